@@ -37,7 +37,7 @@ func (h *handler) Registry(ws *restful.WebService) {
 	tags := []string{"验证码管理"}
 
 	ws.Route(ws.POST("/").To(h.IssueCode).
-		Doc("颁发验证码").
+		Doc("颁发验证码, 找回密码时使用账号方式(issue_by=ACCOUNT, purpose=RESET_PASSWORD)申请").
 		Metadata(restfulspec.KeyOpenAPITags, tags).
 		Reads(code.IssueCodeRequest{}).
 		Writes(code.Code{}))
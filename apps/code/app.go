@@ -1,10 +1,10 @@
 package code
 
 import (
+	"crypto/rand"
 	"fmt"
 	"hash/fnv"
-	"math/rand"
-	"strconv"
+	"math/big"
 	"strings"
 	"time"
 
@@ -16,6 +16,15 @@ const (
 	AppName = "code"
 )
 
+const (
+	// 验证码允许校验失败的次数, 超过后用户当前同用途的验证码全部作废
+	MAX_VERIFY_FAILED = 5
+	// 通过账号申请验证码时, 同一账号两次申请的最小间隔
+	ACCOUNT_ISSUE_INTERVAL = time.Minute
+	// 通过账号申请验证码时, 同一账号每小时最多申请的次数
+	ACCOUNT_ISSUE_LIMIT_PER_HOUR = 5
+)
+
 var (
 	validate = validator.New()
 )
@@ -40,9 +49,9 @@ func NewCode(req *IssueCodeRequest) (*Code, error) {
 		Username:      req.Username,
 		IssueAt:       time.Now().UnixMilli(),
 		ExpiredMinite: 10,
+		Purpose:       req.Purpose,
 	}
 
-	c.Id = HashID(c.Username, c.Code)
 	return c, nil
 }
 
@@ -56,31 +65,36 @@ func (c *Code) ExpiredMiniteString() string {
 	return fmt.Sprintf("%d", c.ExpiredMinite)
 }
 
-// GenRandomCode todo
+// GenRandomCode 使用安全的随机数生成数字验证码
 func GenRandomCode(length uint) string {
-	numbers := []string{}
-	rand.Seed(time.Now().Unix())
+	max := big.NewInt(10)
+	numbers := make([]string, 0, length)
 	for i := 0; i < int(length); i++ {
-		c := rand.Intn(9)
-		// 第一位不能为0
-		if c == 0 {
-			c = 1
+		c, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			panic(err)
 		}
-
-		numbers = append(numbers, strconv.Itoa(c))
+		numbers = append(numbers, c.String())
 	}
 
 	return strings.Join(numbers, "")
 }
 
-// HashID todo
-func HashID(username, code string) string {
+// HashID 验证码Id由用户Id和验证码计算, 用户名只在域内唯一, 不能用于区分用户
+func HashID(userId, code string) string {
 	hash := fnv.New32a()
-	hash.Write([]byte(username))
+	hash.Write([]byte(userId))
 	hash.Write([]byte(code))
 	return fmt.Sprintf("%x", hash.Sum32())
 }
 
+// SetUser 设置验证码所属的用户
+func (c *Code) SetUser(userId, username string) {
+	c.UserId = userId
+	c.Username = username
+	c.Id = HashID(userId, c.Code)
+}
+
 // NewIssueCodeResponse todo
 func NewIssueCodeResponse(message string) *IssueCodeResponse {
 	return &IssueCodeResponse{Message: message}
@@ -93,10 +107,7 @@ func (req *VerifyCodeRequest) Validate() error {
 
 // HashID todo
 func (req *VerifyCodeRequest) HashID() string {
-	hash := fnv.New32a()
-	hash.Write([]byte(req.Username))
-	hash.Write([]byte(req.Code))
-	return fmt.Sprintf("%x", hash.Sum32())
+	return HashID(req.UserId, req.Code)
 }
 
 // NewDefaultCode todo
@@ -104,9 +115,77 @@ func NewDefaultCode() *Code {
 	return &Code{}
 }
 
-func NewVerifyCodeRequest(username, code string) *VerifyCodeRequest {
+func NewVerifyCodeRequest(userId, code string) *VerifyCodeRequest {
 	return &VerifyCodeRequest{
-		Username: username,
-		Code:     code,
+		UserId: userId,
+		Code:   code,
 	}
 }
+
+// NewResetPasswordVerifyCodeRequest 校验找回密码的验证码
+func NewResetPasswordVerifyCodeRequest(userId, code string) *VerifyCodeRequest {
+	req := NewVerifyCodeRequest(userId, code)
+	req.Purpose = PURPOSE_RESET_PASSWORD
+	return req
+}
+
+// NewVerifyContactCodeRequest 校验验证联系方式的验证码
+func NewVerifyContactCodeRequest(userId, code string, purpose PURPOSE) *VerifyCodeRequest {
+	req := NewVerifyCodeRequest(userId, code)
+	req.Purpose = purpose
+	return req
+}
+
+// IsVerifyContact 是否是验证邮箱或者手机的验证码
+func (p PURPOSE) IsVerifyContact() bool {
+	return p.IsIn(PURPOSE_VERIFY_EMAIL, PURPOSE_VERIFY_PHONE)
+}
+
+// Title 验证码通知的标题
+func (c *Code) Title() string {
+	switch c.Purpose {
+	case PURPOSE_RESET_PASSWORD:
+		return "找回密码验证码"
+	case PURPOSE_VERIFY_EMAIL:
+		return "邮箱验证码"
+	case PURPOSE_VERIFY_PHONE:
+		return "手机验证码"
+	default:
+		return "验证码"
+	}
+}
+
+// Desensitize 去除验证码内容
+func (c *Code) Desensitize() {
+	c.Code = ""
+}
+
+func NewCodeSet() *CodeSet {
+	return &CodeSet{
+		Items: []*Code{},
+	}
+}
+
+func (s *CodeSet) Add(item *Code) {
+	s.Items = append(s.Items, item)
+}
+
+func NewQueryCodeRequest(userId string) *QueryCodeRequest {
+	return &QueryCodeRequest{
+		UserId: userId,
+	}
+}
+
+func (req *QueryCodeRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDeleteUserCodeRequest(userId string) *DeleteUserCodeRequest {
+	return &DeleteUserCodeRequest{
+		UserId: userId,
+	}
+}
+
+func (req *DeleteUserCodeRequest) Validate() error {
+	return validate.Struct(req)
+}
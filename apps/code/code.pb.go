@@ -27,6 +27,8 @@ const (
 	ISSUE_BY_PASSWORD ISSUE_BY = 0
 	// 通过AccessToken颁发验证码
 	ISSUE_BY_ACCESS_TOKEN ISSUE_BY = 1
+	// 通过账号(用户名或者邮箱)颁发验证码, 无需凭证, 只能用于找回密码
+	ISSUE_BY_ACCOUNT ISSUE_BY = 2
 )
 
 // Enum value maps for ISSUE_BY.
@@ -34,10 +36,12 @@ var (
 	ISSUE_BY_name = map[int32]string{
 		0: "PASSWORD",
 		1: "ACCESS_TOKEN",
+		2: "ACCOUNT",
 	}
 	ISSUE_BY_value = map[string]int32{
 		"PASSWORD":     0,
 		"ACCESS_TOKEN": 1,
+		"ACCOUNT":      2,
 	}
 )
 
@@ -68,6 +72,62 @@ func (ISSUE_BY) EnumDescriptor() ([]byte, []int) {
 	return file_apps_code_pb_code_proto_rawDescGZIP(), []int{0}
 }
 
+type PURPOSE int32
+
+const (
+	// 登录时的身份校验
+	PURPOSE_LOGIN PURPOSE = 0
+	// 找回密码
+	PURPOSE_RESET_PASSWORD PURPOSE = 1
+	// 验证邮箱, 验证码发送到用户当前的邮箱
+	PURPOSE_VERIFY_EMAIL PURPOSE = 2
+	// 验证手机, 验证码发送到用户当前的手机
+	PURPOSE_VERIFY_PHONE PURPOSE = 3
+)
+
+// Enum value maps for PURPOSE.
+var (
+	PURPOSE_name = map[int32]string{
+		0: "LOGIN",
+		1: "RESET_PASSWORD",
+		2: "VERIFY_EMAIL",
+		3: "VERIFY_PHONE",
+	}
+	PURPOSE_value = map[string]int32{
+		"LOGIN":          0,
+		"RESET_PASSWORD": 1,
+		"VERIFY_EMAIL":   2,
+		"VERIFY_PHONE":   3,
+	}
+)
+
+func (x PURPOSE) Enum() *PURPOSE {
+	p := new(PURPOSE)
+	*p = x
+	return p
+}
+
+func (x PURPOSE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PURPOSE) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_code_pb_code_proto_enumTypes[1].Descriptor()
+}
+
+func (PURPOSE) Type() protoreflect.EnumType {
+	return &file_apps_code_pb_code_proto_enumTypes[1]
+}
+
+func (x PURPOSE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PURPOSE.Descriptor instead.
+func (PURPOSE) EnumDescriptor() ([]byte, []int) {
+	return file_apps_code_pb_code_proto_rawDescGZIP(), []int{1}
+}
+
 // Code 验证码
 type Code struct {
 	state         protoimpl.MessageState
@@ -80,15 +140,27 @@ type Code struct {
 	// 验证码
 	// @gotags: bson:"code" json:"code"
 	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code" bson:"code"`
-	// 用户Id
-	// @gotags: json:"username" validate:"required"
-	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username" validate:"required"`
+	// 用户名
+	// @gotags: bson:"username" json:"username"
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username" bson:"username"`
 	// 颁发时间
 	// @gotags: bson:"issue_at" json:"issue_at"
 	IssueAt int64 `protobuf:"varint,4,opt,name=issue_at,json=issueAt,proto3" json:"issue_at" bson:"issue_at"`
 	// 验证码过期时间
 	// @gotags: bson:"expired_minite" json:"expired_minite"
 	ExpiredMinite uint32 `protobuf:"varint,5,opt,name=expired_minite,json=expiredMinite,proto3" json:"expired_minite" bson:"expired_minite"`
+	// 验证码用途, 验证码只能用于申请时的用途
+	// @gotags: bson:"purpose" json:"purpose"
+	Purpose PURPOSE `protobuf:"varint,6,opt,name=purpose,proto3,enum=infraboard.mcenter.code.PURPOSE" json:"purpose" bson:"purpose"`
+	// 验证码发送的邮箱或者手机, 验证联系方式时使用, 校验时必须和用户当前的联系方式一致
+	// @gotags: bson:"target" json:"target"
+	Target string `protobuf:"bytes,7,opt,name=target,proto3" json:"target" bson:"target"`
+	// 校验失败的次数, 达到限制后验证码作废
+	// @gotags: bson:"failed_count" json:"failed_count"
+	FailedCount uint32 `protobuf:"varint,8,opt,name=failed_count,json=failedCount,proto3" json:"failed_count" bson:"failed_count"`
+	// 用户Id, 用户名只在域内唯一, 验证码按照用户Id颁发和校验
+	// @gotags: bson:"user_id" json:"user_id"
+	UserId string `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id" bson:"user_id"`
 }
 
 func (x *Code) Reset() {
@@ -158,13 +230,101 @@ func (x *Code) GetExpiredMinite() uint32 {
 	return 0
 }
 
+func (x *Code) GetPurpose() PURPOSE {
+	if x != nil {
+		return x.Purpose
+	}
+	return PURPOSE_LOGIN
+}
+
+func (x *Code) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Code) GetFailedCount() uint32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *Code) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// CodeSet 验证码列表
+type CodeSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 总数
+	// @gotags: json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	// 数据项
+	// @gotags: json:"items"
+	Items []*Code `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+}
+
+func (x *CodeSet) Reset() {
+	*x = CodeSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_code_pb_code_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodeSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeSet) ProtoMessage() {}
+
+func (x *CodeSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_code_pb_code_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeSet.ProtoReflect.Descriptor instead.
+func (*CodeSet) Descriptor() ([]byte, []int) {
+	return file_apps_code_pb_code_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CodeSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CodeSet) GetItems() []*Code {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_apps_code_pb_code_proto protoreflect.FileDescriptor
 
 var file_apps_code_pb_code_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x63,
 	0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x69, 0x6e, 0x66, 0x72, 0x61,
 	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f,
-	0x64, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x65, 0x22, 0x98, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63,
 	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
 	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
@@ -172,13 +332,33 @@ var file_apps_code_pb_code_proto_rawDesc = []byte{
 	0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69,
 	0x73, 0x73, 0x75, 0x65, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
 	0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x2a, 0x2a, 0x0a,
-	0x08, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x42, 0x59, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53,
-	0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x01, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f,
-	0x63, 0x6f, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x12, 0x3a, 0x0a,
+	0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45,
+	0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x54, 0x0a,
+	0x07, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x33,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x2a, 0x37, 0x0a, 0x08, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x42, 0x59, 0x12,
+	0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0x4c, 0x0a, 0x07,
+	0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x47, 0x49, 0x4e,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x50, 0x41, 0x53, 0x53,
+	0x57, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59,
+	0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x45, 0x52, 0x49,
+	0x46, 0x59, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73,
+	0x2f, 0x63, 0x6f, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -193,18 +373,22 @@ func file_apps_code_pb_code_proto_rawDescGZIP() []byte {
 	return file_apps_code_pb_code_proto_rawDescData
 }
 
-var file_apps_code_pb_code_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_apps_code_pb_code_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_apps_code_pb_code_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_apps_code_pb_code_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_apps_code_pb_code_proto_goTypes = []interface{}{
-	(ISSUE_BY)(0), // 0: infraboard.mcenter.code.ISSUE_BY
-	(*Code)(nil),  // 1: infraboard.mcenter.code.Code
+	(ISSUE_BY)(0),   // 0: infraboard.mcenter.code.ISSUE_BY
+	(PURPOSE)(0),    // 1: infraboard.mcenter.code.PURPOSE
+	(*Code)(nil),    // 2: infraboard.mcenter.code.Code
+	(*CodeSet)(nil), // 3: infraboard.mcenter.code.CodeSet
 }
 var file_apps_code_pb_code_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: infraboard.mcenter.code.Code.purpose:type_name -> infraboard.mcenter.code.PURPOSE
+	2, // 1: infraboard.mcenter.code.CodeSet.items:type_name -> infraboard.mcenter.code.Code
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_apps_code_pb_code_proto_init() }
@@ -225,14 +409,26 @@ func file_apps_code_pb_code_proto_init() {
 				return nil
 			}
 		}
+		file_apps_code_pb_code_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodeSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apps_code_pb_code_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -53,3 +53,48 @@ func (t *ISSUE_BY) UnmarshalJSON(b []byte) error {
 	*t = ins
 	return nil
 }
+
+// ParsePURPOSEFromString Parse PURPOSE from string
+func ParsePURPOSEFromString(str string) (PURPOSE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := PURPOSE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown PURPOSE: %s", str)
+	}
+
+	return PURPOSE(v), nil
+}
+
+// Equal type compare
+func (t PURPOSE) Equal(target PURPOSE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t PURPOSE) IsIn(targets ...PURPOSE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t PURPOSE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *PURPOSE) UnmarshalJSON(b []byte) error {
+	ins, err := ParsePURPOSEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
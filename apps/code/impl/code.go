@@ -6,6 +6,7 @@ import (
 
 	"github.com/infraboard/mcenter/apps/code"
 	"github.com/infraboard/mcenter/apps/notify"
+	"github.com/infraboard/mcenter/apps/setting"
 	"github.com/infraboard/mcenter/apps/token"
 	"github.com/infraboard/mcenter/apps/user"
 	"github.com/infraboard/mcube/exception"
@@ -13,6 +14,11 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+const (
+	// 通过账号颁发验证码时, 不论账号是否存在都返回相同的消息
+	ACCOUNT_ISSUE_MESSAGE = "如果账号存在, 验证码已发送到账号绑定的邮箱或者手机, 请及时查收"
+)
+
 func (s *service) IssueCode(ctx context.Context, req *code.IssueCodeRequest) (
 	*code.IssueCodeResponse, error) {
 	// 生成验证码
@@ -22,9 +28,10 @@ func (s *service) IssueCode(ctx context.Context, req *code.IssueCodeRequest) (
 	}
 
 	// 校验凭证合法性
+	var u *user.User
 	switch req.IssueBy {
 	case code.ISSUE_BY_PASSWORD:
-		u, err := s.user.DescribeUser(ctx, user.NewDescriptUserRequestWithName(req.Username))
+		u, err = s.user.DescribeUser(ctx, user.NewDescriptUserRequestWithDomainName(req.Domain, req.Username))
 		if err != nil {
 			return nil, err
 		}
@@ -36,10 +43,59 @@ func (s *service) IssueCode(ctx context.Context, req *code.IssueCodeRequest) (
 		if err != nil {
 			return nil, err
 		}
-		c.Username = tk.Username
+		u, err = s.user.DescribeUser(ctx, user.NewDescriptUserRequestWithId(tk.UserId))
+		if err != nil {
+			return nil, err
+		}
+	case code.ISSUE_BY_ACCOUNT:
+		// 没有凭证, 只允许用于找回密码
+		if !req.Purpose.Equal(code.PURPOSE_RESET_PASSWORD) {
+			return nil, exception.NewBadRequest("issue by account only for %s", code.PURPOSE_RESET_PASSWORD)
+		}
+		u, err = s.user.DescribeUser(ctx, accountDescribeRequest(req))
+		if err != nil {
+			// 避免通过该接口探测账号是否存在
+			if exception.IsNotFoundError(err) {
+				s.log.Debugf("forgot password account not found, %s", err)
+				return code.NewIssueCodeResponse(ACCOUNT_ISSUE_MESSAGE), nil
+			}
+			return nil, err
+		}
 	default:
 		return nil, exception.NewBadRequest("uknown issue_by %s", req.IssueBy)
 	}
+	c.SetUser(u.Id, u.Spec.Username)
+
+	// 无需凭证的申请限制频率, 超过限制时不发送, 返回相同的消息避免探测账号
+	if req.IssueBy.Equal(code.ISSUE_BY_ACCOUNT) {
+		limited, err := s.isIssueLimited(ctx, c.UserId)
+		if err != nil {
+			return nil, exception.NewInternalServerError("%s", err)
+		}
+		if limited {
+			s.log.Warnf("account %s issue verify code too frequently", c.Username)
+			return code.NewIssueCodeResponse(ACCOUNT_ISSUE_MESSAGE), nil
+		}
+	}
+
+	// 根据系统配置, 确定验证码的发送方式
+	system, err := s.setting.GetSetting(ctx)
+	if err != nil {
+		return nil, exception.NewInternalServerError("query system setting error, %s", err)
+	}
+	if req.Purpose.IsVerifyContact() {
+		// 验证联系方式的验证码发送到需要验证的邮箱或者手机
+		c.Target = u.Contact(contactType(req.Purpose))
+		if c.Target == "" {
+			return nil, exception.NewBadRequest("user %s %s not set", u.Spec.Username, contactType(req.Purpose))
+		}
+	} else if err := system.Notify.CheckVerified(u); err != nil {
+		if req.IssueBy.Equal(code.ISSUE_BY_ACCOUNT) {
+			s.log.Debugf("forgot password account contact not verified, %s", err)
+			return code.NewIssueCodeResponse(ACCOUNT_ISSUE_MESSAGE), nil
+		}
+		return nil, exception.NewBadRequest("%s", err)
+	}
 
 	// 保存
 	if _, err := s.col.InsertOne(ctx, c); err != nil {
@@ -48,57 +104,73 @@ func (s *service) IssueCode(ctx context.Context, req *code.IssueCodeRequest) (
 	}
 
 	// 发送验证码
-	msg, err := s.send(ctx, c)
+	msg, err := s.send(ctx, system, u, c)
 	if err != nil {
 		return nil, exception.NewInternalServerError("send verify code error, %s", err)
 	}
 
+	if req.IssueBy.Equal(code.ISSUE_BY_ACCOUNT) {
+		msg = ACCOUNT_ISSUE_MESSAGE
+	}
 	return code.NewIssueCodeResponse(msg), nil
 }
 
-func (s *service) send(ctx context.Context, code *code.Code) (string, error) {
-	// 查询用户的详情, 获取邮箱, 电话等这些通知信息
-	u, err := s.user.DescribeUser(ctx, user.NewDescriptUserRequestWithName(code.Username))
-	if err != nil {
-		return "", fmt.Errorf("get user error, %s", err)
+// 通过用户名或者邮箱查找用户
+func accountDescribeRequest(req *code.IssueCodeRequest) *user.DescribeUserRequest {
+	if req.Email != "" {
+		return user.NewDescriptUserRequestWithEmail(req.Domain, req.Email)
 	}
+	return user.NewDescriptUserRequestWithDomainName(req.Domain, req.Username)
+}
 
-	// 根据系统配置, 给用户发送通知
-	system, err := s.setting.GetSetting(ctx)
-	if err != nil {
-		return "", fmt.Errorf("query system setting error, %s", err)
+// 验证码用途对应的联系方式
+func contactType(p code.PURPOSE) user.CONTACT_TYPE {
+	if p.Equal(code.PURPOSE_VERIFY_PHONE) {
+		return user.CONTACT_TYPE_PHONE
+	}
+	return user.CONTACT_TYPE_MAIL
+}
+
+func (s *service) send(ctx context.Context, system *setting.Setting, u *user.User, c *code.Code) (string, error) {
+	// 验证联系方式时, 通过需要验证的方式发送, 其他情况根据系统配置发送
+	notifyType := system.Notify.Type
+	switch c.Purpose {
+	case code.PURPOSE_VERIFY_EMAIL:
+		notifyType = notify.NOTIFY_TYPE_MAIL
+	case code.PURPOSE_VERIFY_PHONE:
+		notifyType = notify.NOTIFY_TYPE_SMS
 	}
 
 	var message string
-	switch system.Notify.Type {
+	switch notifyType {
 	case notify.NOTIFY_TYPE_MAIL:
-		content := system.Notify.Code.RenderMailCentent(code.Code, code.ExpiredMinite)
+		content := system.Notify.Code.RenderMailCentent(c.Code, c.ExpiredMinite)
 		// 邮件通知
 		s.log.Debugf("mail to user %s", u.Profile.Email)
-		_, err := s.notify.SendMail(ctx, notify.NewSendMailRequest([]string{u.Profile.Email}, "验证码", content))
+		_, err := s.notify.SendMail(ctx, notify.NewSendMailRequest([]string{u.Profile.Email}, c.Title(), content))
 		if err != nil {
 			return "", fmt.Errorf("send verify code by mail error, %s", err)
 		}
 		message = fmt.Sprintf("验证码已通过邮件发送到你的邮箱: %s, 请及时查收", u.Profile.Email)
-		s.log.Debugf("send verify code to user: %s by mail ok", code.Username)
+		s.log.Debugf("send verify code to user: %s by mail ok", c.Username)
 	case notify.NOTIFY_TYPE_SMS:
 		// 短信通知
 		s.log.Debugf("sms to user %s", u.Profile.Phone)
 		if u.Profile.Phone == "" {
-			return "", fmt.Errorf("user %s phone not found", code.Username)
+			return "", fmt.Errorf("user %s phone not found", c.Username)
 		}
 		req := notify.NewSendSMSRequest()
 		req.AddPhone(u.Profile.Phone)
 		req.TemplateId = system.Notify.Code.SmsTemplateID
-		req.AddParams(code.Code, code.ExpiredMiniteString())
+		req.AddParams(c.Code, c.ExpiredMiniteString())
 		_, err := s.notify.SendSMS(ctx, req)
 		if err != nil {
 			return "", fmt.Errorf("send verify code by sms error, %s", err)
 		}
 		message = fmt.Sprintf("验证码已通过短信发送到你的手机: %s, 请及时查收", u.Profile.Phone)
-		s.log.Debugf("send verify code to user: %s by sms ok", code.Username)
+		s.log.Debugf("send verify code to user: %s by sms ok", c.Username)
 	default:
-		return "", fmt.Errorf("unknown notify type %s", system.Notify.Type)
+		return "", fmt.Errorf("unknown notify type %s", notifyType)
 	}
 
 	return message, nil
@@ -110,24 +182,61 @@ func (s *service) VerifyCode(ctx context.Context, req *code.VerifyCodeRequest) (
 		return nil, exception.NewBadRequest("validate check code request error, %s", err)
 	}
 
-	code := code.NewDefaultCode()
-	if err := s.col.FindOne(ctx, bson.M{"_id": req.HashID()}).Decode(code); err != nil {
+	ins := code.NewDefaultCode()
+	if err := s.col.FindOne(ctx, bson.M{"_id": req.HashID()}).Decode(ins); err != nil {
 		if err == mongo.ErrNoDocuments {
+			s.verifyFailed(ctx, req)
 			return nil, exception.NewNotFound("verify code: %s  not found", req.Code)
 		}
 
 		return nil, exception.NewInternalServerError("find system config %s error, %s", req.Code, err)
 	}
 
+	// 验证码只能用于申请时的用途
+	if !ins.Purpose.Equal(req.Purpose) {
+		s.verifyFailed(ctx, req)
+		return nil, exception.NewNotFound("verify code: %s  not found", req.Code)
+	}
+
 	// 校验Token是否过期
-	if code.IsExpired() {
+	if ins.IsExpired() {
 		return nil, exception.NewPermissionDeny("verify code is expired")
 	}
 
 	// 没过去验证成功, 删除
-	if err := s.delete(ctx, code); err != nil {
+	if err := s.delete(ctx, ins); err != nil {
 		s.log.Errorf("delete check ok verify code error, %s", err)
 	}
 
-	return code, nil
+	return ins, nil
+}
+
+func (s *service) verifyFailed(ctx context.Context, req *code.VerifyCodeRequest) {
+	if err := s.recordFailure(ctx, req.UserId, req.Purpose); err != nil {
+		s.log.Errorf("record verify code failure error, %s", err)
+	}
+}
+
+// 查询用户的验证码, 不返回验证码内容
+func (s *service) QueryCode(ctx context.Context, req *code.QueryCodeRequest) (*code.CodeSet, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+	return s.query(ctx, req.UserId)
+}
+
+// 删除用户的所有验证码
+func (s *service) DeleteUserCode(ctx context.Context, req *code.DeleteUserCodeRequest) (*code.CodeSet, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	set, err := s.query(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.col.DeleteMany(ctx, bson.M{"user_id": req.UserId}); err != nil {
+		return nil, exception.NewInternalServerError("delete user %s verify code error, %s", req.UserId, err)
+	}
+	return set, nil
 }
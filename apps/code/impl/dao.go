@@ -3,10 +3,12 @@ package impl
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/infraboard/mcenter/apps/code"
 	"github.com/infraboard/mcube/exception"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func (s *service) delete(ctx context.Context, ins *code.Code) error {
@@ -21,3 +23,64 @@ func (s *service) delete(ctx context.Context, ins *code.Code) error {
 
 	return nil
 }
+
+func (s *service) query(ctx context.Context, userId string) (*code.CodeSet, error) {
+	resp, err := s.col.Find(ctx, bson.M{"user_id": userId})
+	if err != nil {
+		return nil, exception.NewInternalServerError("find verify code error, %s", err)
+	}
+
+	set := code.NewCodeSet()
+	for resp.Next(ctx) {
+		ins := code.NewDefaultCode()
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode verify code error, %s", err)
+		}
+		ins.Desensitize()
+		set.Add(ins)
+	}
+	set.Total = int64(len(set.Items))
+	return set, nil
+}
+
+// 记录一次校验失败, 失败次数达到限制后, 用户当前同用途的验证码全部作废
+// 验证码Id由用户Id和验证码计算, 猜错时查不到具体的验证码, 因此按照用户和用途计数
+func (s *service) recordFailure(ctx context.Context, userId string, purpose code.PURPOSE) error {
+	filter := bson.M{"user_id": userId, "purpose": purpose}
+	if _, err := s.col.UpdateMany(ctx, filter, bson.M{"$inc": bson.M{"failed_count": 1}}); err != nil {
+		return fmt.Errorf("update verify code failed count error, %s", err)
+	}
+
+	filter["failed_count"] = bson.M{"$gte": code.MAX_VERIFY_FAILED}
+	result, err := s.col.DeleteMany(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("delete verify code error, %s", err)
+	}
+	if result.DeletedCount > 0 {
+		s.log.Warnf("user %s verify code failed too many times, %d code deleted", userId, result.DeletedCount)
+	}
+	return nil
+}
+
+// 通过账号申请验证码无需凭证, 限制同一账号申请的频率
+func (s *service) isIssueLimited(ctx context.Context, userId string) (bool, error) {
+	now := time.Now()
+	filter := bson.M{
+		"user_id":  userId,
+		"issue_at": bson.M{"$gte": now.Add(-time.Hour).UnixMilli()},
+	}
+	count, err := s.col.CountDocuments(ctx, filter)
+	if err != nil {
+		return false, fmt.Errorf("count verify code error, %s", err)
+	}
+	if count >= code.ACCOUNT_ISSUE_LIMIT_PER_HOUR {
+		return true, nil
+	}
+
+	filter["issue_at"] = bson.M{"$gte": now.Add(-code.ACCOUNT_ISSUE_INTERVAL).UnixMilli()}
+	count, err = s.col.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("count verify code error, %s", err)
+	}
+	return count > 0, nil
+}
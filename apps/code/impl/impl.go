@@ -45,6 +45,12 @@ func (s *service) Config() error {
 		{
 			Keys: bsonx.Doc{{Key: "issue_at", Value: bsonx.Int32(-1)}},
 		},
+		{
+			Keys: bsonx.Doc{
+				{Key: "user_id", Value: bsonx.Int32(1)},
+				{Key: "issue_at", Value: bsonx.Int32(-1)},
+			},
+		},
 	}
 
 	_, err = dc.Indexes().CreateMany(context.Background(), indexs)
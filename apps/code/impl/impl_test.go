@@ -26,8 +26,22 @@ func TestIssueCode(t *testing.T) {
 	t.Log(r)
 }
 
+func TestIssueResetPasswordCode(t *testing.T) {
+	req := code.NewIssueCodeRequest()
+	req.IssueBy = code.ISSUE_BY_ACCOUNT
+	req.Purpose = code.PURPOSE_RESET_PASSWORD
+	req.Domain = "default"
+	req.Username = "test"
+
+	r, err := impl.IssueCode(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
 func TestVerifyCode(t *testing.T) {
-	req := code.NewVerifyCodeRequest("admin", "612114")
+	req := code.NewVerifyCodeRequest("admin-id", "612114")
 	r, err := impl.VerifyCode(ctx, req)
 	if err != nil {
 		t.Fatal(err)
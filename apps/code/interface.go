@@ -1,5 +1,11 @@
 package code
 
+import context "context"
+
 type Service interface {
+	// 查询用户的验证码, 不返回验证码内容
+	QueryCode(context.Context, *QueryCodeRequest) (*CodeSet, error)
+	// 删除用户的所有验证码
+	DeleteUserCode(context.Context, *DeleteUserCodeRequest) (*CodeSet, error)
 	RPCServer
 }
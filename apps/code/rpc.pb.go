@@ -38,6 +38,15 @@ type IssueCodeRequest struct {
 	// 令牌
 	// @gotags: json:"access_token"
 	AccessToken string `protobuf:"bytes,6,opt,name=access_token,json=accessToken,proto3" json:"access_token"`
+	// 验证码用途
+	// @gotags: json:"purpose"
+	Purpose PURPOSE `protobuf:"varint,7,opt,name=purpose,proto3,enum=infraboard.mcenter.code.PURPOSE" json:"purpose"`
+	// 用户所属域, 通过账号或者用户名密码颁发时使用
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,8,opt,name=domain,proto3" json:"domain"`
+	// 用户邮箱, 通过账号颁发时可以使用邮箱代替用户名
+	// @gotags: json:"email"
+	Email string `protobuf:"bytes,9,opt,name=email,proto3" json:"email"`
 }
 
 func (x *IssueCodeRequest) Reset() {
@@ -100,6 +109,27 @@ func (x *IssueCodeRequest) GetAccessToken() string {
 	return ""
 }
 
+func (x *IssueCodeRequest) GetPurpose() PURPOSE {
+	if x != nil {
+		return x.Purpose
+	}
+	return PURPOSE_LOGIN
+}
+
+func (x *IssueCodeRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *IssueCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
 // IssueCodeResponse todo
 type IssueCodeResponse struct {
 	state         protoimpl.MessageState
@@ -156,12 +186,15 @@ type VerifyCodeRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 用户名
-	// @gotags: json:"username" validate:"required"
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username" validate:"required"`
+	// 用户Id
+	// @gotags: json:"user_id" validate:"required"
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id" validate:"required"`
 	// 验证码
 	// @gotags: json:"code" validate:"required"
 	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code" validate:"required"`
+	// 验证码用途
+	// @gotags: json:"purpose"
+	Purpose PURPOSE `protobuf:"varint,3,opt,name=purpose,proto3,enum=infraboard.mcenter.code.PURPOSE" json:"purpose"`
 }
 
 func (x *VerifyCodeRequest) Reset() {
@@ -196,9 +229,9 @@ func (*VerifyCodeRequest) Descriptor() ([]byte, []int) {
 	return file_apps_code_pb_rpc_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *VerifyCodeRequest) GetUsername() string {
+func (x *VerifyCodeRequest) GetUserId() string {
 	if x != nil {
-		return x.Username
+		return x.UserId
 	}
 	return ""
 }
@@ -210,6 +243,113 @@ func (x *VerifyCodeRequest) GetCode() string {
 	return ""
 }
 
+func (x *VerifyCodeRequest) GetPurpose() PURPOSE {
+	if x != nil {
+		return x.Purpose
+	}
+	return PURPOSE_LOGIN
+}
+
+// QueryCodeRequest 查询用户的验证码
+type QueryCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 用户Id
+	// @gotags: json:"user_id" validate:"required"
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id" validate:"required"`
+}
+
+func (x *QueryCodeRequest) Reset() {
+	*x = QueryCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_code_pb_rpc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCodeRequest) ProtoMessage() {}
+
+func (x *QueryCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_code_pb_rpc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCodeRequest.ProtoReflect.Descriptor instead.
+func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
+	return file_apps_code_pb_rpc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryCodeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// DeleteUserCodeRequest 删除用户的所有验证码
+type DeleteUserCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 用户Id
+	// @gotags: json:"user_id" validate:"required"
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id" validate:"required"`
+}
+
+func (x *DeleteUserCodeRequest) Reset() {
+	*x = DeleteUserCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_code_pb_rpc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserCodeRequest) ProtoMessage() {}
+
+func (x *DeleteUserCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_code_pb_rpc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserCodeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserCodeRequest) Descriptor() ([]byte, []int) {
+	return file_apps_code_pb_rpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteUserCodeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 var File_apps_code_pb_rpc_proto protoreflect.FileDescriptor
 
 var file_apps_code_pb_rpc_proto_rawDesc = []byte{
@@ -217,7 +357,7 @@ var file_apps_code_pb_rpc_proto_rawDesc = []byte{
 	0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62,
 	0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x64,
 	0x65, 0x1a, 0x17, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x62, 0x2f,
-	0x63, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x49,
+	0x63, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x02, 0x0a, 0x10, 0x49,
 	0x73, 0x73, 0x75, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x3c, 0x0a, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0e, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d,
@@ -228,30 +368,47 @@ var file_apps_code_pb_rpc_proto_rawDesc = []byte{
 	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
 	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2d, 0x0a, 0x11, 0x49, 0x73, 0x73, 0x75,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0xc2, 0x01, 0x0a,
-	0x03, 0x52, 0x50, 0x43, 0x12, 0x62, 0x0a, 0x09, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d,
-	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69,
-	0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65,
-	0x72, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70,
+	0x6f, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x66, 0x72,
+	0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x64, 0x65, 0x2e, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45, 0x52, 0x07, 0x70, 0x75, 0x72,
+	0x70, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x22, 0x2d, 0x0a, 0x11, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x82, 0x01, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e,
+	0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65,
+	0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x31, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x36, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10,
+	0x02, 0x32, 0xc2, 0x01, 0x0a, 0x03, 0x52, 0x50, 0x43, 0x12, 0x62, 0x0a, 0x09, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f,
 	0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x64, 0x65,
-	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
-	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x6f, 0x64,
-	0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74,
-	0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x64,
+	0x65, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f,
+	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6f, 0x64,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -266,25 +423,30 @@ func file_apps_code_pb_rpc_proto_rawDescGZIP() []byte {
 	return file_apps_code_pb_rpc_proto_rawDescData
 }
 
-var file_apps_code_pb_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_apps_code_pb_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_apps_code_pb_rpc_proto_goTypes = []interface{}{
-	(*IssueCodeRequest)(nil),  // 0: infraboard.mcenter.code.IssueCodeRequest
-	(*IssueCodeResponse)(nil), // 1: infraboard.mcenter.code.IssueCodeResponse
-	(*VerifyCodeRequest)(nil), // 2: infraboard.mcenter.code.VerifyCodeRequest
-	(ISSUE_BY)(0),             // 3: infraboard.mcenter.code.ISSUE_BY
-	(*Code)(nil),              // 4: infraboard.mcenter.code.Code
+	(*IssueCodeRequest)(nil),      // 0: infraboard.mcenter.code.IssueCodeRequest
+	(*IssueCodeResponse)(nil),     // 1: infraboard.mcenter.code.IssueCodeResponse
+	(*VerifyCodeRequest)(nil),     // 2: infraboard.mcenter.code.VerifyCodeRequest
+	(*QueryCodeRequest)(nil),      // 3: infraboard.mcenter.code.QueryCodeRequest
+	(*DeleteUserCodeRequest)(nil), // 4: infraboard.mcenter.code.DeleteUserCodeRequest
+	(ISSUE_BY)(0),                 // 5: infraboard.mcenter.code.ISSUE_BY
+	(PURPOSE)(0),                  // 6: infraboard.mcenter.code.PURPOSE
+	(*Code)(nil),                  // 7: infraboard.mcenter.code.Code
 }
 var file_apps_code_pb_rpc_proto_depIdxs = []int32{
-	3, // 0: infraboard.mcenter.code.IssueCodeRequest.issue_by:type_name -> infraboard.mcenter.code.ISSUE_BY
-	0, // 1: infraboard.mcenter.code.RPC.IssueCode:input_type -> infraboard.mcenter.code.IssueCodeRequest
-	2, // 2: infraboard.mcenter.code.RPC.VerifyCode:input_type -> infraboard.mcenter.code.VerifyCodeRequest
-	1, // 3: infraboard.mcenter.code.RPC.IssueCode:output_type -> infraboard.mcenter.code.IssueCodeResponse
-	4, // 4: infraboard.mcenter.code.RPC.VerifyCode:output_type -> infraboard.mcenter.code.Code
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 0: infraboard.mcenter.code.IssueCodeRequest.issue_by:type_name -> infraboard.mcenter.code.ISSUE_BY
+	6, // 1: infraboard.mcenter.code.IssueCodeRequest.purpose:type_name -> infraboard.mcenter.code.PURPOSE
+	6, // 2: infraboard.mcenter.code.VerifyCodeRequest.purpose:type_name -> infraboard.mcenter.code.PURPOSE
+	0, // 3: infraboard.mcenter.code.RPC.IssueCode:input_type -> infraboard.mcenter.code.IssueCodeRequest
+	2, // 4: infraboard.mcenter.code.RPC.VerifyCode:input_type -> infraboard.mcenter.code.VerifyCodeRequest
+	1, // 5: infraboard.mcenter.code.RPC.IssueCode:output_type -> infraboard.mcenter.code.IssueCodeResponse
+	7, // 6: infraboard.mcenter.code.RPC.VerifyCode:output_type -> infraboard.mcenter.code.Code
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_apps_code_pb_rpc_proto_init() }
@@ -330,6 +492,30 @@ func file_apps_code_pb_rpc_proto_init() {
 				return nil
 			}
 		}
+		file_apps_code_pb_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_code_pb_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -337,7 +523,7 @@ func file_apps_code_pb_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apps_code_pb_rpc_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
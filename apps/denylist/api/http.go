@@ -0,0 +1,63 @@
+package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/http/restful/response"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/mcenter/apps/denylist"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service denylist.Service
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(denylist.AppName)
+	h.service = app.GetInternalApp(denylist.AppName).(denylist.Service)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return denylist.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"密码黑名单"}
+
+	ws.Route(ws.POST("/").To(h.UpdateDBFile).
+		Doc("上传密码黑名单文件, 每行为SHA-1或者k-anonymity前缀格式, 上传后立即生效").
+		Consumes("text/plain", "application/octet-stream").
+		Metadata(restfulspec.KeyOpenAPITags, tags))
+}
+
+func (h *handler) UpdateDBFile(r *restful.Request, w *restful.Response) {
+	req, err := denylist.NewUploadFileRequestFromHTTP(r.Request)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	if err := h.service.UpdateDBFile(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, "ok")
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
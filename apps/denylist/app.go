@@ -0,0 +1,5 @@
+package denylist
+
+const (
+	AppName = "denylist"
+)
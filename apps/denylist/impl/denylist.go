@@ -0,0 +1,184 @@
+package impl
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/rs/xid"
+
+	"github.com/infraboard/mcenter/apps/denylist"
+	"github.com/infraboard/mcenter/apps/storage"
+)
+
+// UpdateDBFile 上传新的黑名单文件, 校验通过后替换内存中的数据集, 无需重启
+func (s *service) UpdateDBFile(req *denylist.UpdateDBFileRequest) error {
+	if err := req.Validate(); err != nil {
+		return exception.NewBadRequest("validate update db file requrest error, %s", err)
+	}
+
+	reader := req.ReadCloser()
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return exception.NewBadRequest("read db file error, %s", err)
+	}
+	dataset, err := denylist.New(bytes.NewReader(data))
+	if err != nil {
+		return exception.NewBadRequest("parse db file error, %s", err)
+	}
+
+	// 记录版本, 其他副本检测到版本变化后重新加载
+	version := xid.New().String()
+	uploadReq := storage.NewUploadFileRequest(s.bucketName, s.dbFileName, io.NopCloser(bytes.NewReader(data)))
+	uploadReq.Meta()[VERSION_META_KEY] = version
+	if err := s.storage.UploadFile(uploadReq); err != nil {
+		return err
+	}
+
+	s.Lock()
+	s.dataset = dataset
+	s.version = version
+	s.Unlock()
+	s.log.Infof("password denylist updated, total %d records", dataset.Len())
+	return nil
+}
+
+// CheckPassword 检测密码是否在黑名单中, 黑名单未加载时返回错误
+func (s *service) CheckPassword(password string) (*denylist.CheckResult, error) {
+	dataset, err := s.getDataset()
+	if err != nil {
+		return nil, err
+	}
+
+	return dataset.Lookup(password), nil
+}
+
+// getDataset 返回当前加载的数据集, 加载和版本检查都在后台进行, 这里只读取
+func (s *service) getDataset() (*denylist.Dataset, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.dataset == nil {
+		return nil, fmt.Errorf("password denylist not loaded")
+	}
+	return s.dataset, nil
+}
+
+// runReload 启动时加载黑名单, 之后定期检查存储中的版本, 加载失败时下个周期重试
+func (s *service) runReload() {
+	s.reload()
+
+	tk := time.NewTicker(VERSION_CHECK_INTERVAL)
+	defer tk.Stop()
+	for range tk.C {
+		s.reload()
+	}
+}
+
+func (s *service) reload() {
+	s.RLock()
+	loaded, current := s.dataset != nil, s.version
+	s.RUnlock()
+
+	if loaded {
+		s.reloadIfChanged(current)
+		return
+	}
+
+	// 优先从本地文件加载DB文件
+	dataset, err := s.loadDBFileFromLocal()
+	if err == nil {
+		// 以存储中当前的版本为基准, 之后有新的文件上传时再切换到存储中的黑名单
+		version, _ := s.bucketVersion()
+		s.swap(dataset, current, version)
+		return
+	}
+	s.log.Infof("load password denylist from local error, %s, retry other load method ", err)
+
+	dataset, version, err := s.loadDBFileFromBucket()
+	if err != nil {
+		s.log.Infof("load password denylist from bucket error, %s", err)
+		return
+	}
+	s.swap(dataset, current, version)
+}
+
+func (s *service) loadDBFileFromLocal() (*denylist.Dataset, error) {
+	file, err := os.Open(s.dbFileName)
+	if err != nil {
+		return nil, fmt.Errorf("open file error, %s", err)
+	}
+	defer file.Close()
+
+	return denylist.New(file)
+}
+
+// 存储中的黑名单版本和当前加载的不一致时重新加载, 下载和解析期间不持有锁
+// 加载失败时继续使用当前的数据集, 下个检查周期再重试
+func (s *service) reloadIfChanged(current string) {
+	version, err := s.bucketVersion()
+	if err != nil {
+		if !exception.IsNotFoundError(err) {
+			s.log.Errorf("check password denylist version error, %s", err)
+		}
+		return
+	}
+	if version == "" || version == current {
+		return
+	}
+
+	dataset, version, err := s.loadDBFileFromBucket()
+	if err != nil {
+		s.log.Errorf("reload password denylist from bucket error, %s", err)
+		return
+	}
+	if s.swap(dataset, current, version) {
+		s.log.Infof("password denylist reloaded, version %s, total %d records", version, dataset.Len())
+	}
+}
+
+// swap 替换内存中的数据集, 加载期间当前副本上传了新文件时放弃本次加载的结果
+func (s *service) swap(dataset *denylist.Dataset, current, version string) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.version != current {
+		return false
+	}
+	s.dataset = dataset
+	s.version = version
+	return true
+}
+
+func (s *service) bucketVersion() (string, error) {
+	f, err := s.storage.DescribeFile(storage.NewDescribeFileRequest(s.bucketName, s.dbFileName))
+	if err != nil {
+		return "", err
+	}
+	return f.Meta[VERSION_META_KEY], nil
+}
+
+func (s *service) loadDBFileFromBucket() (*denylist.Dataset, string, error) {
+	// 先读取版本, 下载期间文件被替换时, 下个检查周期会再次加载
+	version, err := s.bucketVersion()
+	if err != nil {
+		return nil, "", err
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	downloadReq := storage.NewDownloadFileRequest(s.bucketName, s.dbFileName, buf)
+	if err := s.storage.Download(downloadReq); err != nil {
+		return nil, "", err
+	}
+
+	dataset, err := denylist.New(buf)
+	if err != nil {
+		return nil, "", err
+	}
+	return dataset, version, nil
+}
@@ -0,0 +1,55 @@
+package impl
+
+import (
+	"sync"
+	"time"
+
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/mcenter/apps/denylist"
+	"github.com/infraboard/mcenter/apps/storage"
+)
+
+const (
+	// 检查存储中黑名单版本的间隔, 其他副本上传新文件后在该间隔内生效, 加载失败时也按照该间隔重试
+	VERSION_CHECK_INTERVAL = time.Minute
+	// 存储文件meta中记录版本的key
+	VERSION_META_KEY = "version"
+)
+
+var (
+	// Service 服务实例
+	svr = &service{
+		bucketName: "denylist",
+		dbFileName: "password_denylist.txt",
+	}
+)
+
+type service struct {
+	storage    storage.Service
+	log        logger.Logger
+	bucketName string
+	dbFileName string
+	dataset    *denylist.Dataset
+	// 当前加载的黑名单版本, 从本地文件加载时为存储中当时的版本
+	version string
+	sync.RWMutex
+}
+
+func (s *service) Config() error {
+	s.storage = app.GetInternalApp(storage.AppName).(storage.Service)
+
+	s.log = zap.L().Named("Denylist")
+	go s.runReload()
+	return nil
+}
+
+func (s *service) Name() string {
+	return denylist.AppName
+}
+
+func init() {
+	app.RegistryInternalApp(svr)
+}
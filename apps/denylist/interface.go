@@ -0,0 +1,59 @@
+package denylist
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Service 泄露密码和常见密码检测
+type Service interface {
+	UpdateDBFile(*UpdateDBFileRequest) error
+	CheckPassword(password string) (*CheckResult, error)
+}
+
+// CheckResult 密码检测结果
+type CheckResult struct {
+	// 是否命中密码黑名单
+	Matched bool `json:"matched"`
+	// 在泄露数据中出现的次数, 常见密码为0
+	Count int64 `json:"count"`
+}
+
+// Reason 命中的原因
+func (r *CheckResult) Reason() string {
+	if !r.Matched {
+		return ""
+	}
+	if r.Count > 0 {
+		return fmt.Sprintf("password has appeared in data breaches %d times, please choose another one", r.Count)
+	}
+	return "password is too common, please choose another one"
+}
+
+// NewUploadFileRequestFromHTTP todo
+func NewUploadFileRequestFromHTTP(r *http.Request) (*UpdateDBFileRequest, error) {
+	req := &UpdateDBFileRequest{
+		reader: r.Body,
+	}
+	return req, nil
+}
+
+// UpdateDBFileRequest 上传文件请求
+type UpdateDBFileRequest struct {
+	reader io.ReadCloser
+}
+
+// Validate 校验参数
+func (req *UpdateDBFileRequest) Validate() error {
+	if req.reader == nil {
+		return fmt.Errorf("file reader is nil")
+	}
+
+	return nil
+}
+
+// ReadCloser todo
+func (req *UpdateDBFileRequest) ReadCloser() io.ReadCloser {
+	return req.reader
+}
@@ -0,0 +1,143 @@
+package denylist
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// SHA-1前缀长度, 和k-anonymity range接口保持一致
+	PREFIX_LENGTH = 5
+	// SHA-1的16进制长度
+	HASH_LENGTH = 40
+)
+
+// New 加载密码黑名单数据集, 每行一条记录, 支持两种格式:
+//  1. 完整的SHA-1: <HASH>[:COUNT]
+//  2. k-anonymity range格式: 单独一行5位前缀, 之后为 <SUFFIX>[:COUNT]
+//
+// #开头的行为注释, COUNT为泄露次数, 常见密码可以不填
+func New(db io.Reader) (*Dataset, error) {
+	d := &Dataset{
+		ranges: map[string][]*entry{},
+	}
+
+	prefix := ""
+	scanner := bufio.NewScanner(db)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		hash, count, err := parseLine(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err)
+		}
+
+		switch len(hash) {
+		case PREFIX_LENGTH:
+			if count != 0 {
+				return nil, fmt.Errorf("line %d: range prefix %s has count", line, hash)
+			}
+			prefix = hash
+		case HASH_LENGTH - PREFIX_LENGTH:
+			if prefix == "" {
+				return nil, fmt.Errorf("line %d: hash suffix %s without prefix", line, hash)
+			}
+			d.add(prefix, hash, count)
+		case HASH_LENGTH:
+			d.add(hash[:PREFIX_LENGTH], hash[PREFIX_LENGTH:], count)
+		default:
+			return nil, fmt.Errorf("line %d: invalid hash length %d", line, len(hash))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read db file error, %s", err)
+	}
+
+	for _, items := range d.ranges {
+		sort.Slice(items, func(i, j int) bool { return items[i].suffix < items[j].suffix })
+	}
+	return d, nil
+}
+
+func parseLine(text string) (string, int64, error) {
+	hash, countStr, _ := strings.Cut(text, ":")
+	hash = strings.ToUpper(strings.TrimSpace(hash))
+	if _, err := hex.DecodeString(padding(hash)); err != nil {
+		return "", 0, fmt.Errorf("invalid hash %s", hash)
+	}
+
+	var count int64
+	if countStr = strings.TrimSpace(countStr); countStr != "" {
+		c, err := strconv.ParseInt(countStr, 10, 64)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid count %s", countStr)
+		}
+		count = c
+	}
+	return hash, count, nil
+}
+
+// 5位和35位的前后缀不是偶数长度, 补齐后再校验是否为16进制
+func padding(hash string) string {
+	if len(hash)%2 == 1 {
+		return hash + "0"
+	}
+	return hash
+}
+
+// Dataset 按照SHA-1前缀分组的密码黑名单
+type Dataset struct {
+	ranges map[string][]*entry
+	total  int
+}
+
+type entry struct {
+	suffix string
+	count  int64
+}
+
+func (d *Dataset) add(prefix, suffix string, count int64) {
+	d.ranges[prefix] = append(d.ranges[prefix], &entry{suffix: suffix, count: count})
+	d.total++
+}
+
+// Len 数据集中的记录数量
+func (d *Dataset) Len() int {
+	return d.total
+}
+
+// Lookup 查询密码是否在黑名单中
+func (d *Dataset) Lookup(password string) *CheckResult {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	return d.LookupHash(hash)
+}
+
+// LookupHash 通过SHA-1查询
+func (d *Dataset) LookupHash(hash string) *CheckResult {
+	result := &CheckResult{}
+	hash = strings.ToUpper(hash)
+	if len(hash) != HASH_LENGTH {
+		return result
+	}
+
+	items := d.ranges[hash[:PREFIX_LENGTH]]
+	suffix := hash[PREFIX_LENGTH:]
+	i := sort.Search(len(items), func(i int) bool { return items[i].suffix >= suffix })
+	if i < len(items) && items[i].suffix == suffix {
+		result.Matched = true
+		result.Count = items[i].count
+	}
+	return result
+}
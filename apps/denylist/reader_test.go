@@ -0,0 +1,55 @@
+package denylist_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/infraboard/mcenter/apps/denylist"
+	"github.com/stretchr/testify/assert"
+)
+
+// sha1("password") = 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+// sha1("123456") = 7C4A8D09CA3762AF61E59520943DC26494F8941B
+// sha1("qwerty") = B1B3773A05C0ED0176787A4F1574FF0075F7521E
+const testDB = `
+# full hash
+5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:3861493
+7c4a8d09ca3762af61e59520943dc26494f8941b
+
+# k-anonymity range
+B1B37
+73A05C0ED0176787A4F1574FF0075F7521E:10
+`
+
+func TestLookup(t *testing.T) {
+	should := assert.New(t)
+
+	d, err := denylist.New(strings.NewReader(testDB))
+	if should.NoError(err) {
+		should.Equal(3, d.Len())
+
+		r := d.Lookup("password")
+		should.True(r.Matched)
+		should.Equal(int64(3861493), r.Count)
+		should.Contains(r.Reason(), "3861493")
+
+		r = d.Lookup("123456")
+		should.True(r.Matched)
+		should.Contains(r.Reason(), "too common")
+
+		should.True(d.Lookup("qwerty").Matched)
+		should.False(d.Lookup("correct horse battery staple").Matched)
+		should.Equal("", d.Lookup("xxx").Reason())
+	}
+}
+
+func TestInvalidDB(t *testing.T) {
+	should := assert.New(t)
+
+	_, err := denylist.New(strings.NewReader("73A05C0ED0176787A4F1574FF0075F7521E:10"))
+	should.Error(err)
+	_, err = denylist.New(strings.NewReader("ZZZ"))
+	should.Error(err)
+	_, err = denylist.New(strings.NewReader("5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:abc"))
+	should.Error(err)
+}
@@ -66,3 +66,30 @@ func (u *handler) PatchDomain(r *restful.Request, w *restful.Response) {
 	}
 	response.Success(w, set)
 }
+
+func (h *handler) TestAuthSourceConnection(r *restful.Request, w *restful.Response) {
+	req := domain.NewTestAuthSourceRequest(r.PathParameter("id"), r.PathParameter("name"))
+	ins, err := h.service.TestAuthSourceConnection(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) TestAuthSourceLogin(r *restful.Request, w *restful.Response) {
+	req := domain.NewTestAuthSourceRequest("", "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.DomainId = r.PathParameter("id")
+	req.Name = r.PathParameter("name")
+
+	ins, err := h.service.TestAuthSourceLogin(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
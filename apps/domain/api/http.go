@@ -61,6 +61,75 @@ func (h *handler) Registry(ws *restful.WebService) {
 		Param(ws.PathParameter("id", "identifier of the domain").DataType("string")).
 		Metadata(restfulspec.KeyOpenAPITags, tags).
 		Reads(domain.CreateDomainRequest{}))
+
+	ws.Route(ws.POST("/{id}/auth_sources/{name}/connection").To(h.TestAuthSourceConnection).
+		Doc("测试认证源连接").
+		Param(ws.PathParameter("id", "identifier of the domain").DataType("string")).
+		Param(ws.PathParameter("name", "name of the auth source").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", domain.TestAuthSourceResponse{}))
+
+	ws.Route(ws.POST("/{id}/auth_sources/{name}/login").To(h.TestAuthSourceLogin).
+		Doc("测试认证源登录, 不会创建用户").
+		Param(ws.PathParameter("id", "identifier of the domain").DataType("string")).
+		Param(ws.PathParameter("name", "name of the auth source").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(domain.TestAuthSourceRequest{}).
+		Returns(200, "OK", domain.TestAuthSourceResponse{}))
+
+	ws.Route(ws.GET("/").To(h.QueryDomain).
+		Doc("查询域列表").
+		Param(ws.QueryParameter("state", "ACTIVE, DISABLED或者DELETED, 不指定时不包含已删除的域").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", domain.DomainSet{}))
+
+	ws.Route(ws.PUT("/{id}/state").To(h.ChangeDomainState).
+		Doc("禁用/启用域, 禁用后域下所有用户禁止登录").
+		Param(ws.PathParameter("id", "identifier of the domain").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(domain.ChangeDomainStateRequest{}).
+		Returns(200, "OK", domain.Domain{}))
+
+	ws.Route(ws.DELETE("/{id}").To(h.DeleteDomain).
+		Doc("删除域, 保留期内可以恢复, 保留期过后自动清除").
+		Param(ws.PathParameter("id", "identifier of the domain").DataType("string")).
+		Param(ws.QueryParameter("retain_days", "保留天数, 默认30天").DataType("integer")).
+		Param(ws.QueryParameter("reason", "删除原因").DataType("string")).
+		Param(ws.QueryParameter("operator", "操作人").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", domain.Domain{}))
+
+	ws.Route(ws.POST("/{id}/restore").To(h.RestoreDomain).
+		Doc("恢复保留期内的域").
+		Param(ws.PathParameter("id", "identifier of the domain").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(domain.RestoreDomainRequest{}).
+		Returns(200, "OK", domain.Domain{}))
+
+	ws.Route(ws.POST("/{id}/purge").To(h.PurgeDomain).
+		Doc("立即清除已删除的域, 清除在后台执行, 通过清除任务查看进度").
+		Param(ws.PathParameter("id", "identifier of the domain").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(domain.PurgeDomainRequest{}).
+		Returns(200, "OK", domain.PurgeJob{}))
+
+	ws.Route(ws.GET("/purge_jobs").To(h.QueryPurgeJob).
+		Doc("查询域清除任务").
+		Param(ws.QueryParameter("domain", "域名称").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", domain.PurgeJobSet{}))
+
+	ws.Route(ws.GET("/purge_jobs/{id}").To(h.DescribePurgeJob).
+		Doc("查询域清除任务详情").
+		Param(ws.PathParameter("id", "任务Id").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", domain.PurgeJob{}))
+
+	ws.Route(ws.GET("/{id}/usage").To(h.DescribeUsage).
+		Doc("查询域的资源用量和配额").
+		Param(ws.PathParameter("id", "identifier of the domain").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", domain.DomainUsage{}))
 }
 
 func init() {
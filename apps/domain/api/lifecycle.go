@@ -0,0 +1,116 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/http/restful/response"
+
+	"github.com/infraboard/mcenter/apps/domain"
+)
+
+func (h *handler) QueryDomain(r *restful.Request, w *restful.Response) {
+	req, err := domain.NewQueryDomainRequestFromHTTP(r.Request)
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest(err.Error()))
+		return
+	}
+
+	set, err := h.service.QueryDoamin(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *handler) ChangeDomainState(r *restful.Request, w *restful.Response) {
+	req := domain.NewChangeDomainStateRequest(r.PathParameter("id"), domain.DOMAIN_STATE_ACTIVE)
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Id = r.PathParameter("id")
+
+	ins, err := h.service.ChangeDomainState(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) DeleteDomain(r *restful.Request, w *restful.Response) {
+	qs := r.Request.URL.Query()
+	req := domain.NewDeleteDomainRequest(r.PathParameter("id"))
+	req.Reason = qs.Get("reason")
+	req.Operator = qs.Get("operator")
+	if v := qs.Get("retain_days"); v != "" {
+		days, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			response.Failed(w, exception.NewBadRequest("invalid retain_days %s", v))
+			return
+		}
+		req.RetainDays = int32(days)
+	}
+
+	ins, err := h.service.DeleteDomain(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) RestoreDomain(r *restful.Request, w *restful.Response) {
+	req := domain.NewRestoreDomainRequest(r.PathParameter("id"))
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Id = r.PathParameter("id")
+
+	ins, err := h.service.RestoreDomain(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) PurgeDomain(r *restful.Request, w *restful.Response) {
+	req := domain.NewPurgeDomainRequest(r.PathParameter("id"))
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Id = r.PathParameter("id")
+
+	ins, err := h.service.PurgeDomain(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) QueryPurgeJob(r *restful.Request, w *restful.Response) {
+	req := domain.NewQueryPurgeJobRequestFromHTTP(r.Request)
+	set, err := h.service.QueryPurgeJob(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *handler) DescribePurgeJob(r *restful.Request, w *restful.Response) {
+	req := domain.NewDescribePurgeJobRequest(r.PathParameter("id"))
+	ins, err := h.service.DescribePurgeJob(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
@@ -0,0 +1,18 @@
+package api
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/http/restful/response"
+
+	"github.com/infraboard/mcenter/apps/domain"
+)
+
+func (h *handler) DescribeUsage(r *restful.Request, w *restful.Response) {
+	req := domain.NewDescribeUsageRequest(r.PathParameter("id"))
+	ins, err := h.service.DescribeUsage(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
@@ -24,7 +24,8 @@ var (
 
 func NewDefaultDomain() *Domain {
 	return &Domain{
-		Spec: NewCreateDomainRequest(),
+		Spec:   NewCreateDomainRequest(),
+		Status: NewDomainStatus(),
 	}
 }
 
@@ -91,14 +92,22 @@ func New(req *CreateDomainRequest) (*Domain, error) {
 		Id:       xid.New().String(),
 		CreateAt: time.Now().UnixMilli(),
 		Spec:     req,
+		Status:   NewDomainStatus(),
 	}
+	d.TrackDormantLock(0)
 
 	return d, nil
 }
 
 // Validate 校验请求是否合法
 func (req *CreateDomainRequest) Validate() error {
-	return validate.Struct(req)
+	if err := validate.Struct(req); err != nil {
+		return err
+	}
+	if err := req.validateAuthSources(); err != nil {
+		return err
+	}
+	return req.validateUserAttributes()
 }
 
 func NewDescribeDomainRequestWithName(name string) *DescribeDomainRequest {
@@ -130,7 +139,10 @@ func (req *UpdateDomainRequest) Validate() error {
 		return fmt.Errorf("id or name required")
 	}
 	if req.UpdateMode.Equal(pb_request.UpdateMode_PUT) {
-		return validate.Struct(req)
+		if err := validate.Struct(req); err != nil {
+			return err
+		}
+		return req.Spec.Validate()
 	}
 
 	return nil
@@ -201,7 +213,7 @@ func NewQueryDomainRequest() *QueryDomainRequest {
 }
 
 // NewQueryDomainRequestFromHTTP todo
-func NewQueryDomainRequestFromHTTP(r *http.Request) *QueryDomainRequest {
+func NewQueryDomainRequestFromHTTP(r *http.Request) (*QueryDomainRequest, error) {
 	query := NewQueryDomainRequest()
 
 	qs := r.URL.Query()
@@ -216,5 +228,34 @@ func NewQueryDomainRequestFromHTTP(r *http.Request) *QueryDomainRequest {
 	if uids != "" {
 		query.Names = strings.Split(dn, ",")
 	}
-	return query
+
+	if v := qs.Get("state"); v != "" {
+		state, err := ParseDOMAIN_STATEFromString(v)
+		if err != nil {
+			return nil, err
+		}
+		query.State = &state
+	}
+	return query, nil
+}
+
+// NewTestAuthSourceRequest 测试认证源
+func NewTestAuthSourceRequest(domainId, name string) *TestAuthSourceRequest {
+	return &TestAuthSourceRequest{
+		DomainId: domainId,
+		Name:     name,
+	}
+}
+
+// Validate 校验请求是否合法
+func (req *TestAuthSourceRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// NewTestAuthSourceResponse 测试结果
+func NewTestAuthSourceResponse(s *AuthSource) *TestAuthSourceResponse {
+	return &TestAuthSourceResponse{
+		Name: s.Name,
+		Type: s.Type,
+	}
 }
@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// 默认的本地用户名映射, 和认证源中的用户名相同
+	DEFAULT_USERNAME_MAPPING = "{username}"
+)
+
+// NewAuthSource 认证源
+func NewAuthSource(name string, t AUTH_SOURCE_TYPE) *AuthSource {
+	s := &AuthSource{
+		Name:             name,
+		Type:             t,
+		Enabled:          true,
+		UsernameSuffixes: []string{},
+		UsernameMapping:  DEFAULT_USERNAME_MAPPING,
+	}
+	switch t {
+	case AUTH_SOURCE_TYPE_LDAP:
+		s.Ldap = NewDefaultConfig()
+	case AUTH_SOURCE_TYPE_OIDC:
+		s.Oidc = NewDefaultOIDCConfig()
+	}
+	return s
+}
+
+// NewDefaultOIDCConfig OIDC默认配置
+func NewDefaultOIDCConfig() *OIDCConfig {
+	return &OIDCConfig{
+		Scopes:        []string{"openid", "profile", "email"},
+		UsernameClaim: "preferred_username",
+		EmailClaim:    "email",
+		NameClaim:     "name",
+	}
+}
+
+// Validate 校验认证源配置
+func (s *AuthSource) Validate() error {
+	if err := validate.Struct(s); err != nil {
+		return err
+	}
+	if s.UsernameMapping != "" && !strings.Contains(s.UsernameMapping, "{username}") {
+		return fmt.Errorf("auth source %s username mapping must contain {username}", s.Name)
+	}
+	if s.UserFilter != "" {
+		if _, err := regexp.Compile(s.UserFilter); err != nil {
+			return fmt.Errorf("auth source %s user filter invalidate, %s", s.Name, err)
+		}
+	}
+
+	switch s.Type {
+	case AUTH_SOURCE_TYPE_LDAP:
+		if s.Ldap == nil {
+			return fmt.Errorf("auth source %s ldap config required", s.Name)
+		}
+		if err := s.Ldap.Validate(); err != nil {
+			return fmt.Errorf("auth source %s ldap config invalidate, %s", s.Name, err)
+		}
+	case AUTH_SOURCE_TYPE_OIDC:
+		if s.Oidc == nil {
+			return fmt.Errorf("auth source %s oidc config required", s.Name)
+		}
+		if err := s.Oidc.Validate(); err != nil {
+			return fmt.Errorf("auth source %s oidc config invalidate, %s", s.Name, err)
+		}
+	}
+	return nil
+}
+
+// MatchSuffix 用户名是否匹配认证源的后缀, 返回匹配的后缀
+func (s *AuthSource) MatchSuffix(username string) (string, bool) {
+	for _, suffix := range s.UsernameSuffixes {
+		if suffix != "" && len(username) > len(suffix) &&
+			strings.EqualFold(username[len(username)-len(suffix):], suffix) {
+			return suffix, true
+		}
+	}
+	return "", false
+}
+
+// SourceUsername 登录时输入的用户名转换为认证源中的用户名
+func (s *AuthSource) SourceUsername(input string) (username, suffix string) {
+	suffix, ok := s.MatchSuffix(input)
+	if ok && s.StripSuffix {
+		return input[:len(input)-len(suffix)], suffix
+	}
+	return input, suffix
+}
+
+// MatchUser 用户名是否满足认证源的用户过滤条件
+func (s *AuthSource) MatchUser(username string) bool {
+	if s.UserFilter == "" {
+		return true
+	}
+	ok, err := regexp.MatchString(s.UserFilter, username)
+	return err == nil && ok
+}
+
+// LocalUsername 认证源中的用户名映射为本地用户名
+func (s *AuthSource) LocalUsername(username, suffix string) string {
+	mapping := s.UsernameMapping
+	if mapping == "" {
+		mapping = DEFAULT_USERNAME_MAPPING
+	}
+	return strings.NewReplacer(
+		"{username}", username,
+		"{suffix}", suffix,
+		"{source}", s.Name,
+	).Replace(mapping)
+}
+
+// Validate 校验OIDC配置
+func (c *OIDCConfig) Validate() error {
+	if c.Issuer == "" && c.TokenUrl == "" {
+		return fmt.Errorf("issuer or token_url required")
+	}
+	if c.ClientId == "" {
+		return fmt.Errorf("client_id required")
+	}
+	return nil
+}
+
+// CanBind 本地用户是否属于该认证源
+// 认证源上线前通过LDAP自动创建的用户没有记录认证源, 只能通过标记为legacy的认证源登录
+func (s *AuthSource) CanBind(userAuthSource string) bool {
+	if userAuthSource == "" {
+		return s.Legacy
+	}
+	return userAuthSource == s.Name
+}
+
+// GetAuthSource 通过名称查询认证源
+func (req *CreateDomainRequest) GetAuthSource(name string) *AuthSource {
+	for i := range req.AuthSources {
+		if req.AuthSources[i].Name == name {
+			return req.AuthSources[i]
+		}
+	}
+	return nil
+}
+
+// MatchAuthSources 登录时需要尝试的认证源, 按照优先级排序
+// 用户名匹配某个认证源的后缀时, 只使用匹配后缀的认证源, 否则使用未配置后缀的认证源
+func (req *CreateDomainRequest) MatchAuthSources(username string) []*AuthSource {
+	matched, general := []*AuthSource{}, []*AuthSource{}
+	for _, s := range req.AuthSources {
+		if !s.Enabled {
+			continue
+		}
+		if _, ok := s.MatchSuffix(username); ok {
+			matched = append(matched, s)
+		} else if len(s.UsernameSuffixes) == 0 {
+			general = append(general, s)
+		}
+	}
+	if len(matched) == 0 {
+		matched = general
+	}
+
+	sources := []*AuthSource{}
+	for _, s := range matched {
+		name, _ := s.SourceUsername(username)
+		if s.MatchUser(name) {
+			sources = append(sources, s)
+		}
+	}
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i].Priority < sources[j].Priority
+	})
+	return sources
+}
+
+// validateAuthSources 认证源名称不能重复, 最多只能有一个legacy认证源
+func (req *CreateDomainRequest) validateAuthSources() error {
+	names, legacy := map[string]bool{}, ""
+	for _, s := range req.AuthSources {
+		if names[s.Name] {
+			return fmt.Errorf("auth source %s duplicate", s.Name)
+		}
+		names[s.Name] = true
+		if s.Legacy {
+			if legacy != "" {
+				return fmt.Errorf("only one legacy auth source allowed, %s and %s", legacy, s.Name)
+			}
+			legacy = s.Name
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,538 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: apps/domain/pb/auth_source.proto
+
+package domain
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 认证源类型
+type AUTH_SOURCE_TYPE int32
+
+const (
+	// 本地数据库
+	AUTH_SOURCE_TYPE_LOCAL AUTH_SOURCE_TYPE = 0
+	// LDAP或者AD
+	AUTH_SOURCE_TYPE_LDAP AUTH_SOURCE_TYPE = 1
+	// 上游OIDC, 通过密码模式认证
+	AUTH_SOURCE_TYPE_OIDC AUTH_SOURCE_TYPE = 2
+)
+
+// Enum value maps for AUTH_SOURCE_TYPE.
+var (
+	AUTH_SOURCE_TYPE_name = map[int32]string{
+		0: "LOCAL",
+		1: "LDAP",
+		2: "OIDC",
+	}
+	AUTH_SOURCE_TYPE_value = map[string]int32{
+		"LOCAL": 0,
+		"LDAP":  1,
+		"OIDC":  2,
+	}
+)
+
+func (x AUTH_SOURCE_TYPE) Enum() *AUTH_SOURCE_TYPE {
+	p := new(AUTH_SOURCE_TYPE)
+	*p = x
+	return p
+}
+
+func (x AUTH_SOURCE_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AUTH_SOURCE_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_domain_pb_auth_source_proto_enumTypes[0].Descriptor()
+}
+
+func (AUTH_SOURCE_TYPE) Type() protoreflect.EnumType {
+	return &file_apps_domain_pb_auth_source_proto_enumTypes[0]
+}
+
+func (x AUTH_SOURCE_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AUTH_SOURCE_TYPE.Descriptor instead.
+func (AUTH_SOURCE_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_apps_domain_pb_auth_source_proto_rawDescGZIP(), []int{0}
+}
+
+// AuthSource 域的认证源, 密码登录时按照优先级依次尝试
+type AuthSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 认证源名称, 域内唯一
+	// @gotags: bson:"name" json:"name" validate:"required,lte=60"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" bson:"name" validate:"required,lte=60"`
+	// 认证源类型
+	// @gotags: bson:"type" json:"type"
+	Type AUTH_SOURCE_TYPE `protobuf:"varint,2,opt,name=type,proto3,enum=infraboard.mcenter.domain.AUTH_SOURCE_TYPE" json:"type" bson:"type"`
+	// 是否启用
+	// @gotags: bson:"enabled" json:"enabled"
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled" bson:"enabled"`
+	// 优先级, 值越小越先尝试
+	// @gotags: bson:"priority" json:"priority"
+	Priority int32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority" bson:"priority"`
+	// 用户名后缀, 比如@sub.example.com, 用户名匹配后缀时只使用匹配的认证源
+	// @gotags: bson:"username_suffixes" json:"username_suffixes"
+	UsernameSuffixes []string `protobuf:"bytes,5,rep,name=username_suffixes,json=usernameSuffixes,proto3" json:"username_suffixes" bson:"username_suffixes"`
+	// 认证时是否去掉用户名后缀
+	// @gotags: bson:"strip_suffix" json:"strip_suffix"
+	StripSuffix bool `protobuf:"varint,6,opt,name=strip_suffix,json=stripSuffix,proto3" json:"strip_suffix" bson:"strip_suffix"`
+	// 用户名过滤, 正则表达式, 为空时不过滤, 只有匹配的用户名才使用该认证源
+	// @gotags: bson:"user_filter" json:"user_filter"
+	UserFilter string `protobuf:"bytes,7,opt,name=user_filter,json=userFilter,proto3" json:"user_filter" bson:"user_filter"`
+	// 本地用户名映射, 支持{username}, {suffix}, {source}, 默认为{username}
+	// @gotags: bson:"username_mapping" json:"username_mapping"
+	UsernameMapping string `protobuf:"bytes,8,opt,name=username_mapping,json=usernameMapping,proto3" json:"username_mapping" bson:"username_mapping"`
+	// 描述
+	// @gotags: bson:"description" json:"description"
+	Description string `protobuf:"bytes,9,opt,name=description,proto3" json:"description" bson:"description"`
+	// LDAP认证源配置
+	// @gotags: bson:"ldap" json:"ldap,omitempty"
+	Ldap *LdapConfig `protobuf:"bytes,10,opt,name=ldap,proto3" json:"ldap,omitempty" bson:"ldap"`
+	// OIDC认证源配置
+	// @gotags: bson:"oidc" json:"oidc,omitempty"
+	Oidc *OIDCConfig `protobuf:"bytes,11,opt,name=oidc,proto3" json:"oidc,omitempty" bson:"oidc"`
+	// 认证源上线前已经存在的外部用户(没有记录认证源)只能通过该认证源登录, 每个域最多一个
+	// @gotags: bson:"legacy" json:"legacy"
+	Legacy bool `protobuf:"varint,12,opt,name=legacy,proto3" json:"legacy" bson:"legacy"`
+}
+
+func (x *AuthSource) Reset() {
+	*x = AuthSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_auth_source_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthSource) ProtoMessage() {}
+
+func (x *AuthSource) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_auth_source_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthSource.ProtoReflect.Descriptor instead.
+func (*AuthSource) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_auth_source_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuthSource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthSource) GetType() AUTH_SOURCE_TYPE {
+	if x != nil {
+		return x.Type
+	}
+	return AUTH_SOURCE_TYPE_LOCAL
+}
+
+func (x *AuthSource) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AuthSource) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *AuthSource) GetUsernameSuffixes() []string {
+	if x != nil {
+		return x.UsernameSuffixes
+	}
+	return nil
+}
+
+func (x *AuthSource) GetStripSuffix() bool {
+	if x != nil {
+		return x.StripSuffix
+	}
+	return false
+}
+
+func (x *AuthSource) GetUserFilter() string {
+	if x != nil {
+		return x.UserFilter
+	}
+	return ""
+}
+
+func (x *AuthSource) GetUsernameMapping() string {
+	if x != nil {
+		return x.UsernameMapping
+	}
+	return ""
+}
+
+func (x *AuthSource) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AuthSource) GetLdap() *LdapConfig {
+	if x != nil {
+		return x.Ldap
+	}
+	return nil
+}
+
+func (x *AuthSource) GetOidc() *OIDCConfig {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *AuthSource) GetLegacy() bool {
+	if x != nil {
+		return x.Legacy
+	}
+	return false
+}
+
+// OIDCConfig 上游OIDC配置, 使用Resource Owner Password Credentials模式认证
+type OIDCConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Issuer地址, 未配置token_url时通过/.well-known/openid-configuration发现
+	// @gotags: bson:"issuer" json:"issuer"
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer" bson:"issuer"`
+	// Token端点
+	// @gotags: bson:"token_url" json:"token_url"
+	TokenUrl string `protobuf:"bytes,2,opt,name=token_url,json=tokenUrl,proto3" json:"token_url" bson:"token_url"`
+	// UserInfo端点, 为空时从id_token中读取用户信息
+	// @gotags: bson:"userinfo_url" json:"userinfo_url"
+	UserinfoUrl string `protobuf:"bytes,3,opt,name=userinfo_url,json=userinfoUrl,proto3" json:"userinfo_url" bson:"userinfo_url"`
+	// 客户端Id
+	// @gotags: bson:"client_id" json:"client_id"
+	ClientId string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id" bson:"client_id"`
+	// 客户端凭证
+	// @gotags: bson:"client_secret" json:"client_secret"
+	ClientSecret string `protobuf:"bytes,5,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret" bson:"client_secret"`
+	// 申请的scope, 默认为openid profile email
+	// @gotags: bson:"scopes" json:"scopes"
+	Scopes []string `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes" bson:"scopes"`
+	// 用户名对应的claim, 默认为preferred_username
+	// @gotags: bson:"username_claim" json:"username_claim"
+	UsernameClaim string `protobuf:"bytes,7,opt,name=username_claim,json=usernameClaim,proto3" json:"username_claim" bson:"username_claim"`
+	// 邮箱对应的claim, 默认为email
+	// @gotags: bson:"email_claim" json:"email_claim"
+	EmailClaim string `protobuf:"bytes,8,opt,name=email_claim,json=emailClaim,proto3" json:"email_claim" bson:"email_claim"`
+	// 显示名称对应的claim, 默认为name
+	// @gotags: bson:"name_claim" json:"name_claim"
+	NameClaim string `protobuf:"bytes,9,opt,name=name_claim,json=nameClaim,proto3" json:"name_claim" bson:"name_claim"`
+	// 是否跳过TLS证书校验
+	// @gotags: bson:"skip_verify" json:"skip_verify"
+	SkipVerify bool `protobuf:"varint,10,opt,name=skip_verify,json=skipVerify,proto3" json:"skip_verify" bson:"skip_verify"`
+	// id_token签名公钥(JWKS)地址, 为空时通过服务发现获取, 从id_token读取用户信息时用于校验签名
+	// @gotags: bson:"jwks_url" json:"jwks_url"
+	JwksUrl string `protobuf:"bytes,11,opt,name=jwks_url,json=jwksUrl,proto3" json:"jwks_url" bson:"jwks_url"`
+}
+
+func (x *OIDCConfig) Reset() {
+	*x = OIDCConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_auth_source_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCConfig) ProtoMessage() {}
+
+func (x *OIDCConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_auth_source_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCConfig.ProtoReflect.Descriptor instead.
+func (*OIDCConfig) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_auth_source_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OIDCConfig) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetUserinfoUrl() string {
+	if x != nil {
+		return x.UserinfoUrl
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OIDCConfig) GetUsernameClaim() string {
+	if x != nil {
+		return x.UsernameClaim
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetEmailClaim() string {
+	if x != nil {
+		return x.EmailClaim
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetNameClaim() string {
+	if x != nil {
+		return x.NameClaim
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetSkipVerify() bool {
+	if x != nil {
+		return x.SkipVerify
+	}
+	return false
+}
+
+func (x *OIDCConfig) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+var File_apps_domain_pb_auth_source_proto protoreflect.FileDescriptor
+
+var file_apps_domain_pb_auth_source_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x62,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x19, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x1a, 0x19, 0x61,
+	0x70, 0x70, 0x73, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x6c, 0x64,
+	0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x03, 0x0a, 0x0a, 0x41, 0x75, 0x74,
+	0x68, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x72,
+	0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73,
+	0x75, 0x66, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x70, 0x53, 0x75, 0x66, 0x66,
+	0x69, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x39, 0x0a, 0x04, 0x6c, 0x64, 0x61, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x64, 0x61, 0x70, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x6c, 0x64, 0x61, 0x70, 0x12, 0x39, 0x0a, 0x04, 0x6f,
+	0x69, 0x64, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x66, 0x72,
+	0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x22, 0xe1,
+	0x02, 0x0a, 0x0a, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55,
+	0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x69, 0x6e,
+	0x66, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6b, 0x69,
+	0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x77, 0x6b, 0x73, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x77, 0x6b, 0x73, 0x55,
+	0x72, 0x6c, 0x2a, 0x31, 0x0a, 0x10, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x44, 0x41, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4f,
+	0x49, 0x44, 0x43, 0x10, 0x02, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_domain_pb_auth_source_proto_rawDescOnce sync.Once
+	file_apps_domain_pb_auth_source_proto_rawDescData = file_apps_domain_pb_auth_source_proto_rawDesc
+)
+
+func file_apps_domain_pb_auth_source_proto_rawDescGZIP() []byte {
+	file_apps_domain_pb_auth_source_proto_rawDescOnce.Do(func() {
+		file_apps_domain_pb_auth_source_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_domain_pb_auth_source_proto_rawDescData)
+	})
+	return file_apps_domain_pb_auth_source_proto_rawDescData
+}
+
+var file_apps_domain_pb_auth_source_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_apps_domain_pb_auth_source_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_apps_domain_pb_auth_source_proto_goTypes = []interface{}{
+	(AUTH_SOURCE_TYPE)(0), // 0: infraboard.mcenter.domain.AUTH_SOURCE_TYPE
+	(*AuthSource)(nil),    // 1: infraboard.mcenter.domain.AuthSource
+	(*OIDCConfig)(nil),    // 2: infraboard.mcenter.domain.OIDCConfig
+	(*LdapConfig)(nil),    // 3: infraboard.mcenter.domain.LdapConfig
+}
+var file_apps_domain_pb_auth_source_proto_depIdxs = []int32{
+	0, // 0: infraboard.mcenter.domain.AuthSource.type:type_name -> infraboard.mcenter.domain.AUTH_SOURCE_TYPE
+	3, // 1: infraboard.mcenter.domain.AuthSource.ldap:type_name -> infraboard.mcenter.domain.LdapConfig
+	2, // 2: infraboard.mcenter.domain.AuthSource.oidc:type_name -> infraboard.mcenter.domain.OIDCConfig
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_apps_domain_pb_auth_source_proto_init() }
+func file_apps_domain_pb_auth_source_proto_init() {
+	if File_apps_domain_pb_auth_source_proto != nil {
+		return
+	}
+	file_apps_domain_pb_ldap_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_apps_domain_pb_auth_source_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_auth_source_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_domain_pb_auth_source_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apps_domain_pb_auth_source_proto_goTypes,
+		DependencyIndexes: file_apps_domain_pb_auth_source_proto_depIdxs,
+		EnumInfos:         file_apps_domain_pb_auth_source_proto_enumTypes,
+		MessageInfos:      file_apps_domain_pb_auth_source_proto_msgTypes,
+	}.Build()
+	File_apps_domain_pb_auth_source_proto = out.File
+	file_apps_domain_pb_auth_source_proto_rawDesc = nil
+	file_apps_domain_pb_auth_source_proto_goTypes = nil
+	file_apps_domain_pb_auth_source_proto_depIdxs = nil
+}
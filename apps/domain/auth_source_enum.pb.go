@@ -0,0 +1,55 @@
+// Code generated by github.com/infraboard/mcube
+// DO NOT EDIT
+
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseAUTH_SOURCE_TYPEFromString Parse AUTH_SOURCE_TYPE from string
+func ParseAUTH_SOURCE_TYPEFromString(str string) (AUTH_SOURCE_TYPE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := AUTH_SOURCE_TYPE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown AUTH_SOURCE_TYPE: %s", str)
+	}
+
+	return AUTH_SOURCE_TYPE(v), nil
+}
+
+// Equal type compare
+func (t AUTH_SOURCE_TYPE) Equal(target AUTH_SOURCE_TYPE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t AUTH_SOURCE_TYPE) IsIn(targets ...AUTH_SOURCE_TYPE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t AUTH_SOURCE_TYPE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *AUTH_SOURCE_TYPE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseAUTH_SOURCE_TYPEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
@@ -0,0 +1,83 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/domain"
+)
+
+func TestMatchAuthSources(t *testing.T) {
+	should := assert.New(t)
+
+	local := domain.NewAuthSource("local", domain.AUTH_SOURCE_TYPE_LOCAL)
+	local.Priority = 10
+	corp := domain.NewAuthSource("corp", domain.AUTH_SOURCE_TYPE_LDAP)
+	corp.Priority = 1
+	sub := domain.NewAuthSource("sub", domain.AUTH_SOURCE_TYPE_LDAP)
+	sub.UsernameSuffixes = []string{"@sub.example.com"}
+	sub.StripSuffix = true
+	sub.UsernameMapping = "{username}{suffix}"
+	disabled := domain.NewAuthSource("disabled", domain.AUTH_SOURCE_TYPE_OIDC)
+	disabled.Enabled = false
+	admin := domain.NewAuthSource("admin", domain.AUTH_SOURCE_TYPE_LOCAL)
+	admin.UserFilter = "^admin$"
+	admin.Priority = -1
+
+	req := domain.NewCreateDomainRequest()
+	req.AuthSources = []*domain.AuthSource{local, corp, sub, disabled, admin}
+
+	should.Equal([]*domain.AuthSource{corp, local}, req.MatchAuthSources("alice"))
+	should.Equal([]*domain.AuthSource{admin, corp, local}, req.MatchAuthSources("admin"))
+	should.Equal([]*domain.AuthSource{sub}, req.MatchAuthSources("alice@SUB.example.com"))
+
+	username, suffix := sub.SourceUsername("alice@sub.example.com")
+	should.Equal("alice", username)
+	should.Equal("alice@sub.example.com", sub.LocalUsername(username, suffix))
+	should.Equal("alice", corp.LocalUsername("alice", ""))
+}
+
+func TestValidateAuthSources(t *testing.T) {
+	should := assert.New(t)
+
+	req := domain.NewCreateDomainRequest()
+	req.Name = domain.DEFAULT_DOMAIN
+	req.AuthSources = []*domain.AuthSource{
+		domain.NewAuthSource("local", domain.AUTH_SOURCE_TYPE_LOCAL),
+		domain.NewAuthSource("local", domain.AUTH_SOURCE_TYPE_LOCAL),
+	}
+	should.Error(req.Validate())
+
+	oidc := domain.NewAuthSource("oidc", domain.AUTH_SOURCE_TYPE_OIDC)
+	req.AuthSources = []*domain.AuthSource{oidc}
+	should.Error(req.Validate())
+
+	oidc.Oidc.Issuer = "https://sso.example.com"
+	oidc.Oidc.ClientId = "mcenter"
+	should.NoError(req.Validate())
+
+	oidc.UsernameMapping = "{source}"
+	should.Error(req.Validate())
+}
+
+func TestLegacyAuthSource(t *testing.T) {
+	should := assert.New(t)
+
+	corp := domain.NewAuthSource("corp", domain.AUTH_SOURCE_TYPE_LOCAL)
+	sub := domain.NewAuthSource("sub", domain.AUTH_SOURCE_TYPE_LOCAL)
+
+	// 没有记录认证源的用户只能通过legacy认证源登录
+	should.False(corp.CanBind(""))
+	corp.Legacy = true
+	should.True(corp.CanBind(""))
+	should.True(sub.CanBind("sub"))
+	should.False(sub.CanBind("corp"))
+
+	req := domain.NewCreateDomainRequest()
+	req.Name = domain.DEFAULT_DOMAIN
+	req.AuthSources = []*domain.AuthSource{corp, sub}
+	should.NoError(req.Validate())
+	sub.Legacy = true
+	should.Error(req.Validate())
+}
@@ -0,0 +1,70 @@
+// Package authsource 域认证源的认证实现, 支持本地数据库、LDAP和上游OIDC
+package authsource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+var (
+	AUTH_FAILED = exception.NewUnauthorized("user or password not connrect")
+)
+
+// Identity 认证成功后认证源返回的用户信息
+type Identity struct {
+	// 认证源中的用户名
+	Username string
+	// 邮箱
+	Email string
+	// 显示名称
+	DisplayName string
+	// 用户在认证源中的Id, 比如LDAP的DN, OIDC的sub
+	ExternalId string
+	// 本地认证源认证的用户
+	User *user.User
+}
+
+// Authenticator 认证源
+type Authenticator interface {
+	// 检查认证源是否可以连接
+	CheckConnect(context.Context) error
+	// 使用认证源中的用户名和密码认证
+	Authenticate(ctx context.Context, username, password string) (*Identity, error)
+}
+
+// New 根据认证源的类型创建认证器, 本地认证源需要查询域内的用户
+func New(dom string, s *domain.AuthSource, users user.Service) (Authenticator, error) {
+	switch s.Type {
+	case domain.AUTH_SOURCE_TYPE_LOCAL:
+		return &local{domain: dom, user: users}, nil
+	case domain.AUTH_SOURCE_TYPE_LDAP:
+		if s.Ldap == nil {
+			return nil, fmt.Errorf("auth source %s ldap config required", s.Name)
+		}
+		return newLDAP(s.Ldap), nil
+	case domain.AUTH_SOURCE_TYPE_OIDC:
+		if s.Oidc == nil {
+			return nil, fmt.Errorf("auth source %s oidc config required", s.Name)
+		}
+		return newOIDC(s.Oidc), nil
+	default:
+		return nil, fmt.Errorf("unknown auth source type %s", s.Type)
+	}
+}
+
+// Provider 认证源对应的用户来源
+func Provider(t domain.AUTH_SOURCE_TYPE) user.PROVIDER {
+	switch t {
+	case domain.AUTH_SOURCE_TYPE_LDAP:
+		return user.PROVIDER_LDAP
+	case domain.AUTH_SOURCE_TYPE_OIDC:
+		return user.PROVIDER_OIDC
+	default:
+		return user.PROVIDER_LOCAL
+	}
+}
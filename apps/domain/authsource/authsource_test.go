@@ -0,0 +1,240 @@
+package authsource_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/infraboard/mcube/logger/zap"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/domain/authsource"
+	"github.com/infraboard/mcenter/apps/token/provider/ldap/ldaptest"
+)
+
+var (
+	ctx = context.Background()
+)
+
+func TestLDAPAuthenticate(t *testing.T) {
+	should := assert.New(t)
+
+	s, err := ldaptest.NewServer("cn=admin,dc=example,dc=org", "admin")
+	if !should.NoError(err) {
+		return
+	}
+	defer s.Close()
+	s.Add(ldaptest.NewEntry("uid=alice,ou=people,dc=example,dc=org").
+		Set("objectClass", "inetOrgPerson").
+		Set("uid", "alice").
+		Set("mail", "alice@example.org").
+		Set("displayName", "Alice").
+		Set("userPassword", "secret"))
+
+	as := domain.NewAuthSource("corp", domain.AUTH_SOURCE_TYPE_LDAP)
+	as.Ldap.Url = s.URL()
+	as.Ldap.BindDn = s.BindDN
+	as.Ldap.BindPassword = s.BindPassword
+	as.Ldap.BaseDn = "dc=example,dc=org"
+
+	a, err := authsource.New(domain.DEFAULT_DOMAIN, as, nil)
+	if !should.NoError(err) {
+		return
+	}
+	should.NoError(a.CheckConnect(ctx))
+
+	id, err := a.Authenticate(ctx, "alice", "secret")
+	if should.NoError(err) {
+		should.Equal("alice", id.Username)
+		should.Equal("alice@example.org", id.Email)
+		should.Equal("Alice", id.DisplayName)
+		should.Equal("uid=alice,ou=people,dc=example,dc=org", id.ExternalId)
+	}
+
+	_, err = a.Authenticate(ctx, "alice", "wrong")
+	should.Error(err)
+	_, err = a.Authenticate(ctx, "alice", "")
+	should.Error(err)
+}
+
+func TestOIDCAuthenticate(t *testing.T) {
+	should := assert.New(t)
+
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{
+			"issuer":            s.URL,
+			"token_endpoint":    s.URL + "/token",
+			"userinfo_endpoint": s.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		_ = r.ParseForm()
+		if r.PostForm.Get("grant_type") != "password" ||
+			r.PostForm.Get("username") != "bob" || r.PostForm.Get("password") != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		writeJSON(w, map[string]interface{}{
+			"access_token": "bob-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer bob-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, map[string]string{
+			"sub":   "10001",
+			"login": "bob",
+			"email": "bob@example.org",
+			"name":  "Bob",
+		})
+	})
+
+	as := domain.NewAuthSource("sso", domain.AUTH_SOURCE_TYPE_OIDC)
+	as.Oidc.Issuer = s.URL
+	as.Oidc.ClientId = "mcenter"
+	as.Oidc.UsernameClaim = "login"
+
+	a, err := authsource.New(domain.DEFAULT_DOMAIN, as, nil)
+	if !should.NoError(err) {
+		return
+	}
+	should.NoError(a.CheckConnect(ctx))
+
+	id, err := a.Authenticate(ctx, "bob", "secret")
+	if should.NoError(err) {
+		should.Equal("bob", id.Username)
+		should.Equal("bob@example.org", id.Email)
+		should.Equal("Bob", id.DisplayName)
+		should.Equal("10001", id.ExternalId)
+	}
+
+	_, err = a.Authenticate(ctx, "bob", "wrong")
+	should.Equal(authsource.AUTH_FAILED, err)
+}
+
+func TestOIDCIDToken(t *testing.T) {
+	should := assert.New(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if !should.NoError(err) {
+		return
+	}
+
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	// 每次申请令牌时返回的id_token
+	var claims map[string]interface{}
+	signKey := key
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{
+			"issuer":         s.URL,
+			"token_endpoint": s.URL + "/token",
+			"jwks_uri":       s.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"keys": []map[string]string{{
+			"kid": "k1",
+			"kty": "RSA",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"access_token": "carol-token",
+			"token_type":   "Bearer",
+			"id_token":     signIDToken(t, signKey, claims),
+		})
+	})
+
+	as := domain.NewAuthSource("sso", domain.AUTH_SOURCE_TYPE_OIDC)
+	as.Oidc.Issuer = s.URL
+	as.Oidc.ClientId = "mcenter"
+	a, err := authsource.New(domain.DEFAULT_DOMAIN, as, nil)
+	if !should.NoError(err) {
+		return
+	}
+
+	claims = map[string]interface{}{
+		"iss":                s.URL,
+		"aud":                "mcenter",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"sub":                "10002",
+		"preferred_username": "carol",
+	}
+	id, err := a.Authenticate(ctx, "carol", "secret")
+	if should.NoError(err) {
+		should.Equal("carol", id.Username)
+		should.Equal("10002", id.ExternalId)
+	}
+
+	// 受众不匹配
+	claims["aud"] = "other"
+	_, err = a.Authenticate(ctx, "carol", "secret")
+	should.Error(err)
+
+	// 签发者不匹配
+	claims["aud"] = []string{"other", "mcenter"}
+	claims["iss"] = "https://evil.example.com"
+	_, err = a.Authenticate(ctx, "carol", "secret")
+	should.Error(err)
+
+	// 已过期
+	claims["iss"] = s.URL
+	claims["exp"] = time.Now().Add(-time.Hour).Unix()
+	_, err = a.Authenticate(ctx, "carol", "secret")
+	should.Error(err)
+
+	// 其他密钥签名
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	signKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+	_, err = a.Authenticate(ctx, "carol", "secret")
+	should.Error(err)
+}
+
+func signIDToken(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "k1", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func init() {
+	zap.DevelopmentSetup()
+}
@@ -0,0 +1,237 @@
+package authsource
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	// 注册签名使用的hash算法
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+const (
+	// 校验过期时间时允许的时钟误差
+	ID_TOKEN_CLOCK_SKEW = time.Minute
+)
+
+// jwk JSON Web Key, 只支持RSA和EC公钥
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwkSet struct {
+	Keys []*jwk `json:"keys"`
+}
+
+// get 按照kid查找公钥, id_token没有kid时只允许JWKS中有一个公钥
+func (s *jwkSet) get(kid string) (*jwk, error) {
+	if kid == "" && len(s.Keys) == 1 {
+		return s.Keys[0], nil
+	}
+	for _, k := range s.Keys {
+		if k.Kid == kid {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("jwks key %s not found", kid)
+}
+
+func (k *jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported ec curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+func decodeBigInt(v string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(v)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// verifyIDToken 使用上游的JWKS校验id_token的签名, 签发者, 受众和有效期, 校验通过后返回claims
+func (o *oidcSource) verifyIDToken(ctx context.Context, v interface{}, jwksURL string) (map[string]interface{}, error) {
+	raw, _ := v.(string)
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("token response has no id_token")
+	}
+	if jwksURL == "" {
+		return nil, fmt.Errorf("jwks_url required to verify id_token")
+	}
+
+	header := struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}{}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("decode id_token header error, %s", err)
+	}
+
+	keys := &jwkSet{}
+	if err := o.getJSON(ctx, jwksURL, "", keys); err != nil {
+		return nil, fmt.Errorf("get jwks error, %s", err)
+	}
+	key, err := keys.get(header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := key.publicKey()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("decode id_token signature error, %s", err)
+	}
+	if err := verifySignature(header.Alg, pub, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	claims := map[string]interface{}{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("decode id_token payload error, %s", err)
+	}
+	if err := checkIDTokenClaims(claims, o.conf.Issuer, o.conf.ClientId, time.Now()); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// verifySignature 只支持非对称签名算法, 不接受none和HMAC
+func verifySignature(alg string, pub crypto.PublicKey, signed string, sig []byte) error {
+	if len(alg) != 5 {
+		return fmt.Errorf("unsupported id_token alg %s", alg)
+	}
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported id_token alg %s", alg)
+	}
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch {
+	case strings.HasPrefix(alg, "RS"), strings.HasPrefix(alg, "PS"):
+		key, ok := pub.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("id_token alg %s not match key type", alg)
+		}
+		if alg[0] == 'P' {
+			return rsa.VerifyPSS(key, hash, digest, sig, nil)
+		}
+		return rsa.VerifyPKCS1v15(key, hash, digest, sig)
+	case strings.HasPrefix(alg, "ES"):
+		key, ok := pub.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("id_token alg %s not match key type", alg)
+		}
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return fmt.Errorf("id_token signature length invalid")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return fmt.Errorf("id_token signature invalid")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported id_token alg %s", alg)
+	}
+}
+
+// checkIDTokenClaims 签发者必须和配置的issuer一致, 受众必须包含client_id, 并且未过期
+func checkIDTokenClaims(claims map[string]interface{}, issuer, clientId string, now time.Time) error {
+	iss, _ := claims["iss"].(string)
+	if issuer == "" || strings.TrimSuffix(iss, "/") != strings.TrimSuffix(issuer, "/") {
+		return fmt.Errorf("id_token issuer %s not match %s", iss, issuer)
+	}
+
+	matched := false
+	switch aud := claims["aud"].(type) {
+	case string:
+		matched = aud == clientId
+	case []interface{}:
+		for _, a := range aud {
+			if a == clientId {
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		return fmt.Errorf("id_token audience not contains %s", clientId)
+	}
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return fmt.Errorf("id_token has no exp")
+	}
+	if now.After(time.Unix(int64(exp), 0).Add(ID_TOKEN_CLOCK_SKEW)) {
+		return fmt.Errorf("id_token is expired")
+	}
+	return nil
+}
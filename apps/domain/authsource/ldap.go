@@ -0,0 +1,39 @@
+package authsource
+
+import (
+	"context"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/token/provider/ldap"
+)
+
+// ldapSource LDAP或者AD认证
+type ldapSource struct {
+	p *ldap.Provider
+}
+
+func newLDAP(conf *domain.LdapConfig) *ldapSource {
+	return &ldapSource{p: ldap.NewProvider(conf)}
+}
+
+func (l *ldapSource) CheckConnect(ctx context.Context) error {
+	return l.p.CheckConnect()
+}
+
+func (l *ldapSource) Authenticate(ctx context.Context, username, password string) (*Identity, error) {
+	// 空密码会被LDAP当作匿名绑定
+	if password == "" {
+		return nil, AUTH_FAILED
+	}
+
+	u, err := l.p.CheckUserPassword(username, password)
+	if err != nil {
+		return nil, err
+	}
+	return &Identity{
+		Username:    u.Username,
+		Email:       u.Email(),
+		DisplayName: u.DisplayName,
+		ExternalId:  u.DN,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package authsource
+
+import (
+	"context"
+
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// local 本地数据库认证
+type local struct {
+	domain string
+	user   user.Service
+}
+
+func (l *local) CheckConnect(ctx context.Context) error {
+	return nil
+}
+
+func (l *local) Authenticate(ctx context.Context, username, password string) (*Identity, error) {
+	u, err := l.user.DescribeUser(ctx, user.NewDescriptUserRequestWithDomainName(l.domain, username))
+	if err != nil {
+		return nil, err
+	}
+	// 外部认证源同步的用户, 本地密码为随机生成, 不允许通过本地数据库认证
+	if !u.Spec.Provider.Equal(user.PROVIDER_LOCAL) {
+		return nil, AUTH_FAILED
+	}
+	if err := u.Password.CheckPassword(password); err != nil {
+		return nil, err
+	}
+
+	id := &Identity{
+		Username:   u.Spec.Username,
+		ExternalId: u.Id,
+		User:       u,
+	}
+	if u.Profile != nil {
+		id.Email = u.Profile.Email
+		id.DisplayName = u.Profile.NickName
+	}
+	return id, nil
+}
@@ -0,0 +1,74 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/domain/authsource"
+)
+
+func (s *service) TestAuthSourceConnection(ctx context.Context, req *domain.TestAuthSourceRequest) (*domain.TestAuthSourceResponse, error) {
+	d, as, a, err := s.getAuthenticator(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := domain.NewTestAuthSourceResponse(as)
+	start := time.Now()
+	if err := a.CheckConnect(ctx); err != nil {
+		return nil, exception.NewBadRequest("domain %s auth source %s connect error, %s", d.Spec.Name, as.Name, err)
+	}
+	resp.Took = time.Since(start).Milliseconds()
+	return resp, nil
+}
+
+func (s *service) TestAuthSourceLogin(ctx context.Context, req *domain.TestAuthSourceRequest) (*domain.TestAuthSourceResponse, error) {
+	if req.Username == "" || req.Password == "" {
+		return nil, exception.NewBadRequest("username and password required")
+	}
+
+	d, as, a, err := s.getAuthenticator(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := domain.NewTestAuthSourceResponse(as)
+	start := time.Now()
+	username, suffix := as.SourceUsername(req.Username)
+	id, err := a.Authenticate(ctx, username, req.Password)
+	if err != nil {
+		return nil, exception.NewBadRequest("domain %s auth source %s login error, %s", d.Spec.Name, as.Name, err)
+	}
+	resp.Took = time.Since(start).Milliseconds()
+	resp.Username = id.Username
+	resp.LocalUsername = as.LocalUsername(id.Username, suffix)
+	resp.Email = id.Email
+	resp.DisplayName = id.DisplayName
+	resp.ExternalId = id.ExternalId
+	return resp, nil
+}
+
+// getAuthenticator 查询域的认证源
+func (s *service) getAuthenticator(ctx context.Context, req *domain.TestAuthSourceRequest) (*domain.Domain, *domain.AuthSource, authsource.Authenticator, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, nil, exception.NewBadRequest(err.Error())
+	}
+
+	d, err := s.DescribeDomain(ctx, domain.NewDescribeDomainRequestById(req.DomainId))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	as := d.Spec.GetAuthSource(req.Name)
+	if as == nil {
+		return nil, nil, nil, exception.NewNotFound("domain %s auth source %s not found", d.Spec.Name, req.Name)
+	}
+
+	a, err := authsource.New(d.Spec.Name, as, s.user)
+	if err != nil {
+		return nil, nil, nil, exception.NewBadRequest(err.Error())
+	}
+	return d, as, a, nil
+}
@@ -42,5 +42,15 @@ func (r *queryRequest) FindFilter() bson.M {
 		filter["spec.name"] = bson.M{"$in": r.Names}
 	}
 
+	// 老数据没有状态字段, 视为正常
+	switch {
+	case r.State != nil && r.State.Equal(domain.DOMAIN_STATE_ACTIVE):
+		filter["status.state"] = bson.M{"$nin": []domain.DOMAIN_STATE{domain.DOMAIN_STATE_DISABLED, domain.DOMAIN_STATE_DELETED}}
+	case r.State != nil:
+		filter["status.state"] = *r.State
+	default:
+		filter["status.state"] = bson.M{"$ne": domain.DOMAIN_STATE_DELETED}
+	}
+
 	return filter
 }
@@ -96,6 +96,7 @@ func (s *service) UpdateDomain(ctx context.Context, req *domain.UpdateDomainRequ
 		return nil, err
 	}
 
+	dormantLockDays := d.DormantLockDays()
 	switch req.UpdateMode {
 	case request.UpdateMode_PUT:
 		d.Spec = req.Spec
@@ -104,12 +105,13 @@ func (s *service) UpdateDomain(ctx context.Context, req *domain.UpdateDomainRequ
 			return nil, err
 		}
 		if err := d.Spec.Validate(); err != nil {
-			return nil, nil
+			return nil, exception.NewBadRequest(err.Error())
 		}
 	default:
 		return nil, exception.NewBadRequest("unknown update mode: %s", req.UpdateMode)
 	}
 
+	d.TrackDormantLock(dormantLockDays)
 	d.UpdateAt = time.Now().UnixMilli()
 	_, err = s.col.UpdateOne(ctx, bson.M{"_id": d.Id}, bson.M{"$set": d})
 	if err != nil {
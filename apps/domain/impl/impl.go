@@ -4,12 +4,23 @@ import (
 	"context"
 
 	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/x/bsonx"
 	"google.golang.org/grpc"
 
 	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/instance"
+	"github.com/infraboard/mcenter/apps/lease"
+	"github.com/infraboard/mcenter/apps/namespace"
+	"github.com/infraboard/mcenter/apps/policy"
+	"github.com/infraboard/mcenter/apps/role"
+	meta "github.com/infraboard/mcenter/apps/service"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
 	"github.com/infraboard/mcenter/conf"
 )
 
@@ -20,7 +31,28 @@ var (
 
 type service struct {
 	col *mongo.Collection
+	job *mongo.Collection
+	log logger.Logger
 	domain.UnimplementedRPCServer
+
+	user   user.Service
+	token  token.Service
+	lease  lease.Service
+	runner *lease.JobRunner
+	// 清除域时按照顺序删除的资源
+	cleaners []*cleaner
+	// 受配额限制的资源
+	counters []*counter
+}
+
+type cleaner struct {
+	resource string
+	domain.ResourceCleaner
+}
+
+type counter struct {
+	resource domain.QUOTA_RESOURCE
+	domain.ResourceCounter
 }
 
 func (s *service) Config() error {
@@ -48,7 +80,61 @@ func (s *service) Config() error {
 	}
 
 	s.col = dc
+	if err := s.trackDormantLock(context.Background()); err != nil {
+		return err
+	}
+
+	jc := db.Collection("domain_purge_job")
+	jobIndexs := []mongo.IndexModel{
+		{
+			Keys: bsonx.Doc{{Key: "create_at", Value: bsonx.Int32(-1)}},
+		},
+		{
+			Keys: bsonx.Doc{{Key: "domain_id", Value: bsonx.Int32(-1)}},
+		},
+		{
+			// 同一个域同时只能有一个未完成的清除任务
+			Keys: bsonx.Doc{{Key: "domain_id", Value: bsonx.Int32(1)}},
+			Options: options.Index().SetName("uniq_active_domain_job").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": bson.M{"$lte": domain.PURGE_STATUS_RUNNING}}),
+		},
+	}
+	if _, err := jc.Indexes().CreateMany(context.Background(), jobIndexs); err != nil {
+		return err
+	}
+	s.job = jc
+
+	s.log = zap.L().Named(domain.AppName)
+	s.user = app.GetInternalApp(user.AppName).(user.Service)
+	s.token = app.GetInternalApp(token.AppName).(token.Service)
+	s.lease = app.GetInternalApp(lease.AppName).(lease.Service)
+	s.runner = lease.NewJobRunner(s.lease, "domain_purge_job")
+	insSvc := app.GetInternalApp(instance.AppName).(instance.Service)
+	metaSvc := app.GetInternalApp(meta.AppName).(meta.MetaService)
+	roleSvc := app.GetInternalApp(role.AppName).(role.Service)
+	nsSvc := app.GetInternalApp(namespace.AppName).(namespace.Service)
+	// 先删除令牌让用户立即下线, 最后删除用户
+	s.cleaners = []*cleaner{
+		{"token", s.token},
+		{"instance", insSvc},
+		{"service", metaSvc},
+		{"policy", app.GetInternalApp(policy.AppName).(policy.Service)},
+		{"role", roleSvc},
+		{"namespace", nsSvc},
+		{"user", s.user},
+	}
+	s.counters = []*counter{
+		{domain.QUOTA_RESOURCE_USER, s.user},
+		{domain.QUOTA_RESOURCE_NAMESPACE, nsSvc},
+		{domain.QUOTA_RESOURCE_SERVICE, metaSvc},
+		{domain.QUOTA_RESOURCE_INSTANCE, insSvc},
+		{domain.QUOTA_RESOURCE_CUSTOM_ROLE, roleSvc},
+		{domain.QUOTA_RESOURCE_PRIVATE_TOKEN, s.token},
+	}
 
+	// 后台清除保留期到期的域, 执行清除任务的副本退出后, 未完成的任务标记为失败, 到期的域会重新创建清除任务
+	go lease.Schedule(s.lease, PURGE_LEASE_NAME, PURGE_CHECK_INTERVAL, s.purgeExpired)
+	go s.runner.Recover(s.activeJobs, s.interruptJob)
 	return nil
 }
 
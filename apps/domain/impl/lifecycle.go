@@ -0,0 +1,365 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/token"
+)
+
+const (
+	// 检查保留期到期的域的间隔
+	PURGE_CHECK_INTERVAL = time.Hour
+	// 定期清除的租约名称
+	PURGE_LEASE_NAME = "domain.purge"
+)
+
+// 禁用/启用域
+func (s *service) ChangeDomainState(ctx context.Context, req *domain.ChangeDomainStateRequest) (*domain.Domain, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	d, err := s.DescribeDomain(ctx, domain.NewDescribeDomainRequestById(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if d.State().Equal(domain.DOMAIN_STATE_DELETED) {
+		return nil, exception.NewBadRequest("domain %s is deleted, restore it first", d.Spec.Name)
+	}
+	if d.State().Equal(req.State) {
+		return d, nil
+	}
+	if req.State.Equal(domain.DOMAIN_STATE_DISABLED) && d.Spec.Name == domain.DEFAULT_DOMAIN {
+		return nil, exception.NewBadRequest("default domain can not be disabled")
+	}
+
+	d.ChangeState(req.State, req.Operator, req.Reason)
+	if req.State.Equal(domain.DOMAIN_STATE_DISABLED) {
+		if err := s.blockToken(ctx, d); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.updateStatus(ctx, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// 删除域, 保留期内可以恢复
+func (s *service) DeleteDomain(ctx context.Context, req *domain.DeleteDomainRequest) (*domain.Domain, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	d, err := s.DescribeDomain(ctx, domain.NewDescribeDomainRequestById(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if d.Spec.Name == domain.DEFAULT_DOMAIN {
+		return nil, exception.NewBadRequest("default domain can not be deleted")
+	}
+	if d.State().Equal(domain.DOMAIN_STATE_DELETED) {
+		return nil, exception.NewBadRequest("domain %s already deleted", d.Spec.Name)
+	}
+
+	d.MarkDeleted(req.RetainDays, req.Operator, req.Reason)
+	if err := s.blockToken(ctx, d); err != nil {
+		return nil, err
+	}
+	if err := s.updateStatus(ctx, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// 恢复保留期内的域, 恢复后的域为正常状态
+func (s *service) RestoreDomain(ctx context.Context, req *domain.RestoreDomainRequest) (*domain.Domain, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	d, err := s.DescribeDomain(ctx, domain.NewDescribeDomainRequestById(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if !d.State().Equal(domain.DOMAIN_STATE_DELETED) {
+		return nil, exception.NewBadRequest("domain %s is not deleted", d.Spec.Name)
+	}
+
+	// 已经开始清除的域不能恢复
+	if err := s.checkNoRunningJob(ctx, d); err != nil {
+		return nil, err
+	}
+
+	d.ChangeState(domain.DOMAIN_STATE_ACTIVE, req.Operator, "restore")
+	if err := s.updateStatus(ctx, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// 立即清除已删除的域
+func (s *service) PurgeDomain(ctx context.Context, req *domain.PurgeDomainRequest) (*domain.PurgeJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	d, err := s.DescribeDomain(ctx, domain.NewDescribeDomainRequestById(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if !d.State().Equal(domain.DOMAIN_STATE_DELETED) {
+		return nil, exception.NewBadRequest("domain %s must be deleted before purge", d.Spec.Name)
+	}
+
+	return s.createPurgeJob(ctx, d, req.Operator)
+}
+
+func (s *service) QueryPurgeJob(ctx context.Context, req *domain.QueryPurgeJobRequest) (*domain.PurgeJobSet, error) {
+	filter := bson.M{}
+	if req.Domain != "" {
+		filter["domain"] = req.Domain
+	}
+
+	pageSize := int64(req.Page.PageSize)
+	skip := req.Page.ComputeOffset()
+	opt := &options.FindOptions{
+		Sort:  bson.D{{Key: "create_at", Value: -1}},
+		Limit: &pageSize,
+		Skip:  &skip,
+	}
+	resp, err := s.job.Find(ctx, filter, opt)
+	if err != nil {
+		return nil, exception.NewInternalServerError("find purge job error, %s", err)
+	}
+
+	set := domain.NewPurgeJobSet()
+	for resp.Next(ctx) {
+		ins := &domain.PurgeJob{}
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode purge job error, %s", err)
+		}
+		set.Add(ins)
+	}
+
+	count, err := s.job.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, exception.NewInternalServerError("get purge job count error, %s", err)
+	}
+	set.Total = count
+	return set, nil
+}
+
+func (s *service) DescribePurgeJob(ctx context.Context, req *domain.DescribePurgeJobRequest) (*domain.PurgeJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins := &domain.PurgeJob{}
+	if err := s.job.FindOne(ctx, bson.M{"_id": req.Id}).Decode(ins); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, exception.NewNotFound("purge job %s not found", req.Id)
+		}
+		return nil, exception.NewInternalServerError("find purge job %s error, %s", req.Id, err)
+	}
+	return ins, nil
+}
+
+// trackDormantLock 记录启用时间之前已经启用休眠锁定的域, 没有登录记录的账号从现在开始计算
+func (s *service) trackDormantLock(ctx context.Context) error {
+	resp, err := s.col.Find(ctx, bson.M{
+		"spec.security_setting.login_security.dormant_lock_days": bson.M{"$gt": 0},
+		"status.dormant_lock_since":                              bson.M{"$in": bson.A{nil, 0}},
+	})
+	if err != nil {
+		return exception.NewInternalServerError("find dormant lock domain error, %s", err)
+	}
+	defer resp.Close(ctx)
+
+	for resp.Next(ctx) {
+		d := domain.NewDefaultDomain()
+		if err := resp.Decode(d); err != nil {
+			return exception.NewInternalServerError("decode domain error, %s", err)
+		}
+		d.TrackDormantLock(0)
+		if _, err := s.col.UpdateOne(ctx, bson.M{"_id": d.Id}, bson.M{"$set": bson.M{"status": d.Status}}); err != nil {
+			return exception.NewInternalServerError("update domain(%s) status error, %s", d.Id, err)
+		}
+	}
+	return resp.Err()
+}
+
+func (s *service) updateStatus(ctx context.Context, d *domain.Domain) error {
+	d.UpdateAt = time.Now().UnixMilli()
+	_, err := s.col.UpdateOne(ctx, bson.M{"_id": d.Id}, bson.M{"$set": bson.M{
+		"status":    d.Status,
+		"update_at": d.UpdateAt,
+	}})
+	if err != nil {
+		return exception.NewInternalServerError("update domain(%s) status error, %s", d.Id, err)
+	}
+	return nil
+}
+
+// 域被禁用或者删除后, 已经颁发的令牌立即失效
+// 在保存状态之前执行, 失败时域状态不变, 操作返回错误, 可以重试
+func (s *service) blockToken(ctx context.Context, d *domain.Domain) error {
+	req := token.NewBlockDomainTokenRequest(d.Spec.Name, token.BLOCK_TYPE_DOMAIN_INACTIVE, d.CheckActive().Error())
+	if _, err := s.token.BlockDomainToken(ctx, req); err != nil {
+		return exception.NewInternalServerError("block domain %s token error, %s", d.Spec.Name, err)
+	}
+	return nil
+}
+
+func (s *service) checkNoRunningJob(ctx context.Context, d *domain.Domain) error {
+	running, err := s.job.CountDocuments(ctx, bson.M{
+		"domain_id": d.Id,
+		"status":    bson.M{"$in": []domain.PURGE_STATUS{domain.PURGE_STATUS_PENDING, domain.PURGE_STATUS_RUNNING}},
+	})
+	if err != nil {
+		return exception.NewInternalServerError("count purge job error, %s", err)
+	}
+	if running > 0 {
+		return exception.NewConflict("domain %s is purging", d.Spec.Name)
+	}
+	return nil
+}
+
+// 创建清除任务, 任务持有租约在后台执行, 同一个域同时只能有一个未完成的任务, 由唯一索引保证
+func (s *service) createPurgeJob(ctx context.Context, d *domain.Domain, operator string) (*domain.PurgeJob, error) {
+	resources := make([]string, 0, len(s.cleaners))
+	for _, c := range s.cleaners {
+		resources = append(resources, c.resource)
+	}
+
+	job := domain.NewPurgeJob(d, operator, resources)
+	err := s.runner.Start(ctx, job.Id, func(ctx context.Context) error {
+		if _, err := s.job.InsertOne(ctx, job); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return exception.NewConflict("domain %s is purging", d.Spec.Name)
+			}
+			return exception.NewInternalServerError("insert purge job error, %s", err)
+		}
+		return nil
+	}, func(ctx context.Context) {
+		s.purge(ctx, job)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// 按照顺序清除域下的资源, 每完成一类资源更新一次进度, 全部完成后删除域, 租约续约失败时ctx被取消
+func (s *service) purge(ctx context.Context, job *domain.PurgeJob) {
+	job.Status = domain.PURGE_STATUS_RUNNING
+	if err := s.updateJob(ctx, job); err != nil {
+		s.log.Errorf("update purge job %s error, %s", job.Id, err)
+		return
+	}
+
+	for i, c := range s.cleaners {
+		step := job.Steps[i]
+		step.Status = domain.PURGE_STATUS_RUNNING
+		if err := s.updateJob(ctx, job); err != nil {
+			s.log.Errorf("update purge job %s error, %s", job.Id, err)
+		}
+
+		resp, err := c.DeleteDomainResource(ctx, domain.NewDeleteDomainResourceRequest(job.Domain))
+		if err != nil {
+			step.Failed(err)
+			job.Failed("purge %s error, %s", c.resource, err)
+			s.log.Errorf("purge domain %s %s error, %s", job.Domain, c.resource, err)
+			if err := s.updateJob(ctx, job); err != nil {
+				s.log.Errorf("update purge job %s error, %s", job.Id, err)
+			}
+			return
+		}
+		step.Succeed(resp.Deleted)
+	}
+
+	if _, err := s.col.DeleteOne(ctx, bson.M{"_id": job.DomainId}); err != nil {
+		job.Failed("delete domain error, %s", err)
+	} else {
+		job.Succeed()
+		s.log.Infof("domain %s purged by %s", job.Domain, job.Operator)
+	}
+
+	if err := s.updateJob(ctx, job); err != nil {
+		s.log.Errorf("update purge job %s error, %s", job.Id, err)
+	}
+}
+
+// 未完成的清除任务
+func (s *service) activeJobs(ctx context.Context) ([]string, error) {
+	resp, err := s.job.Find(ctx, bson.M{"status": bson.M{"$lte": domain.PURGE_STATUS_RUNNING}})
+	if err != nil {
+		return nil, exception.NewInternalServerError("find active purge job error, %s", err)
+	}
+
+	ids := []string{}
+	for resp.Next(ctx) {
+		job := &domain.PurgeJob{}
+		if err := resp.Decode(job); err != nil {
+			return nil, exception.NewInternalServerError("decode purge job error, %s", err)
+		}
+		ids = append(ids, job.Id)
+	}
+	return ids, nil
+}
+
+// 执行清除任务的副本已经退出, 任务不会再执行, 标记为失败
+func (s *service) interruptJob(ctx context.Context, id string) error {
+	_, err := s.job.UpdateOne(ctx,
+		bson.M{"_id": id, "status": bson.M{"$lte": domain.PURGE_STATUS_RUNNING}},
+		bson.M{"$set": bson.M{
+			"status":      domain.PURGE_STATUS_FAILED,
+			"finished_at": time.Now().UnixMilli(),
+			"message":     "interrupted, the replica running it exited",
+		}},
+	)
+	if err != nil {
+		return exception.NewInternalServerError("interrupt purge job %s error, %s", id, err)
+	}
+	s.log.Warnf("purge job %s interrupted", id)
+	return nil
+}
+
+func (s *service) updateJob(ctx context.Context, job *domain.PurgeJob) error {
+	if _, err := s.job.ReplaceOne(ctx, bson.M{"_id": job.Id}, job); err != nil {
+		return exception.NewInternalServerError("update purge job %s error, %s", job.Id, err)
+	}
+	return nil
+}
+
+// 清除保留期到期的域, 多副本部署时只有持有租约的副本执行
+func (s *service) purgeExpired(ctx context.Context) {
+	filter := bson.M{
+		"status.state":    domain.DOMAIN_STATE_DELETED,
+		"status.purge_at": bson.M{"$lte": time.Now().UnixMilli()},
+	}
+	resp, err := s.col.Find(ctx, filter)
+	if err != nil {
+		s.log.Errorf("find expired domain error, %s", err)
+		return
+	}
+
+	for resp.Next(ctx) {
+		d := domain.NewDefaultDomain()
+		if err := resp.Decode(d); err != nil {
+			s.log.Errorf("decode domain error, %s", err)
+			return
+		}
+		_, err := s.createPurgeJob(ctx, d, domain.SYSTEM_OPERATOR)
+		if err != nil && !exception.IsConflictError(err) {
+			s.log.Errorf("create domain %s purge job error, %s", d.Spec.Name, err)
+		}
+	}
+}
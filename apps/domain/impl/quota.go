@@ -0,0 +1,53 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/domain"
+)
+
+// 创建资源前检查配额, 域不存在或者没有配置配额时不限制
+func (s *service) CheckQuota(ctx context.Context, req *domain.CheckQuotaRequest) (*domain.ResourceUsage, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	d, err := s.DescribeDomain(ctx, domain.NewDescribeDomainRequestByName(req.Domain))
+	if err != nil {
+		if exception.IsNotFoundError(err) {
+			return domain.NewResourceUsage(req.Resource, req.Used, 0), nil
+		}
+		return nil, err
+	}
+
+	usage := domain.NewResourceUsage(req.Resource, req.Used, d.Spec.Quota.Limit(req.Resource))
+	if usage.IsExceeded() {
+		return nil, domain.NewQuotaExceeded("domain %s %s quota exceeded, used %d, limit %d",
+			req.Domain, req.Resource, usage.Used, usage.Limit)
+	}
+	return usage, nil
+}
+
+// 查询域的资源用量和配额
+func (s *service) DescribeUsage(ctx context.Context, req *domain.DescribeUsageRequest) (*domain.DomainUsage, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	d, err := s.DescribeDomain(ctx, domain.NewDescribeDomainRequestById(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	usage := domain.NewDomainUsage(d.Spec.Name)
+	for _, c := range s.counters {
+		resp, err := c.CountDomainResource(ctx, domain.NewCountDomainResourceRequest(d.Spec.Name))
+		if err != nil {
+			return nil, err
+		}
+		usage.Add(domain.NewResourceUsage(c.resource, resp.Count, d.Spec.Quota.Limit(c.resource)))
+	}
+	return usage, nil
+}
@@ -7,6 +7,36 @@ type Service interface {
 	CreateDomain(context.Context, *CreateDomainRequest) (*Domain, error)
 	// 更新域
 	UpdateDomain(context.Context, *UpdateDomainRequest) (*Domain, error)
+	// 测试认证源连接
+	TestAuthSourceConnection(context.Context, *TestAuthSourceRequest) (*TestAuthSourceResponse, error)
+	// 测试认证源登录, 只返回认证结果, 不会创建用户
+	TestAuthSourceLogin(context.Context, *TestAuthSourceRequest) (*TestAuthSourceResponse, error)
+	// 禁用/启用域, 禁用时域下所有用户的令牌失效
+	ChangeDomainState(context.Context, *ChangeDomainStateRequest) (*Domain, error)
+	// 删除域, 域进入保留期, 保留期过后自动清除
+	DeleteDomain(context.Context, *DeleteDomainRequest) (*Domain, error)
+	// 恢复保留期内的域
+	RestoreDomain(context.Context, *RestoreDomainRequest) (*Domain, error)
+	// 立即清除已删除的域, 清除在后台执行
+	PurgeDomain(context.Context, *PurgeDomainRequest) (*PurgeJob, error)
+	// 查询清除任务
+	QueryPurgeJob(context.Context, *QueryPurgeJobRequest) (*PurgeJobSet, error)
+	// 查询清除任务详情, 用于查看清除进度
+	DescribePurgeJob(context.Context, *DescribePurgeJobRequest) (*PurgeJob, error)
+	// 创建资源前检查域的配额, 超出配额时返回QuotaExceeded异常
+	CheckQuota(context.Context, *CheckQuotaRequest) (*ResourceUsage, error)
+	// 查询域的资源用量和配额
+	DescribeUsage(context.Context, *DescribeUsageRequest) (*DomainUsage, error)
 	// RPC
 	RPCServer
 }
+
+// ResourceCleaner 清除域时删除域下的某类资源, 由各个模块实现
+type ResourceCleaner interface {
+	DeleteDomainResource(context.Context, *DeleteDomainResourceRequest) (*DeleteDomainResourceResponse, error)
+}
+
+// ResourceCounter 统计域下某类资源的数量, 用于配额检查和用量统计, 由各个模块实现
+type ResourceCounter interface {
+	CountDomainResource(context.Context, *CountDomainResourceRequest) (*CountDomainResourceResponse, error)
+}
@@ -58,6 +58,27 @@ type LdapConfig struct {
 	// 用户显示名称属性名称
 	// @gotags: bson:"display_name_attribute" json:"display_name_attribute"
 	DisplayNameAttribute string `protobuf:"bytes,11,opt,name=display_name_attribute,json=displayNameAttribute,proto3" json:"display_name_attribute" bson:"display_name_attribute"`
+	// 是否开启定时同步用户和用户组
+	// @gotags: bson:"sync_enabled" json:"sync_enabled"
+	SyncEnabled bool `protobuf:"varint,12,opt,name=sync_enabled,json=syncEnabled,proto3" json:"sync_enabled" bson:"sync_enabled"`
+	// 定时同步的间隔
+	// @gotags: bson:"sync_interval_minutes" json:"sync_interval_minutes"
+	SyncIntervalMinutes uint32 `protobuf:"varint,13,opt,name=sync_interval_minutes,json=syncIntervalMinutes,proto3" json:"sync_interval_minutes" bson:"sync_interval_minutes"`
+	// 同步时查询所有用户的过滤条件
+	// @gotags: bson:"user_sync_filter" json:"user_sync_filter"
+	UserSyncFilter string `protobuf:"bytes,14,opt,name=user_sync_filter,json=userSyncFilter,proto3" json:"user_sync_filter" bson:"user_sync_filter"`
+	// 同步时查询所有用户组的过滤条件
+	// @gotags: bson:"group_sync_filter" json:"group_sync_filter"
+	GroupSyncFilter string `protobuf:"bytes,15,opt,name=group_sync_filter,json=groupSyncFilter,proto3" json:"group_sync_filter" bson:"group_sync_filter"`
+	// 组成员属性的名称, 值为成员的DN或者用户名
+	// @gotags: bson:"group_member_attribute" json:"group_member_attribute"
+	GroupMemberAttribute string `protobuf:"bytes,16,opt,name=group_member_attribute,json=groupMemberAttribute,proto3" json:"group_member_attribute" bson:"group_member_attribute"`
+	// 标记用户禁用的属性名称, 比如nsAccountLock
+	// @gotags: bson:"disabled_attribute" json:"disabled_attribute"
+	DisabledAttribute string `protobuf:"bytes,17,opt,name=disabled_attribute,json=disabledAttribute,proto3" json:"disabled_attribute" bson:"disabled_attribute"`
+	// 禁用属性的值, 属性等于该值时用户被禁用
+	// @gotags: bson:"disabled_value" json:"disabled_value"
+	DisabledValue string `protobuf:"bytes,18,opt,name=disabled_value,json=disabledValue,proto3" json:"disabled_value" bson:"disabled_value"`
 }
 
 func (x *LdapConfig) Reset() {
@@ -169,13 +190,62 @@ func (x *LdapConfig) GetDisplayNameAttribute() string {
 	return ""
 }
 
+func (x *LdapConfig) GetSyncEnabled() bool {
+	if x != nil {
+		return x.SyncEnabled
+	}
+	return false
+}
+
+func (x *LdapConfig) GetSyncIntervalMinutes() uint32 {
+	if x != nil {
+		return x.SyncIntervalMinutes
+	}
+	return 0
+}
+
+func (x *LdapConfig) GetUserSyncFilter() string {
+	if x != nil {
+		return x.UserSyncFilter
+	}
+	return ""
+}
+
+func (x *LdapConfig) GetGroupSyncFilter() string {
+	if x != nil {
+		return x.GroupSyncFilter
+	}
+	return ""
+}
+
+func (x *LdapConfig) GetGroupMemberAttribute() string {
+	if x != nil {
+		return x.GroupMemberAttribute
+	}
+	return ""
+}
+
+func (x *LdapConfig) GetDisabledAttribute() string {
+	if x != nil {
+		return x.DisabledAttribute
+	}
+	return ""
+}
+
+func (x *LdapConfig) GetDisabledValue() string {
+	if x != nil {
+		return x.DisabledValue
+	}
+	return ""
+}
+
 var File_apps_domain_pb_ldap_proto protoreflect.FileDescriptor
 
 var file_apps_domain_pb_ldap_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x62,
 	0x2f, 0x6c, 0x64, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x69, 0x6e, 0x66,
 	0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
-	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x97, 0x03, 0x0a, 0x0a, 0x4c, 0x64, 0x61, 0x70, 0x43,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0xd0, 0x05, 0x0a, 0x0a, 0x4c, 0x64, 0x61, 0x70, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x5f,
 	0x64, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x64, 0x44, 0x6e,
@@ -201,10 +271,29 @@ var file_apps_domain_pb_ldap_proto_rawDesc = []byte{
 	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69,
 	0x62, 0x75, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x69, 0x73, 0x70,
 	0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69,
-	0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65,
-	0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a,
+	0x16, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3,6 +3,7 @@ package domain
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 // NewDefaultConfig represents the default LDAP config.
@@ -14,9 +15,32 @@ func NewDefaultConfig() *LdapConfig {
 		UsernameAttribute:    "uid",
 		UserFilter:           "(uid={input})",
 		GroupFilter:          "(|(member={dn})(uid={username})(uid={input}))",
+		SyncIntervalMinutes:  60,
+		UserSyncFilter:       "(objectClass=inetOrgPerson)",
+		GroupSyncFilter:      "(objectClass=groupOfNames)",
+		GroupMemberAttribute: "member",
+		DisabledValue:        "true",
 	}
 }
 
+// SyncInterval 定时同步的间隔
+func (c *LdapConfig) SyncInterval() time.Duration {
+	return time.Duration(c.SyncIntervalMinutes) * time.Minute
+}
+
+// IsDisabled 根据禁用属性的值判断用户是否被禁用
+func (c *LdapConfig) IsDisabled(values []string) bool {
+	if c.DisabledAttribute == "" {
+		return false
+	}
+	for _, v := range values {
+		if strings.EqualFold(v, c.DisabledValue) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetBaseDNFromUser 从用户中获取BaseDN
 func (c *LdapConfig) GetBaseDNFromUser() string {
 	return strings.Join(c.getBaseDN(c.BindDn), ",")
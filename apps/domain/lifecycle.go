@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/infraboard/mcube/http/request"
+	"github.com/rs/xid"
+)
+
+const (
+	// 删除后默认保留的天数, 保留期内可以恢复
+	DEFAULT_RETAIN_DAYS = 30
+	// 保留期到期自动清除时的操作人
+	SYSTEM_OPERATOR = "system"
+)
+
+func NewDomainStatus() *DomainStatus {
+	return &DomainStatus{
+		State: DOMAIN_STATE_ACTIVE,
+	}
+}
+
+// State 域当前的状态, 老数据没有状态时视为正常
+func (d *Domain) State() DOMAIN_STATE {
+	if d.Status == nil {
+		return DOMAIN_STATE_ACTIVE
+	}
+	return d.Status.State
+}
+
+// CheckActive 域被禁用或者删除时, 域下的用户不允许登录
+func (d *Domain) CheckActive() error {
+	switch d.State() {
+	case DOMAIN_STATE_DISABLED:
+		return fmt.Errorf("domain %s is disabled", d.Spec.Name)
+	case DOMAIN_STATE_DELETED:
+		return fmt.Errorf("domain %s is deleted", d.Spec.Name)
+	}
+	return nil
+}
+
+// ChangeState 修改域状态
+func (d *Domain) ChangeState(state DOMAIN_STATE, operator, reason string) {
+	if d.Status == nil {
+		d.Status = NewDomainStatus()
+	}
+	d.Status.State = state
+	d.Status.ChangeAt = time.Now().UnixMilli()
+	d.Status.Operator = operator
+	d.Status.Reason = reason
+	d.Status.DeleteAt = 0
+	d.Status.PurgeAt = 0
+}
+
+// MarkDeleted 标记删除, 保留期过后彻底清除
+func (d *Domain) MarkDeleted(retainDays int32, operator, reason string) {
+	if retainDays <= 0 {
+		retainDays = DEFAULT_RETAIN_DAYS
+	}
+	d.ChangeState(DOMAIN_STATE_DELETED, operator, reason)
+	now := time.Now()
+	d.Status.DeleteAt = now.UnixMilli()
+	d.Status.PurgeAt = now.Add(time.Duration(retainDays) * 24 * time.Hour).UnixMilli()
+}
+
+// DormantLockDays 休眠锁定天数, 为0表示不启用
+func (d *Domain) DormantLockDays() uint32 {
+	return d.Spec.GetSecuritySetting().GetLoginSecurity().GetDormantLockDays()
+}
+
+// TrackDormantLock 记录休眠锁定的启用时间, 从未启用变为启用时重新计时, 关闭时清除
+func (d *Domain) TrackDormantLock(prevDays uint32) {
+	if d.Status == nil {
+		d.Status = NewDomainStatus()
+	}
+	switch {
+	case d.DormantLockDays() == 0:
+		d.Status.DormantLockSince = 0
+	case prevDays == 0 || d.Status.DormantLockSince == 0:
+		d.Status.DormantLockSince = time.Now().UnixMilli()
+	}
+}
+
+func NewChangeDomainStateRequest(id string, state DOMAIN_STATE) *ChangeDomainStateRequest {
+	return &ChangeDomainStateRequest{
+		Id:    id,
+		State: state,
+	}
+}
+
+func (req *ChangeDomainStateRequest) Validate() error {
+	if req.State.Equal(DOMAIN_STATE_DELETED) {
+		return fmt.Errorf("use delete domain api to delete domain")
+	}
+	return validate.Struct(req)
+}
+
+func NewDeleteDomainRequest(id string) *DeleteDomainRequest {
+	return &DeleteDomainRequest{
+		Id: id,
+	}
+}
+
+func (req *DeleteDomainRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewRestoreDomainRequest(id string) *RestoreDomainRequest {
+	return &RestoreDomainRequest{
+		Id: id,
+	}
+}
+
+func (req *RestoreDomainRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewPurgeDomainRequest(id string) *PurgeDomainRequest {
+	return &PurgeDomainRequest{
+		Id: id,
+	}
+}
+
+func (req *PurgeDomainRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// NewPurgeJob 清除任务, resources为需要清除的资源, 按照顺序执行
+func NewPurgeJob(d *Domain, operator string, resources []string) *PurgeJob {
+	job := &PurgeJob{
+		Id:       xid.New().String(),
+		CreateAt: time.Now().UnixMilli(),
+		DomainId: d.Id,
+		Domain:   d.Spec.Name,
+		Operator: operator,
+		Status:   PURGE_STATUS_PENDING,
+		Steps:    []*PurgeStep{},
+	}
+	for _, r := range resources {
+		job.Steps = append(job.Steps, &PurgeStep{Resource: r})
+	}
+	return job
+}
+
+// IsFinished 任务是否已经结束
+func (j *PurgeJob) IsFinished() bool {
+	return j.Status.Equal(PURGE_STATUS_SUCCEEDED) || j.Status.Equal(PURGE_STATUS_FAILED)
+}
+
+// Succeed 任务执行成功
+func (j *PurgeJob) Succeed() {
+	j.Status = PURGE_STATUS_SUCCEEDED
+	j.FinishedAt = time.Now().UnixMilli()
+}
+
+// Failed 任务执行失败
+func (j *PurgeJob) Failed(format string, a ...interface{}) {
+	j.Status = PURGE_STATUS_FAILED
+	j.FinishedAt = time.Now().UnixMilli()
+	j.Message = fmt.Sprintf(format, a...)
+}
+
+// Progress 已完成的步骤数量和总的步骤数量
+func (j *PurgeJob) Progress() (int, int) {
+	done := 0
+	for _, s := range j.Steps {
+		if s.Status.Equal(PURGE_STATUS_SUCCEEDED) {
+			done++
+		}
+	}
+	return done, len(j.Steps)
+}
+
+// Succeed 该类资源清除完成
+func (s *PurgeStep) Succeed(deleted int64) {
+	s.Status = PURGE_STATUS_SUCCEEDED
+	s.Deleted = deleted
+	s.FinishedAt = time.Now().UnixMilli()
+}
+
+// Failed 该类资源清除失败
+func (s *PurgeStep) Failed(err error) {
+	s.Status = PURGE_STATUS_FAILED
+	s.FinishedAt = time.Now().UnixMilli()
+	s.Message = err.Error()
+}
+
+func NewPurgeJobSet() *PurgeJobSet {
+	return &PurgeJobSet{
+		Items: []*PurgeJob{},
+	}
+}
+
+func (s *PurgeJobSet) Add(item *PurgeJob) {
+	s.Items = append(s.Items, item)
+}
+
+func NewQueryPurgeJobRequest() *QueryPurgeJobRequest {
+	return &QueryPurgeJobRequest{
+		Page: request.NewPageRequest(20, 1),
+	}
+}
+
+func NewQueryPurgeJobRequestFromHTTP(r *http.Request) *QueryPurgeJobRequest {
+	req := NewQueryPurgeJobRequest()
+	req.Page = request.NewPageRequestFromHTTP(r)
+	req.Domain = r.URL.Query().Get("domain")
+	return req
+}
+
+func NewDescribePurgeJobRequest(id string) *DescribePurgeJobRequest {
+	return &DescribePurgeJobRequest{
+		Id: id,
+	}
+}
+
+func (req *DescribePurgeJobRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDeleteDomainResourceRequest(domain string) *DeleteDomainResourceRequest {
+	return &DeleteDomainResourceRequest{
+		Domain: domain,
+	}
+}
+
+func (req *DeleteDomainResourceRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDeleteDomainResourceResponse(deleted int64) *DeleteDomainResourceResponse {
+	return &DeleteDomainResourceResponse{
+		Deleted: deleted,
+	}
+}
@@ -0,0 +1,1348 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: apps/domain/pb/lifecycle.proto
+
+package domain
+
+import (
+	request "github.com/infraboard/mcube/http/request"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 域的生命周期状态
+type DOMAIN_STATE int32
+
+const (
+	// 正常
+	DOMAIN_STATE_ACTIVE DOMAIN_STATE = 0
+	// 禁用, 域下所有用户禁止登录, 已颁发的令牌失效
+	DOMAIN_STATE_DISABLED DOMAIN_STATE = 1
+	// 已删除, 保留期内可以恢复, 保留期过后彻底清除
+	DOMAIN_STATE_DELETED DOMAIN_STATE = 2
+)
+
+// Enum value maps for DOMAIN_STATE.
+var (
+	DOMAIN_STATE_name = map[int32]string{
+		0: "ACTIVE",
+		1: "DISABLED",
+		2: "DELETED",
+	}
+	DOMAIN_STATE_value = map[string]int32{
+		"ACTIVE":   0,
+		"DISABLED": 1,
+		"DELETED":  2,
+	}
+)
+
+func (x DOMAIN_STATE) Enum() *DOMAIN_STATE {
+	p := new(DOMAIN_STATE)
+	*p = x
+	return p
+}
+
+func (x DOMAIN_STATE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DOMAIN_STATE) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_domain_pb_lifecycle_proto_enumTypes[0].Descriptor()
+}
+
+func (DOMAIN_STATE) Type() protoreflect.EnumType {
+	return &file_apps_domain_pb_lifecycle_proto_enumTypes[0]
+}
+
+func (x DOMAIN_STATE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DOMAIN_STATE.Descriptor instead.
+func (DOMAIN_STATE) EnumDescriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{0}
+}
+
+// 清除任务状态
+type PURGE_STATUS int32
+
+const (
+	// 等待执行
+	PURGE_STATUS_PENDING PURGE_STATUS = 0
+	// 执行中
+	PURGE_STATUS_RUNNING PURGE_STATUS = 1
+	// 执行成功
+	PURGE_STATUS_SUCCEEDED PURGE_STATUS = 2
+	// 执行失败
+	PURGE_STATUS_FAILED PURGE_STATUS = 3
+)
+
+// Enum value maps for PURGE_STATUS.
+var (
+	PURGE_STATUS_name = map[int32]string{
+		0: "PENDING",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	PURGE_STATUS_value = map[string]int32{
+		"PENDING":   0,
+		"RUNNING":   1,
+		"SUCCEEDED": 2,
+		"FAILED":    3,
+	}
+)
+
+func (x PURGE_STATUS) Enum() *PURGE_STATUS {
+	p := new(PURGE_STATUS)
+	*p = x
+	return p
+}
+
+func (x PURGE_STATUS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PURGE_STATUS) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_domain_pb_lifecycle_proto_enumTypes[1].Descriptor()
+}
+
+func (PURGE_STATUS) Type() protoreflect.EnumType {
+	return &file_apps_domain_pb_lifecycle_proto_enumTypes[1]
+}
+
+func (x PURGE_STATUS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PURGE_STATUS.Descriptor instead.
+func (PURGE_STATUS) EnumDescriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{1}
+}
+
+// DomainStatus 域状态
+type DomainStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 当前状态
+	// @gotags: bson:"state" json:"state"
+	State DOMAIN_STATE `protobuf:"varint,1,opt,name=state,proto3,enum=infraboard.mcenter.domain.DOMAIN_STATE" json:"state" bson:"state"`
+	// 状态变更时间
+	// @gotags: bson:"change_at" json:"change_at"
+	ChangeAt int64 `protobuf:"varint,2,opt,name=change_at,json=changeAt,proto3" json:"change_at" bson:"change_at"`
+	// 操作人
+	// @gotags: bson:"operator" json:"operator"
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator" bson:"operator"`
+	// 变更原因
+	// @gotags: bson:"reason" json:"reason"
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason" bson:"reason"`
+	// 删除时间
+	// @gotags: bson:"delete_at" json:"delete_at"
+	DeleteAt int64 `protobuf:"varint,5,opt,name=delete_at,json=deleteAt,proto3" json:"delete_at" bson:"delete_at"`
+	// 计划彻底清除的时间, 删除时间加上保留天数
+	// @gotags: bson:"purge_at" json:"purge_at"
+	PurgeAt int64 `protobuf:"varint,6,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at" bson:"purge_at"`
+	// 休眠锁定的启用时间, 没有登录记录的账号从该时间开始计算未登录天数
+	// @gotags: bson:"dormant_lock_since" json:"dormant_lock_since"
+	DormantLockSince int64 `protobuf:"varint,7,opt,name=dormant_lock_since,json=dormantLockSince,proto3" json:"dormant_lock_since" bson:"dormant_lock_since"`
+}
+
+func (x *DomainStatus) Reset() {
+	*x = DomainStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DomainStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainStatus) ProtoMessage() {}
+
+func (x *DomainStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainStatus.ProtoReflect.Descriptor instead.
+func (*DomainStatus) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DomainStatus) GetState() DOMAIN_STATE {
+	if x != nil {
+		return x.State
+	}
+	return DOMAIN_STATE_ACTIVE
+}
+
+func (x *DomainStatus) GetChangeAt() int64 {
+	if x != nil {
+		return x.ChangeAt
+	}
+	return 0
+}
+
+func (x *DomainStatus) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *DomainStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DomainStatus) GetDeleteAt() int64 {
+	if x != nil {
+		return x.DeleteAt
+	}
+	return 0
+}
+
+func (x *DomainStatus) GetPurgeAt() int64 {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return 0
+}
+
+func (x *DomainStatus) GetDormantLockSince() int64 {
+	if x != nil {
+		return x.DormantLockSince
+	}
+	return 0
+}
+
+// ChangeDomainStateRequest 禁用/启用域
+type ChangeDomainStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域Id
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required"`
+	// 目标状态, 只能是ACTIVE或者DISABLED
+	// @gotags: json:"state"
+	State DOMAIN_STATE `protobuf:"varint,2,opt,name=state,proto3,enum=infraboard.mcenter.domain.DOMAIN_STATE" json:"state"`
+	// 操作人
+	// @gotags: json:"operator"
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator"`
+	// 变更原因
+	// @gotags: json:"reason" validate:"lte=200"
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason" validate:"lte=200"`
+}
+
+func (x *ChangeDomainStateRequest) Reset() {
+	*x = ChangeDomainStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeDomainStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeDomainStateRequest) ProtoMessage() {}
+
+func (x *ChangeDomainStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeDomainStateRequest.ProtoReflect.Descriptor instead.
+func (*ChangeDomainStateRequest) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChangeDomainStateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeDomainStateRequest) GetState() DOMAIN_STATE {
+	if x != nil {
+		return x.State
+	}
+	return DOMAIN_STATE_ACTIVE
+}
+
+func (x *ChangeDomainStateRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ChangeDomainStateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// DeleteDomainRequest 删除域, 域进入保留期, 保留期内可以恢复
+type DeleteDomainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域Id
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required"`
+	// 保留天数, 0表示使用默认值
+	// @gotags: json:"retain_days" validate:"gte=0,lte=365"
+	RetainDays int32 `protobuf:"varint,2,opt,name=retain_days,json=retainDays,proto3" json:"retain_days" validate:"gte=0,lte=365"`
+	// 操作人
+	// @gotags: json:"operator"
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator"`
+	// 删除原因
+	// @gotags: json:"reason" validate:"lte=200"
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason" validate:"lte=200"`
+}
+
+func (x *DeleteDomainRequest) Reset() {
+	*x = DeleteDomainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDomainRequest) ProtoMessage() {}
+
+func (x *DeleteDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDomainRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDomainRequest) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteDomainRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteDomainRequest) GetRetainDays() int32 {
+	if x != nil {
+		return x.RetainDays
+	}
+	return 0
+}
+
+func (x *DeleteDomainRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *DeleteDomainRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// RestoreDomainRequest 恢复保留期内的域
+type RestoreDomainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域Id
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required"`
+	// 操作人
+	// @gotags: json:"operator"
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator"`
+}
+
+func (x *RestoreDomainRequest) Reset() {
+	*x = RestoreDomainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDomainRequest) ProtoMessage() {}
+
+func (x *RestoreDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDomainRequest.ProtoReflect.Descriptor instead.
+func (*RestoreDomainRequest) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RestoreDomainRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreDomainRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// PurgeDomainRequest 立即彻底清除已删除的域
+type PurgeDomainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域Id
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required"`
+	// 操作人
+	// @gotags: json:"operator"
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator"`
+}
+
+func (x *PurgeDomainRequest) Reset() {
+	*x = PurgeDomainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDomainRequest) ProtoMessage() {}
+
+func (x *PurgeDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDomainRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDomainRequest) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PurgeDomainRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurgeDomainRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// PurgeStep 清除任务中一类资源的清除进度
+type PurgeStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源名称
+	// @gotags: bson:"resource" json:"resource"
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource" bson:"resource"`
+	// 状态
+	// @gotags: bson:"status" json:"status"
+	Status PURGE_STATUS `protobuf:"varint,2,opt,name=status,proto3,enum=infraboard.mcenter.domain.PURGE_STATUS" json:"status" bson:"status"`
+	// 删除的数量
+	// @gotags: bson:"deleted" json:"deleted"
+	Deleted int64 `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted" bson:"deleted"`
+	// 完成时间
+	// @gotags: bson:"finished_at" json:"finished_at"
+	FinishedAt int64 `protobuf:"varint,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at" bson:"finished_at"`
+	// 失败原因
+	// @gotags: bson:"message" json:"message"
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message" bson:"message"`
+}
+
+func (x *PurgeStep) Reset() {
+	*x = PurgeStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeStep) ProtoMessage() {}
+
+func (x *PurgeStep) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeStep.ProtoReflect.Descriptor instead.
+func (*PurgeStep) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PurgeStep) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *PurgeStep) GetStatus() PURGE_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return PURGE_STATUS_PENDING
+}
+
+func (x *PurgeStep) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *PurgeStep) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *PurgeStep) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// PurgeJob 域清除任务
+type PurgeJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 任务Id
+	// @gotags: bson:"_id" json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id"`
+	// 创建时间
+	// @gotags: bson:"create_at" json:"create_at"
+	CreateAt int64 `protobuf:"varint,2,opt,name=create_at,json=createAt,proto3" json:"create_at" bson:"create_at"`
+	// 域Id
+	// @gotags: bson:"domain_id" json:"domain_id"
+	DomainId string `protobuf:"bytes,3,opt,name=domain_id,json=domainId,proto3" json:"domain_id" bson:"domain_id"`
+	// 域名称
+	// @gotags: bson:"domain" json:"domain"
+	Domain string `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain" bson:"domain"`
+	// 操作人, 保留期到期自动清除时为system
+	// @gotags: bson:"operator" json:"operator"
+	Operator string `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator" bson:"operator"`
+	// 任务状态
+	// @gotags: bson:"status" json:"status"
+	Status PURGE_STATUS `protobuf:"varint,6,opt,name=status,proto3,enum=infraboard.mcenter.domain.PURGE_STATUS" json:"status" bson:"status"`
+	// 各类资源的清除进度, 按照执行顺序排列
+	// @gotags: bson:"steps" json:"steps"
+	Steps []*PurgeStep `protobuf:"bytes,7,rep,name=steps,proto3" json:"steps" bson:"steps"`
+	// 完成时间
+	// @gotags: bson:"finished_at" json:"finished_at"
+	FinishedAt int64 `protobuf:"varint,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at" bson:"finished_at"`
+	// 失败原因
+	// @gotags: bson:"message" json:"message"
+	Message string `protobuf:"bytes,9,opt,name=message,proto3" json:"message" bson:"message"`
+}
+
+func (x *PurgeJob) Reset() {
+	*x = PurgeJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeJob) ProtoMessage() {}
+
+func (x *PurgeJob) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeJob.ProtoReflect.Descriptor instead.
+func (*PurgeJob) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PurgeJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurgeJob) GetCreateAt() int64 {
+	if x != nil {
+		return x.CreateAt
+	}
+	return 0
+}
+
+func (x *PurgeJob) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+func (x *PurgeJob) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *PurgeJob) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *PurgeJob) GetStatus() PURGE_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return PURGE_STATUS_PENDING
+}
+
+func (x *PurgeJob) GetSteps() []*PurgeStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *PurgeJob) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *PurgeJob) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PurgeJobSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 总数量
+	// @gotags: bson:"total" json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total" bson:"total"`
+	// 数据项
+	// @gotags: bson:"items" json:"items"
+	Items []*PurgeJob `protobuf:"bytes,2,rep,name=items,proto3" json:"items" bson:"items"`
+}
+
+func (x *PurgeJobSet) Reset() {
+	*x = PurgeJobSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeJobSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeJobSet) ProtoMessage() {}
+
+func (x *PurgeJobSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeJobSet.ProtoReflect.Descriptor instead.
+func (*PurgeJobSet) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PurgeJobSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PurgeJobSet) GetItems() []*PurgeJob {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// QueryPurgeJobRequest 查询清除任务
+type QueryPurgeJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分页参数
+	// @gotags: json:"page"
+	Page *request.PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page"`
+	// 域名称
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+}
+
+func (x *QueryPurgeJobRequest) Reset() {
+	*x = QueryPurgeJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPurgeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPurgeJobRequest) ProtoMessage() {}
+
+func (x *QueryPurgeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPurgeJobRequest.ProtoReflect.Descriptor instead.
+func (*QueryPurgeJobRequest) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryPurgeJobRequest) GetPage() *request.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *QueryPurgeJobRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// DescribePurgeJobRequest 查询清除任务详情
+type DescribePurgeJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 任务Id
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required"`
+}
+
+func (x *DescribePurgeJobRequest) Reset() {
+	*x = DescribePurgeJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribePurgeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribePurgeJobRequest) ProtoMessage() {}
+
+func (x *DescribePurgeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribePurgeJobRequest.ProtoReflect.Descriptor instead.
+func (*DescribePurgeJobRequest) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DescribePurgeJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteDomainResourceRequest 删除域下某类资源, 由各模块实现, 清除域时调用
+type DeleteDomainResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域名称
+	// @gotags: json:"domain" validate:"required"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain" validate:"required"`
+}
+
+func (x *DeleteDomainResourceRequest) Reset() {
+	*x = DeleteDomainResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDomainResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDomainResourceRequest) ProtoMessage() {}
+
+func (x *DeleteDomainResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDomainResourceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDomainResourceRequest) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteDomainResourceRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type DeleteDomainResourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 删除的数量
+	// @gotags: json:"deleted"
+	Deleted int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted"`
+}
+
+func (x *DeleteDomainResourceResponse) Reset() {
+	*x = DeleteDomainResourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDomainResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDomainResourceResponse) ProtoMessage() {}
+
+func (x *DeleteDomainResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_lifecycle_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDomainResourceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDomainResourceResponse) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_lifecycle_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteDomainResourceResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+var File_apps_domain_pb_lifecycle_proto protoreflect.FileDescriptor
+
+var file_apps_domain_pb_lifecycle_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x62,
+	0x2f, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x19, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x1a, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2f, 0x6d, 0x63, 0x75, 0x62, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x61, 0x67, 0x65,
+	0x2f, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x02, 0x0a, 0x0c,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x74, 0x5f,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x64, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x6e,
+	0x63, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
+	0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x4f, 0x4d, 0x41, 0x49,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74,
+	0x61, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x42,
+	0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x40, 0x0a, 0x12, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0xbd, 0x01, 0x0a, 0x09, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3f,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
+	0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x55, 0x52, 0x47, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x08, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4a, 0x6f,
+	0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x55, 0x52, 0x47,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x3a, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x0b, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x39, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x66, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x75, 0x62, 0x65,
+	0x2e, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22,
+	0x29, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x1b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x22, 0x38, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x2a, 0x35, 0x0a, 0x0c, 0x44,
+	0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x12, 0x0a, 0x0a, 0x06, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42,
+	0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x2a, 0x43, 0x0a, 0x0c, 0x50, 0x55, 0x52, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_domain_pb_lifecycle_proto_rawDescOnce sync.Once
+	file_apps_domain_pb_lifecycle_proto_rawDescData = file_apps_domain_pb_lifecycle_proto_rawDesc
+)
+
+func file_apps_domain_pb_lifecycle_proto_rawDescGZIP() []byte {
+	file_apps_domain_pb_lifecycle_proto_rawDescOnce.Do(func() {
+		file_apps_domain_pb_lifecycle_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_domain_pb_lifecycle_proto_rawDescData)
+	})
+	return file_apps_domain_pb_lifecycle_proto_rawDescData
+}
+
+var file_apps_domain_pb_lifecycle_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_apps_domain_pb_lifecycle_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_apps_domain_pb_lifecycle_proto_goTypes = []interface{}{
+	(DOMAIN_STATE)(0),                    // 0: infraboard.mcenter.domain.DOMAIN_STATE
+	(PURGE_STATUS)(0),                    // 1: infraboard.mcenter.domain.PURGE_STATUS
+	(*DomainStatus)(nil),                 // 2: infraboard.mcenter.domain.DomainStatus
+	(*ChangeDomainStateRequest)(nil),     // 3: infraboard.mcenter.domain.ChangeDomainStateRequest
+	(*DeleteDomainRequest)(nil),          // 4: infraboard.mcenter.domain.DeleteDomainRequest
+	(*RestoreDomainRequest)(nil),         // 5: infraboard.mcenter.domain.RestoreDomainRequest
+	(*PurgeDomainRequest)(nil),           // 6: infraboard.mcenter.domain.PurgeDomainRequest
+	(*PurgeStep)(nil),                    // 7: infraboard.mcenter.domain.PurgeStep
+	(*PurgeJob)(nil),                     // 8: infraboard.mcenter.domain.PurgeJob
+	(*PurgeJobSet)(nil),                  // 9: infraboard.mcenter.domain.PurgeJobSet
+	(*QueryPurgeJobRequest)(nil),         // 10: infraboard.mcenter.domain.QueryPurgeJobRequest
+	(*DescribePurgeJobRequest)(nil),      // 11: infraboard.mcenter.domain.DescribePurgeJobRequest
+	(*DeleteDomainResourceRequest)(nil),  // 12: infraboard.mcenter.domain.DeleteDomainResourceRequest
+	(*DeleteDomainResourceResponse)(nil), // 13: infraboard.mcenter.domain.DeleteDomainResourceResponse
+	(*request.PageRequest)(nil),          // 14: infraboard.mcube.page.PageRequest
+}
+var file_apps_domain_pb_lifecycle_proto_depIdxs = []int32{
+	0,  // 0: infraboard.mcenter.domain.DomainStatus.state:type_name -> infraboard.mcenter.domain.DOMAIN_STATE
+	0,  // 1: infraboard.mcenter.domain.ChangeDomainStateRequest.state:type_name -> infraboard.mcenter.domain.DOMAIN_STATE
+	1,  // 2: infraboard.mcenter.domain.PurgeStep.status:type_name -> infraboard.mcenter.domain.PURGE_STATUS
+	1,  // 3: infraboard.mcenter.domain.PurgeJob.status:type_name -> infraboard.mcenter.domain.PURGE_STATUS
+	7,  // 4: infraboard.mcenter.domain.PurgeJob.steps:type_name -> infraboard.mcenter.domain.PurgeStep
+	8,  // 5: infraboard.mcenter.domain.PurgeJobSet.items:type_name -> infraboard.mcenter.domain.PurgeJob
+	14, // 6: infraboard.mcenter.domain.QueryPurgeJobRequest.page:type_name -> infraboard.mcube.page.PageRequest
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_apps_domain_pb_lifecycle_proto_init() }
+func file_apps_domain_pb_lifecycle_proto_init() {
+	if File_apps_domain_pb_lifecycle_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_domain_pb_lifecycle_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DomainStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_lifecycle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeDomainStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_lifecycle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDomainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_lifecycle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreDomainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_lifecycle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeDomainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_lifecycle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_lifecycle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_lifecycle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeJobSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_lifecycle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPurgeJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_lifecycle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribePurgeJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_lifecycle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDomainResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_lifecycle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDomainResourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_domain_pb_lifecycle_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apps_domain_pb_lifecycle_proto_goTypes,
+		DependencyIndexes: file_apps_domain_pb_lifecycle_proto_depIdxs,
+		EnumInfos:         file_apps_domain_pb_lifecycle_proto_enumTypes,
+		MessageInfos:      file_apps_domain_pb_lifecycle_proto_msgTypes,
+	}.Build()
+	File_apps_domain_pb_lifecycle_proto = out.File
+	file_apps_domain_pb_lifecycle_proto_rawDesc = nil
+	file_apps_domain_pb_lifecycle_proto_goTypes = nil
+	file_apps_domain_pb_lifecycle_proto_depIdxs = nil
+}
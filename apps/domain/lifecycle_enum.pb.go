@@ -0,0 +1,100 @@
+// Code generated by github.com/infraboard/mcube
+// DO NOT EDIT
+
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseDOMAIN_STATEFromString Parse DOMAIN_STATE from string
+func ParseDOMAIN_STATEFromString(str string) (DOMAIN_STATE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := DOMAIN_STATE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown DOMAIN_STATE: %s", str)
+	}
+
+	return DOMAIN_STATE(v), nil
+}
+
+// Equal type compare
+func (t DOMAIN_STATE) Equal(target DOMAIN_STATE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t DOMAIN_STATE) IsIn(targets ...DOMAIN_STATE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t DOMAIN_STATE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *DOMAIN_STATE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseDOMAIN_STATEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParsePURGE_STATUSFromString Parse PURGE_STATUS from string
+func ParsePURGE_STATUSFromString(str string) (PURGE_STATUS, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := PURGE_STATUS_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown PURGE_STATUS: %s", str)
+	}
+
+	return PURGE_STATUS(v), nil
+}
+
+// Equal type compare
+func (t PURGE_STATUS) Equal(target PURGE_STATUS) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t PURGE_STATUS) IsIn(targets ...PURGE_STATUS) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t PURGE_STATUS) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *PURGE_STATUS) UnmarshalJSON(b []byte) error {
+	ins, err := ParsePURGE_STATUSFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
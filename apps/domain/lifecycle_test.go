@@ -0,0 +1,63 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/domain"
+)
+
+func TestDomainLifecycle(t *testing.T) {
+	should := assert.New(t)
+
+	req := domain.NewCreateDomainRequest()
+	req.Name = "tenant01"
+	d, err := domain.New(req)
+	should.NoError(err)
+	should.Equal(domain.DOMAIN_STATE_ACTIVE, d.State())
+	should.NoError(d.CheckActive())
+
+	// 老数据没有状态
+	d.Status = nil
+	should.Equal(domain.DOMAIN_STATE_ACTIVE, d.State())
+
+	d.ChangeState(domain.DOMAIN_STATE_DISABLED, "admin", "overdue")
+	should.Error(d.CheckActive())
+
+	d.MarkDeleted(0, "admin", "")
+	should.Equal(domain.DOMAIN_STATE_DELETED, d.State())
+	should.Equal(int64(domain.DEFAULT_RETAIN_DAYS*24*time.Hour/time.Millisecond), d.Status.PurgeAt-d.Status.DeleteAt)
+
+	d.ChangeState(domain.DOMAIN_STATE_ACTIVE, "admin", "restore")
+	should.NoError(d.CheckActive())
+	should.Zero(d.Status.PurgeAt)
+
+	should.Error(domain.NewChangeDomainStateRequest(d.Id, domain.DOMAIN_STATE_DELETED).Validate())
+	should.NoError(domain.NewChangeDomainStateRequest(d.Id, domain.DOMAIN_STATE_DISABLED).Validate())
+}
+
+func TestPurgeJobProgress(t *testing.T) {
+	should := assert.New(t)
+
+	req := domain.NewCreateDomainRequest()
+	req.Name = "tenant01"
+	d, err := domain.New(req)
+	should.NoError(err)
+
+	job := domain.NewPurgeJob(d, domain.SYSTEM_OPERATOR, []string{"token", "user"})
+	should.Equal("tenant01", job.Domain)
+	should.False(job.IsFinished())
+
+	job.Steps[0].Succeed(3)
+	done, total := job.Progress()
+	should.Equal(1, done)
+	should.Equal(2, total)
+
+	job.Steps[1].Failed(errors.New("timeout"))
+	job.Failed("purge user error, timeout")
+	should.True(job.IsFinished())
+	should.Equal("timeout", job.Steps[1].Message)
+}
@@ -5,6 +5,8 @@ import (
 	"math/big"
 	"strings"
 
+	"github.com/infraboard/mcube/exception"
+
 	"github.com/infraboard/mcenter/apps/domain"
 )
 
@@ -103,6 +105,20 @@ func (g Generator) Generate() (*string, error) {
 	return &generated, nil
 }
 
+// GenerateValid 生成满足密码策略的密码, 随机生成的密码不一定包含所有要求的字符类型, 因此需要重试
+func (g Generator) GenerateValid() (*string, error) {
+	for i := 0; i < MaxGenerateRetry; i++ {
+		pass, err := g.Generate()
+		if err != nil {
+			return nil, err
+		}
+		if Validate(g.PasswordSecurity, *pass) == nil {
+			return pass, nil
+		}
+	}
+	return nil, exception.NewInternalServerError("generate password with policy failed after %d retries", MaxGenerateRetry)
+}
+
 // GenerateMany generates multiple passwords with length set
 // in the config
 func (g Generator) GenerateMany(amount int) ([]string, error) {
@@ -38,6 +38,9 @@ const (
 	// DefaultSymbolAmbiguousSet are the symbols which are removed from the
 	// chosen character set if removing ambiguous characters
 	DefaultSymbolAmbiguousSet = "<>[](){}:;'/|\\,"
+
+	// MaxGenerateRetry 生成满足策略的密码时最大的重试次数
+	MaxGenerateRetry = 100
 )
 
 var (
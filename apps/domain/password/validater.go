@@ -2,6 +2,10 @@ package password
 
 import (
 	"regexp"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/domain"
 )
 
 // Validater 校验器
@@ -21,7 +25,7 @@ func NewValidater(pass string) Validater {
 		numReg:     `[0-9]{1}`,
 		lowerReg:   `[a-z]{1}`,
 		upperReg:   `[A-Z]{1}`,
-		symbolsReg: `[!@#~$%^&*()+|_]{1}`,
+		symbolsReg: `[[:punct:]]{1}`,
 	}
 }
 
@@ -70,3 +74,28 @@ func (v *validater) match(reg string) bool {
 
 	return true
 }
+
+// Validate 按照域的密码安全策略校验密码的长度和组成
+func Validate(config *domain.PasswordSecurity, pass string) error {
+	if config == nil {
+		return nil
+	}
+
+	v := NewValidater(pass)
+	if !v.LengthOK(int(config.Length)) {
+		return exception.NewBadRequest("password length must be at least %d", config.Length)
+	}
+	if config.IncludeNumber && !v.IncludeNumbers() {
+		return exception.NewBadRequest("password must include numbers")
+	}
+	if config.IncludeLowerLetter && !v.IncludeLowercaseLetters() {
+		return exception.NewBadRequest("password must include lowercase letters")
+	}
+	if config.IncludeUpperLetter && !v.IncludeUppercaseLetters() {
+		return exception.NewBadRequest("password must include uppercase letters")
+	}
+	if config.IncludeSymbols && !v.IncludeSymbols() {
+		return exception.NewBadRequest("password must include symbols")
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package password_test
 import (
 	"testing"
 
+	"github.com/infraboard/mcenter/apps/domain"
 	"github.com/infraboard/mcenter/apps/domain/password"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,3 +17,29 @@ func TestValidater(t *testing.T) {
 	should.True(v.IncludeNumbers())
 	should.True(v.IncludeSymbols())
 }
+
+func TestValidate(t *testing.T) {
+	should := assert.New(t)
+	ps := domain.NewDefaulPasswordSecurity()
+	ps.IncludeUpperLetter = true
+	ps.IncludeSymbols = true
+
+	should.Error(password.Validate(ps, "aB1."))
+	should.Error(password.Validate(ps, "abcd1234."))
+	should.Error(password.Validate(ps, "abcD1234"))
+	should.NoError(password.Validate(ps, "abcD1234."))
+}
+
+func TestGenerateValid(t *testing.T) {
+	should := assert.New(t)
+	ps := domain.NewDefaulPasswordSecurity()
+	ps.IncludeUpperLetter = true
+	ps.IncludeSymbols = true
+
+	for i := 0; i < 20; i++ {
+		pass, err := password.New(ps).GenerateValid()
+		if should.NoError(err) {
+			should.NoError(password.Validate(ps, *pass))
+		}
+	}
+}
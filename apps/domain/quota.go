@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"net/http"
+
+	"github.com/infraboard/mcube/exception"
+)
+
+const (
+	// 配额不足异常的namespace, 用于和普通的无权限异常区分
+	QUOTA_EXCEPTION_NAMESPACE = "quota"
+)
+
+// NewQuotaExceeded 配额不足
+func NewQuotaExceeded(format string, a ...interface{}) exception.APIException {
+	return exception.NewAPIException(QUOTA_EXCEPTION_NAMESPACE, http.StatusForbidden, "", format, a...)
+}
+
+// IsQuotaExceededError 是否是配额不足的异常
+func IsQuotaExceededError(err error) bool {
+	e, ok := err.(exception.APIException)
+	if !ok {
+		return false
+	}
+	return e.ErrorCode() == http.StatusForbidden && e.Namespace() == QUOTA_EXCEPTION_NAMESPACE
+}
+
+// Limit 资源的配额, 0表示不限制
+func (q *Quota) Limit(r QUOTA_RESOURCE) int64 {
+	if q == nil {
+		return 0
+	}
+
+	switch r {
+	case QUOTA_RESOURCE_USER:
+		return q.Users
+	case QUOTA_RESOURCE_NAMESPACE:
+		return q.Namespaces
+	case QUOTA_RESOURCE_SERVICE:
+		return q.Services
+	case QUOTA_RESOURCE_INSTANCE:
+		return q.Instances
+	case QUOTA_RESOURCE_CUSTOM_ROLE:
+		return q.CustomRoles
+	case QUOTA_RESOURCE_PRIVATE_TOKEN:
+		return q.PrivateTokens
+	}
+	return 0
+}
+
+func NewResourceUsage(r QUOTA_RESOURCE, used, limit int64) *ResourceUsage {
+	return &ResourceUsage{
+		Resource: r,
+		Used:     used,
+		Limit:    limit,
+	}
+}
+
+// IsExceeded 再创建一个资源是否会超出配额
+func (u *ResourceUsage) IsExceeded() bool {
+	return u.Limit > 0 && u.Used >= u.Limit
+}
+
+func NewDomainUsage(domain string) *DomainUsage {
+	return &DomainUsage{
+		Domain: domain,
+		Items:  []*ResourceUsage{},
+	}
+}
+
+func (u *DomainUsage) Add(item *ResourceUsage) {
+	u.Items = append(u.Items, item)
+}
+
+func NewDescribeUsageRequest(id string) *DescribeUsageRequest {
+	return &DescribeUsageRequest{
+		Id: id,
+	}
+}
+
+func (req *DescribeUsageRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewCheckQuotaRequest(domain string, r QUOTA_RESOURCE, used int64) *CheckQuotaRequest {
+	return &CheckQuotaRequest{
+		Domain:   domain,
+		Resource: r,
+		Used:     used,
+	}
+}
+
+func (req *CheckQuotaRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewCountDomainResourceRequest(domain string) *CountDomainResourceRequest {
+	return &CountDomainResourceRequest{
+		Domain: domain,
+	}
+}
+
+func (req *CountDomainResourceRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewCountDomainResourceResponse(count int64) *CountDomainResourceResponse {
+	return &CountDomainResourceResponse{
+		Count: count,
+	}
+}
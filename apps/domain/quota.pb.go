@@ -0,0 +1,745 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: apps/domain/pb/quota.proto
+
+package domain
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 受配额限制的资源
+type QUOTA_RESOURCE int32
+
+const (
+	// 用户
+	QUOTA_RESOURCE_USER QUOTA_RESOURCE = 0
+	// 空间
+	QUOTA_RESOURCE_NAMESPACE QUOTA_RESOURCE = 1
+	// 服务
+	QUOTA_RESOURCE_SERVICE QUOTA_RESOURCE = 2
+	// 服务实例
+	QUOTA_RESOURCE_INSTANCE QUOTA_RESOURCE = 3
+	// 自定义角色
+	QUOTA_RESOURCE_CUSTOM_ROLE QUOTA_RESOURCE = 4
+	// 私有令牌
+	QUOTA_RESOURCE_PRIVATE_TOKEN QUOTA_RESOURCE = 5
+)
+
+// Enum value maps for QUOTA_RESOURCE.
+var (
+	QUOTA_RESOURCE_name = map[int32]string{
+		0: "USER",
+		1: "NAMESPACE",
+		2: "SERVICE",
+		3: "INSTANCE",
+		4: "CUSTOM_ROLE",
+		5: "PRIVATE_TOKEN",
+	}
+	QUOTA_RESOURCE_value = map[string]int32{
+		"USER":          0,
+		"NAMESPACE":     1,
+		"SERVICE":       2,
+		"INSTANCE":      3,
+		"CUSTOM_ROLE":   4,
+		"PRIVATE_TOKEN": 5,
+	}
+)
+
+func (x QUOTA_RESOURCE) Enum() *QUOTA_RESOURCE {
+	p := new(QUOTA_RESOURCE)
+	*p = x
+	return p
+}
+
+func (x QUOTA_RESOURCE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QUOTA_RESOURCE) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_domain_pb_quota_proto_enumTypes[0].Descriptor()
+}
+
+func (QUOTA_RESOURCE) Type() protoreflect.EnumType {
+	return &file_apps_domain_pb_quota_proto_enumTypes[0]
+}
+
+func (x QUOTA_RESOURCE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QUOTA_RESOURCE.Descriptor instead.
+func (QUOTA_RESOURCE) EnumDescriptor() ([]byte, []int) {
+	return file_apps_domain_pb_quota_proto_rawDescGZIP(), []int{0}
+}
+
+// Quota 域的资源配额, 0表示不限制
+type Quota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 用户数量
+	// @gotags: bson:"users" json:"users" validate:"gte=0"
+	Users int64 `protobuf:"varint,1,opt,name=users,proto3" json:"users" bson:"users" validate:"gte=0"`
+	// 空间数量
+	// @gotags: bson:"namespaces" json:"namespaces" validate:"gte=0"
+	Namespaces int64 `protobuf:"varint,2,opt,name=namespaces,proto3" json:"namespaces" bson:"namespaces" validate:"gte=0"`
+	// 服务数量
+	// @gotags: bson:"services" json:"services" validate:"gte=0"
+	Services int64 `protobuf:"varint,3,opt,name=services,proto3" json:"services" bson:"services" validate:"gte=0"`
+	// 服务实例数量
+	// @gotags: bson:"instances" json:"instances" validate:"gte=0"
+	Instances int64 `protobuf:"varint,4,opt,name=instances,proto3" json:"instances" bson:"instances" validate:"gte=0"`
+	// 自定义角色数量
+	// @gotags: bson:"custom_roles" json:"custom_roles" validate:"gte=0"
+	CustomRoles int64 `protobuf:"varint,5,opt,name=custom_roles,json=customRoles,proto3" json:"custom_roles" bson:"custom_roles" validate:"gte=0"`
+	// 私有令牌数量
+	// @gotags: bson:"private_tokens" json:"private_tokens" validate:"gte=0"
+	PrivateTokens int64 `protobuf:"varint,6,opt,name=private_tokens,json=privateTokens,proto3" json:"private_tokens" bson:"private_tokens" validate:"gte=0"`
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_quota_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_quota_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_quota_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Quota) GetUsers() int64 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *Quota) GetNamespaces() int64 {
+	if x != nil {
+		return x.Namespaces
+	}
+	return 0
+}
+
+func (x *Quota) GetServices() int64 {
+	if x != nil {
+		return x.Services
+	}
+	return 0
+}
+
+func (x *Quota) GetInstances() int64 {
+	if x != nil {
+		return x.Instances
+	}
+	return 0
+}
+
+func (x *Quota) GetCustomRoles() int64 {
+	if x != nil {
+		return x.CustomRoles
+	}
+	return 0
+}
+
+func (x *Quota) GetPrivateTokens() int64 {
+	if x != nil {
+		return x.PrivateTokens
+	}
+	return 0
+}
+
+// ResourceUsage 某类资源的用量
+type ResourceUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源
+	// @gotags: json:"resource"
+	Resource QUOTA_RESOURCE `protobuf:"varint,1,opt,name=resource,proto3,enum=infraboard.mcenter.domain.QUOTA_RESOURCE" json:"resource"`
+	// 已使用的数量
+	// @gotags: json:"used"
+	Used int64 `protobuf:"varint,2,opt,name=used,proto3" json:"used"`
+	// 配额, 0表示不限制
+	// @gotags: json:"limit"
+	Limit int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit"`
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_quota_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsage) ProtoMessage() {}
+
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_quota_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_quota_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResourceUsage) GetResource() QUOTA_RESOURCE {
+	if x != nil {
+		return x.Resource
+	}
+	return QUOTA_RESOURCE_USER
+}
+
+func (x *ResourceUsage) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// DomainUsage 域的资源用量
+type DomainUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域名称
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	// 各类资源的用量
+	// @gotags: json:"items"
+	Items []*ResourceUsage `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+}
+
+func (x *DomainUsage) Reset() {
+	*x = DomainUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_quota_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DomainUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainUsage) ProtoMessage() {}
+
+func (x *DomainUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_quota_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainUsage.ProtoReflect.Descriptor instead.
+func (*DomainUsage) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_quota_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DomainUsage) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *DomainUsage) GetItems() []*ResourceUsage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// DescribeUsageRequest 查询域的资源用量
+type DescribeUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域Id
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required"`
+}
+
+func (x *DescribeUsageRequest) Reset() {
+	*x = DescribeUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_quota_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeUsageRequest) ProtoMessage() {}
+
+func (x *DescribeUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_quota_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeUsageRequest.ProtoReflect.Descriptor instead.
+func (*DescribeUsageRequest) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_quota_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DescribeUsageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// CheckQuotaRequest 创建资源前检查配额
+type CheckQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域名称
+	// @gotags: json:"domain" validate:"required"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain" validate:"required"`
+	// 资源
+	// @gotags: json:"resource"
+	Resource QUOTA_RESOURCE `protobuf:"varint,2,opt,name=resource,proto3,enum=infraboard.mcenter.domain.QUOTA_RESOURCE" json:"resource"`
+	// 当前已使用的数量
+	// @gotags: json:"used"
+	Used int64 `protobuf:"varint,3,opt,name=used,proto3" json:"used"`
+}
+
+func (x *CheckQuotaRequest) Reset() {
+	*x = CheckQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_quota_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuotaRequest) ProtoMessage() {}
+
+func (x *CheckQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_quota_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuotaRequest.ProtoReflect.Descriptor instead.
+func (*CheckQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_quota_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CheckQuotaRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CheckQuotaRequest) GetResource() QUOTA_RESOURCE {
+	if x != nil {
+		return x.Resource
+	}
+	return QUOTA_RESOURCE_USER
+}
+
+func (x *CheckQuotaRequest) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+// CountDomainResourceRequest 统计域下某类资源的数量, 由各模块实现
+type CountDomainResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域名称
+	// @gotags: json:"domain" validate:"required"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain" validate:"required"`
+}
+
+func (x *CountDomainResourceRequest) Reset() {
+	*x = CountDomainResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_quota_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountDomainResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountDomainResourceRequest) ProtoMessage() {}
+
+func (x *CountDomainResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_quota_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountDomainResourceRequest.ProtoReflect.Descriptor instead.
+func (*CountDomainResourceRequest) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_quota_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CountDomainResourceRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type CountDomainResourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源数量
+	// @gotags: json:"count"
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *CountDomainResourceResponse) Reset() {
+	*x = CountDomainResourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_quota_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountDomainResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountDomainResourceResponse) ProtoMessage() {}
+
+func (x *CountDomainResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_quota_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountDomainResourceResponse.ProtoReflect.Descriptor instead.
+func (*CountDomainResourceResponse) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_quota_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CountDomainResourceResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_apps_domain_pb_quota_proto protoreflect.FileDescriptor
+
+var file_apps_domain_pb_quota_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x62,
+	0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0xc1, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0d,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x45, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x29, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x51, 0x55, 0x4f, 0x54,
+	0x41, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x65,
+	0x0a, 0x0b, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x3e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x86, 0x01,
+	0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x45, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e,
+	0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f,
+	0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x33, 0x0a, 0x1b,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x2a, 0x68, 0x0a, 0x0e, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x53,
+	0x54, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x55, 0x53, 0x54, 0x4f,
+	0x4d, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x49, 0x56,
+	0x41, 0x54, 0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x05, 0x42, 0x2b, 0x5a, 0x29, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70,
+	0x73, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_domain_pb_quota_proto_rawDescOnce sync.Once
+	file_apps_domain_pb_quota_proto_rawDescData = file_apps_domain_pb_quota_proto_rawDesc
+)
+
+func file_apps_domain_pb_quota_proto_rawDescGZIP() []byte {
+	file_apps_domain_pb_quota_proto_rawDescOnce.Do(func() {
+		file_apps_domain_pb_quota_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_domain_pb_quota_proto_rawDescData)
+	})
+	return file_apps_domain_pb_quota_proto_rawDescData
+}
+
+var file_apps_domain_pb_quota_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_apps_domain_pb_quota_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_apps_domain_pb_quota_proto_goTypes = []interface{}{
+	(QUOTA_RESOURCE)(0),                 // 0: infraboard.mcenter.domain.QUOTA_RESOURCE
+	(*Quota)(nil),                       // 1: infraboard.mcenter.domain.Quota
+	(*ResourceUsage)(nil),               // 2: infraboard.mcenter.domain.ResourceUsage
+	(*DomainUsage)(nil),                 // 3: infraboard.mcenter.domain.DomainUsage
+	(*DescribeUsageRequest)(nil),        // 4: infraboard.mcenter.domain.DescribeUsageRequest
+	(*CheckQuotaRequest)(nil),           // 5: infraboard.mcenter.domain.CheckQuotaRequest
+	(*CountDomainResourceRequest)(nil),  // 6: infraboard.mcenter.domain.CountDomainResourceRequest
+	(*CountDomainResourceResponse)(nil), // 7: infraboard.mcenter.domain.CountDomainResourceResponse
+}
+var file_apps_domain_pb_quota_proto_depIdxs = []int32{
+	0, // 0: infraboard.mcenter.domain.ResourceUsage.resource:type_name -> infraboard.mcenter.domain.QUOTA_RESOURCE
+	2, // 1: infraboard.mcenter.domain.DomainUsage.items:type_name -> infraboard.mcenter.domain.ResourceUsage
+	0, // 2: infraboard.mcenter.domain.CheckQuotaRequest.resource:type_name -> infraboard.mcenter.domain.QUOTA_RESOURCE
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_apps_domain_pb_quota_proto_init() }
+func file_apps_domain_pb_quota_proto_init() {
+	if File_apps_domain_pb_quota_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_domain_pb_quota_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_quota_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_quota_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DomainUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_quota_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_quota_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_quota_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountDomainResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_domain_pb_quota_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountDomainResourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_domain_pb_quota_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apps_domain_pb_quota_proto_goTypes,
+		DependencyIndexes: file_apps_domain_pb_quota_proto_depIdxs,
+		EnumInfos:         file_apps_domain_pb_quota_proto_enumTypes,
+		MessageInfos:      file_apps_domain_pb_quota_proto_msgTypes,
+	}.Build()
+	File_apps_domain_pb_quota_proto = out.File
+	file_apps_domain_pb_quota_proto_rawDesc = nil
+	file_apps_domain_pb_quota_proto_goTypes = nil
+	file_apps_domain_pb_quota_proto_depIdxs = nil
+}
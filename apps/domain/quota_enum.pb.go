@@ -0,0 +1,55 @@
+// Code generated by github.com/infraboard/mcube
+// DO NOT EDIT
+
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseQUOTA_RESOURCEFromString Parse QUOTA_RESOURCE from string
+func ParseQUOTA_RESOURCEFromString(str string) (QUOTA_RESOURCE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := QUOTA_RESOURCE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown QUOTA_RESOURCE: %s", str)
+	}
+
+	return QUOTA_RESOURCE(v), nil
+}
+
+// Equal type compare
+func (t QUOTA_RESOURCE) Equal(target QUOTA_RESOURCE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t QUOTA_RESOURCE) IsIn(targets ...QUOTA_RESOURCE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t QUOTA_RESOURCE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *QUOTA_RESOURCE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseQUOTA_RESOURCEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
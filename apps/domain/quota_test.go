@@ -0,0 +1,34 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/domain"
+)
+
+func TestQuotaLimit(t *testing.T) {
+	should := assert.New(t)
+
+	// 没有配置配额时不限制
+	var q *domain.Quota
+	should.Zero(q.Limit(domain.QUOTA_RESOURCE_USER))
+
+	q = &domain.Quota{Users: 2, PrivateTokens: 5}
+	should.Equal(int64(2), q.Limit(domain.QUOTA_RESOURCE_USER))
+	should.Equal(int64(5), q.Limit(domain.QUOTA_RESOURCE_PRIVATE_TOKEN))
+	should.Zero(q.Limit(domain.QUOTA_RESOURCE_NAMESPACE))
+
+	should.False(domain.NewResourceUsage(domain.QUOTA_RESOURCE_USER, 1, 2).IsExceeded())
+	should.True(domain.NewResourceUsage(domain.QUOTA_RESOURCE_USER, 2, 2).IsExceeded())
+	should.False(domain.NewResourceUsage(domain.QUOTA_RESOURCE_USER, 100, 0).IsExceeded())
+}
+
+func TestQuotaExceededError(t *testing.T) {
+	should := assert.New(t)
+
+	should.True(domain.IsQuotaExceededError(domain.NewQuotaExceeded("user quota exceeded")))
+	should.False(domain.IsQuotaExceededError(exception.NewPermissionDeny("deny")))
+}
@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// 日期类型属性值的格式
+	ATTRIBUTE_DATE_LAYOUT = "2006-01-02"
+)
+
+var (
+	attributeNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+// NewUserAttribute 用户自定义属性
+func NewUserAttribute(name string, t ATTRIBUTE_TYPE) *UserAttribute {
+	return &UserAttribute{
+		Name:       name,
+		Type:       t,
+		EnumValues: []string{},
+	}
+}
+
+// Validate 校验属性定义
+func (a *UserAttribute) Validate() error {
+	if err := validate.Struct(a); err != nil {
+		return err
+	}
+	if !attributeNameRegexp.MatchString(a.Name) {
+		return fmt.Errorf("user attribute name %s invalidate, must match %s", a.Name, attributeNameRegexp)
+	}
+	if a.Type.Equal(ATTRIBUTE_TYPE_ENUM) && len(a.EnumValues) == 0 {
+		return fmt.Errorf("user attribute %s enum values required", a.Name)
+	}
+	if a.Pattern != "" {
+		if _, err := regexp.Compile(a.Pattern); err != nil {
+			return fmt.Errorf("user attribute %s pattern invalidate, %s", a.Name, err)
+		}
+	}
+	return nil
+}
+
+// ValidateValue 校验属性值是否满足定义, 引用用户是否存在需要调用方检查
+func (a *UserAttribute) ValidateValue(v string) error {
+	if a.MaxLength > 0 && uint32(utf8.RuneCountInString(v)) > a.MaxLength {
+		return fmt.Errorf("attribute %s length must less than %d", a.Name, a.MaxLength)
+	}
+
+	switch a.Type {
+	case ATTRIBUTE_TYPE_NUMBER:
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return fmt.Errorf("attribute %s must be number", a.Name)
+		}
+	case ATTRIBUTE_TYPE_ENUM:
+		if !a.IsEnumValue(v) {
+			return fmt.Errorf("attribute %s must be one of %v", a.Name, a.EnumValues)
+		}
+	case ATTRIBUTE_TYPE_DATE:
+		if _, err := time.Parse(ATTRIBUTE_DATE_LAYOUT, v); err != nil {
+			return fmt.Errorf("attribute %s must be date, format %s", a.Name, ATTRIBUTE_DATE_LAYOUT)
+		}
+	case ATTRIBUTE_TYPE_STRING:
+		if a.Pattern != "" {
+			ok, err := regexp.MatchString(a.Pattern, v)
+			if err != nil || !ok {
+				return fmt.Errorf("attribute %s not match pattern %s", a.Name, a.Pattern)
+			}
+		}
+	}
+	return nil
+}
+
+// IsEnumValue 值是否是枚举选项之一
+func (a *UserAttribute) IsEnumValue(v string) bool {
+	for _, item := range a.EnumValues {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+// GetUserAttribute 通过名称查询属性定义
+func (req *CreateDomainRequest) GetUserAttribute(name string) *UserAttribute {
+	for i := range req.UserAttributes {
+		if req.UserAttributes[i].Name == name {
+			return req.UserAttributes[i]
+		}
+	}
+	return nil
+}
+
+// ValidateUserAttributes 校验用户的属性值, 返回引用用户类型的属性值, 由调用方检查用户是否存在,
+// required为false时不检查必填属性, 比如外部身份源自动创建的用户
+func (req *CreateDomainRequest) ValidateUserAttributes(attrs map[string]string, required bool) (map[string]string, error) {
+	refs := map[string]string{}
+	for k, v := range attrs {
+		a := req.GetUserAttribute(k)
+		if a == nil {
+			return nil, fmt.Errorf("attribute %s not defined in domain %s", k, req.Name)
+		}
+		// 空值表示清除该属性
+		if v == "" {
+			continue
+		}
+		if err := a.ValidateValue(v); err != nil {
+			return nil, err
+		}
+		if a.Type.Equal(ATTRIBUTE_TYPE_USER_REF) {
+			refs[k] = v
+		}
+	}
+
+	for _, a := range req.UserAttributes {
+		if required && a.Required && attrs[a.Name] == "" {
+			return nil, fmt.Errorf("attribute %s required", a.Name)
+		}
+	}
+	return refs, nil
+}
+
+// ValidateAttributeFilter 用户列表只允许使用可查询的属性过滤
+func (req *CreateDomainRequest) ValidateAttributeFilter(filter map[string]string) error {
+	for k := range filter {
+		a := req.GetUserAttribute(k)
+		if a == nil {
+			return fmt.Errorf("attribute %s not defined in domain %s", k, req.Name)
+		}
+		if !a.Searchable {
+			return fmt.Errorf("attribute %s not searchable", k)
+		}
+	}
+	return nil
+}
+
+// TokenClaims 需要写入令牌的属性值
+func (req *CreateDomainRequest) TokenClaims(attrs map[string]string) map[string]string {
+	claims := map[string]string{}
+	for _, a := range req.UserAttributes {
+		if v, ok := attrs[a.Name]; ok && a.TokenClaim && v != "" {
+			claims[a.Name] = v
+		}
+	}
+	return claims
+}
+
+// validateUserAttributes 属性名称不能重复
+func (req *CreateDomainRequest) validateUserAttributes() error {
+	names := map[string]bool{}
+	for _, a := range req.UserAttributes {
+		if names[a.Name] {
+			return fmt.Errorf("user attribute %s duplicate", a.Name)
+		}
+		names[a.Name] = true
+		if err := a.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
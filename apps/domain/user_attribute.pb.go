@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: apps/domain/pb/user_attribute.proto
+
+package domain
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 自定义属性的值类型
+type ATTRIBUTE_TYPE int32
+
+const (
+	// 字符串
+	ATTRIBUTE_TYPE_STRING ATTRIBUTE_TYPE = 0
+	// 数字
+	ATTRIBUTE_TYPE_NUMBER ATTRIBUTE_TYPE = 1
+	// 枚举, 值必须是枚举选项中的一个
+	ATTRIBUTE_TYPE_ENUM ATTRIBUTE_TYPE = 2
+	// 日期, 格式: 2006-01-02
+	ATTRIBUTE_TYPE_DATE ATTRIBUTE_TYPE = 3
+	// 引用同域下的用户, 值为用户名
+	ATTRIBUTE_TYPE_USER_REF ATTRIBUTE_TYPE = 4
+)
+
+// Enum value maps for ATTRIBUTE_TYPE.
+var (
+	ATTRIBUTE_TYPE_name = map[int32]string{
+		0: "STRING",
+		1: "NUMBER",
+		2: "ENUM",
+		3: "DATE",
+		4: "USER_REF",
+	}
+	ATTRIBUTE_TYPE_value = map[string]int32{
+		"STRING":   0,
+		"NUMBER":   1,
+		"ENUM":     2,
+		"DATE":     3,
+		"USER_REF": 4,
+	}
+)
+
+func (x ATTRIBUTE_TYPE) Enum() *ATTRIBUTE_TYPE {
+	p := new(ATTRIBUTE_TYPE)
+	*p = x
+	return p
+}
+
+func (x ATTRIBUTE_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ATTRIBUTE_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_domain_pb_user_attribute_proto_enumTypes[0].Descriptor()
+}
+
+func (ATTRIBUTE_TYPE) Type() protoreflect.EnumType {
+	return &file_apps_domain_pb_user_attribute_proto_enumTypes[0]
+}
+
+func (x ATTRIBUTE_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ATTRIBUTE_TYPE.Descriptor instead.
+func (ATTRIBUTE_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_apps_domain_pb_user_attribute_proto_rawDescGZIP(), []int{0}
+}
+
+// UserAttribute 域自定义的用户属性, 比如工号, 部门, 成本中心, 上级
+type UserAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 属性名称, 域内唯一, 小写字母开头, 只能包含小写字母, 数字和下划线
+	// @gotags: bson:"name" json:"name" validate:"required,lte=40"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" bson:"name" validate:"required,lte=40"`
+	// 展示名称
+	// @gotags: bson:"display_name" json:"display_name" validate:"lte=60"
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name" bson:"display_name" validate:"lte=60"`
+	// 值类型
+	// @gotags: bson:"type" json:"type"
+	Type ATTRIBUTE_TYPE `protobuf:"varint,3,opt,name=type,proto3,enum=infraboard.mcenter.domain.ATTRIBUTE_TYPE" json:"type" bson:"type"`
+	// 是否必填
+	// @gotags: bson:"required" json:"required"
+	Required bool `protobuf:"varint,4,opt,name=required,proto3" json:"required" bson:"required"`
+	// 是否允许作为用户列表的查询条件
+	// @gotags: bson:"searchable" json:"searchable"
+	Searchable bool `protobuf:"varint,5,opt,name=searchable,proto3" json:"searchable" bson:"searchable"`
+	// 是否写入令牌的claims中
+	// @gotags: bson:"token_claim" json:"token_claim"
+	TokenClaim bool `protobuf:"varint,6,opt,name=token_claim,json=tokenClaim,proto3" json:"token_claim" bson:"token_claim"`
+	// 枚举选项, 类型为ENUM时必填
+	// @gotags: bson:"enum_values" json:"enum_values"
+	EnumValues []string `protobuf:"bytes,7,rep,name=enum_values,json=enumValues,proto3" json:"enum_values" bson:"enum_values"`
+	// 值的正则校验, 类型为STRING时有效
+	// @gotags: bson:"pattern" json:"pattern"
+	Pattern string `protobuf:"bytes,8,opt,name=pattern,proto3" json:"pattern" bson:"pattern"`
+	// 值的最大长度, 为0时不限制
+	// @gotags: bson:"max_length" json:"max_length"
+	MaxLength uint32 `protobuf:"varint,9,opt,name=max_length,json=maxLength,proto3" json:"max_length" bson:"max_length"`
+	// 描述
+	// @gotags: bson:"description" json:"description"
+	Description string `protobuf:"bytes,10,opt,name=description,proto3" json:"description" bson:"description"`
+}
+
+func (x *UserAttribute) Reset() {
+	*x = UserAttribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_domain_pb_user_attribute_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAttribute) ProtoMessage() {}
+
+func (x *UserAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_domain_pb_user_attribute_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAttribute.ProtoReflect.Descriptor instead.
+func (*UserAttribute) Descriptor() ([]byte, []int) {
+	return file_apps_domain_pb_user_attribute_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserAttribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserAttribute) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserAttribute) GetType() ATTRIBUTE_TYPE {
+	if x != nil {
+		return x.Type
+	}
+	return ATTRIBUTE_TYPE_STRING
+}
+
+func (x *UserAttribute) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *UserAttribute) GetSearchable() bool {
+	if x != nil {
+		return x.Searchable
+	}
+	return false
+}
+
+func (x *UserAttribute) GetTokenClaim() bool {
+	if x != nil {
+		return x.TokenClaim
+	}
+	return false
+}
+
+func (x *UserAttribute) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *UserAttribute) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *UserAttribute) GetMaxLength() uint32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *UserAttribute) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_apps_domain_pb_user_attribute_proto protoreflect.FileDescriptor
+
+var file_apps_domain_pb_user_attribute_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x62,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x22, 0xde, 0x02, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x2e, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x75, 0x6d,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x2a, 0x4a, 0x0a, 0x0e, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x45,
+	0x4e, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12,
+	0x0c, 0x0a, 0x08, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x46, 0x10, 0x04, 0x42, 0x2b, 0x5a,
+	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72,
+	0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x70, 0x73, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_apps_domain_pb_user_attribute_proto_rawDescOnce sync.Once
+	file_apps_domain_pb_user_attribute_proto_rawDescData = file_apps_domain_pb_user_attribute_proto_rawDesc
+)
+
+func file_apps_domain_pb_user_attribute_proto_rawDescGZIP() []byte {
+	file_apps_domain_pb_user_attribute_proto_rawDescOnce.Do(func() {
+		file_apps_domain_pb_user_attribute_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_domain_pb_user_attribute_proto_rawDescData)
+	})
+	return file_apps_domain_pb_user_attribute_proto_rawDescData
+}
+
+var file_apps_domain_pb_user_attribute_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_apps_domain_pb_user_attribute_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_apps_domain_pb_user_attribute_proto_goTypes = []interface{}{
+	(ATTRIBUTE_TYPE)(0),   // 0: infraboard.mcenter.domain.ATTRIBUTE_TYPE
+	(*UserAttribute)(nil), // 1: infraboard.mcenter.domain.UserAttribute
+}
+var file_apps_domain_pb_user_attribute_proto_depIdxs = []int32{
+	0, // 0: infraboard.mcenter.domain.UserAttribute.type:type_name -> infraboard.mcenter.domain.ATTRIBUTE_TYPE
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_apps_domain_pb_user_attribute_proto_init() }
+func file_apps_domain_pb_user_attribute_proto_init() {
+	if File_apps_domain_pb_user_attribute_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_domain_pb_user_attribute_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_domain_pb_user_attribute_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apps_domain_pb_user_attribute_proto_goTypes,
+		DependencyIndexes: file_apps_domain_pb_user_attribute_proto_depIdxs,
+		EnumInfos:         file_apps_domain_pb_user_attribute_proto_enumTypes,
+		MessageInfos:      file_apps_domain_pb_user_attribute_proto_msgTypes,
+	}.Build()
+	File_apps_domain_pb_user_attribute_proto = out.File
+	file_apps_domain_pb_user_attribute_proto_rawDesc = nil
+	file_apps_domain_pb_user_attribute_proto_goTypes = nil
+	file_apps_domain_pb_user_attribute_proto_depIdxs = nil
+}
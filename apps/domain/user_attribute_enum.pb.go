@@ -0,0 +1,55 @@
+// Code generated by github.com/infraboard/mcube
+// DO NOT EDIT
+
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseATTRIBUTE_TYPEFromString Parse ATTRIBUTE_TYPE from string
+func ParseATTRIBUTE_TYPEFromString(str string) (ATTRIBUTE_TYPE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := ATTRIBUTE_TYPE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown ATTRIBUTE_TYPE: %s", str)
+	}
+
+	return ATTRIBUTE_TYPE(v), nil
+}
+
+// Equal type compare
+func (t ATTRIBUTE_TYPE) Equal(target ATTRIBUTE_TYPE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t ATTRIBUTE_TYPE) IsIn(targets ...ATTRIBUTE_TYPE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t ATTRIBUTE_TYPE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *ATTRIBUTE_TYPE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseATTRIBUTE_TYPEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
@@ -0,0 +1,61 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/domain"
+)
+
+func TestValidateUserAttributes(t *testing.T) {
+	should := assert.New(t)
+
+	employeeId := domain.NewUserAttribute("employee_id", domain.ATTRIBUTE_TYPE_STRING)
+	employeeId.Required = true
+	employeeId.Pattern = `^E\d+$`
+	level := domain.NewUserAttribute("level", domain.ATTRIBUTE_TYPE_NUMBER)
+	department := domain.NewUserAttribute("department", domain.ATTRIBUTE_TYPE_ENUM)
+	department.EnumValues = []string{"ops", "dev"}
+	department.Searchable = true
+	department.TokenClaim = true
+	joinAt := domain.NewUserAttribute("join_at", domain.ATTRIBUTE_TYPE_DATE)
+	manager := domain.NewUserAttribute("manager", domain.ATTRIBUTE_TYPE_USER_REF)
+
+	req := domain.NewCreateDomainRequest()
+	req.Name = "default"
+	req.UserAttributes = []*domain.UserAttribute{employeeId, level, department, joinAt, manager}
+	should.NoError(req.Validate())
+
+	refs, err := req.ValidateUserAttributes(map[string]string{
+		"employee_id": "E1001",
+		"level":       "3",
+		"department":  "ops",
+		"join_at":     "2023-01-02",
+		"manager":     "bob",
+	}, true)
+	should.NoError(err)
+	should.Equal(map[string]string{"manager": "bob"}, refs)
+
+	for _, attrs := range []map[string]string{
+		{"level": "3"},
+		{"employee_id": "1001"},
+		{"employee_id": "E1001", "level": "three"},
+		{"employee_id": "E1001", "department": "sales"},
+		{"employee_id": "E1001", "join_at": "2023/01/02"},
+		{"employee_id": "E1001", "unknown": "x"},
+	} {
+		_, err := req.ValidateUserAttributes(attrs, true)
+		should.Error(err, attrs)
+	}
+	_, err = req.ValidateUserAttributes(map[string]string{"level": "3"}, false)
+	should.NoError(err)
+
+	should.NoError(req.ValidateAttributeFilter(map[string]string{"department": "ops"}))
+	should.Error(req.ValidateAttributeFilter(map[string]string{"level": "3"}))
+	should.Equal(map[string]string{"department": "ops"},
+		req.TokenClaims(map[string]string{"department": "ops", "level": "3"}))
+
+	req.UserAttributes = append(req.UserAttributes, domain.NewUserAttribute("Level", domain.ATTRIBUTE_TYPE_ENUM))
+	should.Error(req.Validate())
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/http/restful/response"
+
+	"github.com/infraboard/mcenter/apps/group"
+)
+
+func (h *handler) CreateGroup(r *restful.Request, w *restful.Response) {
+	req := group.NewCreateGroupRequest()
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	ins, err := h.service.CreateGroup(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) QueryGroup(r *restful.Request, w *restful.Response) {
+	req := group.NewQueryGroupRequestFromHTTP(r.Request)
+	set, err := h.service.QueryGroup(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *handler) DescribeGroup(r *restful.Request, w *restful.Response) {
+	req := group.NewDescribeGroupRequest(r.PathParameter("id"))
+	ins, err := h.service.DescribeGroup(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) PutGroup(r *restful.Request, w *restful.Response) {
+	req := group.NewPutGroupRequest(r.PathParameter("id"))
+	if err := r.ReadEntity(req.Spec); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	ins, err := h.service.UpdateGroup(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) PatchGroup(r *restful.Request, w *restful.Response) {
+	req := group.NewPatchGroupRequest(r.PathParameter("id"))
+	if err := r.ReadEntity(req.Spec); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	ins, err := h.service.UpdateGroup(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) DeleteGroup(r *restful.Request, w *restful.Response) {
+	req := group.NewDeleteGroupRequest(r.PathParameter("id"))
+	ins, err := h.service.DeleteGroup(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) AddUserToGroup(r *restful.Request, w *restful.Response) {
+	req := group.NewAddUserToGroupRequest(r.PathParameter("id"))
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.GroupId = r.PathParameter("id")
+
+	ins, err := h.service.AddUserToGroup(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) RemoveUserFromGroup(r *restful.Request, w *restful.Response) {
+	req := group.NewRemoveUserFromGroupRequest(r.PathParameter("id"))
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.GroupId = r.PathParameter("id")
+
+	ins, err := h.service.RemoveUserFromGroup(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) QueryUserGroup(r *restful.Request, w *restful.Response) {
+	req := group.NewQueryUserGroupRequest(r.QueryParameter("domain"), r.PathParameter("user_id"))
+	if v := r.QueryParameter("with_parent"); v != "" {
+		req.WithParent = v == "true"
+	}
+
+	set, err := h.service.QueryUserGroup(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
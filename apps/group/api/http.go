@@ -0,0 +1,101 @@
+package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/mcenter/apps/group"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service group.Service
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(group.AppName)
+	h.service = app.GetInternalApp(group.AppName).(group.Service)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return group.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"用户组管理"}
+
+	ws.Route(ws.POST("/").To(h.CreateGroup).
+		Doc("创建用户组").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(group.CreateGroupRequest{}).
+		Returns(200, "创建成功", &group.Group{}))
+
+	ws.Route(ws.GET("/").To(h.QueryGroup).
+		Doc("查询用户组列表").
+		Param(ws.QueryParameter("domain", "domain of the group").DataType("string")).
+		Param(ws.QueryParameter("parent_id", "parent group id").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", group.GroupSet{}))
+
+	ws.Route(ws.GET("/{id}").To(h.DescribeGroup).
+		Doc("查询用户组详情").
+		Param(ws.PathParameter("id", "identifier of the group").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(group.Group{}).
+		Returns(200, "OK", group.Group{}))
+
+	ws.Route(ws.PUT("/{id}").To(h.PutGroup).
+		Doc("修改用户组").
+		Param(ws.PathParameter("id", "identifier of the group").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(group.CreateGroupRequest{}))
+
+	ws.Route(ws.PATCH("/{id}").To(h.PatchGroup).
+		Doc("修改用户组").
+		Param(ws.PathParameter("id", "identifier of the group").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(group.CreateGroupRequest{}))
+
+	ws.Route(ws.DELETE("/{id}").To(h.DeleteGroup).
+		Doc("删除用户组").
+		Param(ws.PathParameter("id", "identifier of the group").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags))
+
+	ws.Route(ws.POST("/{id}/users").To(h.AddUserToGroup).
+		Doc("添加组成员").
+		Param(ws.PathParameter("id", "identifier of the group").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(group.AddUserToGroupRequest{}).
+		Returns(200, "OK", group.Group{}))
+
+	ws.Route(ws.DELETE("/{id}/users").To(h.RemoveUserFromGroup).
+		Doc("移除组成员").
+		Param(ws.PathParameter("id", "identifier of the group").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(group.RemoveUserFromGroupRequest{}).
+		Returns(200, "OK", group.Group{}))
+
+	ws.Route(ws.GET("/user/{user_id}").To(h.QueryUserGroup).
+		Doc("查询用户所在的用户组").
+		Param(ws.PathParameter("user_id", "identifier of the user").DataType("string")).
+		Param(ws.QueryParameter("domain", "domain of the user").DataType("string")).
+		Param(ws.QueryParameter("with_parent", "include parent groups").DataType("boolean")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", group.GroupSet{}))
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
@@ -0,0 +1,240 @@
+package group
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/imdario/mergo"
+	request "github.com/infraboard/mcube/http/request"
+	pb_request "github.com/infraboard/mcube/pb/request"
+	"github.com/rs/xid"
+)
+
+const (
+	AppName = "group"
+)
+
+// use a single instance of Validate, it caches struct info
+var (
+	validate = validator.New()
+)
+
+// New 实例
+func New(req *CreateGroupRequest) (*Group, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Group{
+		Id:       xid.New().String(),
+		CreateAt: time.Now().UnixMilli(),
+		Spec:     req,
+	}, nil
+}
+
+func NewCreateGroupRequest() *CreateGroupRequest {
+	return &CreateGroupRequest{
+		Users: []string{},
+	}
+}
+
+func (req *CreateGroupRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDefaultGroup() *Group {
+	return &Group{
+		Spec: NewCreateGroupRequest(),
+	}
+}
+
+// HasUser 是否是组成员
+func (g *Group) HasUser(userId string) bool {
+	for i := range g.Spec.Users {
+		if g.Spec.Users[i] == userId {
+			return true
+		}
+	}
+	return false
+}
+
+// AddUser 添加成员, 已经存在的成员忽略
+func (g *Group) AddUser(userIds ...string) {
+	for _, uid := range userIds {
+		if !g.HasUser(uid) {
+			g.Spec.Users = append(g.Spec.Users, uid)
+		}
+	}
+}
+
+// RemoveUser 移除成员
+func (g *Group) RemoveUser(userIds ...string) {
+	remove := map[string]struct{}{}
+	for _, uid := range userIds {
+		remove[uid] = struct{}{}
+	}
+
+	users := []string{}
+	for _, uid := range g.Spec.Users {
+		if _, ok := remove[uid]; !ok {
+			users = append(users, uid)
+		}
+	}
+	g.Spec.Users = users
+}
+
+func (g *Group) Update(req *UpdateGroupRequest) {
+	g.UpdateAt = time.Now().UnixMilli()
+	// 域和创建人不允许修改
+	req.Spec.Domain = g.Spec.Domain
+	req.Spec.CreateBy = g.Spec.CreateBy
+	g.Spec = req.Spec
+}
+
+func (g *Group) Patch(req *UpdateGroupRequest) error {
+	g.UpdateAt = time.Now().UnixMilli()
+	req.Spec.Domain = g.Spec.Domain
+	req.Spec.CreateBy = g.Spec.CreateBy
+	return mergo.MergeWithOverwrite(g.Spec, req.Spec)
+}
+
+func NewGroupSet() *GroupSet {
+	return &GroupSet{
+		Items: []*Group{},
+	}
+}
+
+func (s *GroupSet) Add(item *Group) {
+	s.Items = append(s.Items, item)
+}
+
+func (s *GroupSet) Len() int {
+	return len(s.Items)
+}
+
+func (s *GroupSet) GroupIds() (ids []string) {
+	for i := range s.Items {
+		ids = append(ids, s.Items[i].Id)
+	}
+	return
+}
+
+func (s *GroupSet) HasGroup(groupId string) bool {
+	for i := range s.Items {
+		if s.Items[i].Id == groupId {
+			return true
+		}
+	}
+	return false
+}
+
+// ParentIds 用户组的父组Id, 不包含已经在集合中的组
+func (s *GroupSet) ParentIds() (ids []string) {
+	pids := map[string]struct{}{}
+	for i := range s.Items {
+		pid := s.Items[i].Spec.ParentId
+		if pid == "" || s.HasGroup(pid) {
+			continue
+		}
+		if _, ok := pids[pid]; !ok {
+			pids[pid] = struct{}{}
+			ids = append(ids, pid)
+		}
+	}
+	return
+}
+
+func NewQueryGroupRequest() *QueryGroupRequest {
+	return &QueryGroupRequest{
+		Page:     request.NewDefaultPageRequest(),
+		GroupIds: []string{},
+	}
+}
+
+// NewQueryGroupRequestFromHTTP 列表查询请求
+func NewQueryGroupRequestFromHTTP(r *http.Request) *QueryGroupRequest {
+	qs := r.URL.Query()
+	req := NewQueryGroupRequest()
+	req.Page = request.NewPageRequestFromHTTP(r)
+	req.Domain = qs.Get("domain")
+	req.Name = qs.Get("name")
+	req.ParentId = qs.Get("parent_id")
+	req.UserId = qs.Get("user_id")
+	req.Keywords = qs.Get("keywords")
+	return req
+}
+
+func NewQueryUserGroupRequest(domain, userId string) *QueryUserGroupRequest {
+	return &QueryUserGroupRequest{
+		Domain:     domain,
+		UserId:     userId,
+		WithParent: true,
+	}
+}
+
+func (req *QueryUserGroupRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDescribeGroupRequest(id string) *DescribeGroupRequest {
+	return &DescribeGroupRequest{
+		Id: id,
+	}
+}
+
+func (req *DescribeGroupRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewPutGroupRequest(id string) *UpdateGroupRequest {
+	return &UpdateGroupRequest{
+		Id:         id,
+		UpdateMode: pb_request.UpdateMode_PUT,
+		Spec:       NewCreateGroupRequest(),
+	}
+}
+
+func NewPatchGroupRequest(id string) *UpdateGroupRequest {
+	return &UpdateGroupRequest{
+		Id:         id,
+		UpdateMode: pb_request.UpdateMode_PATCH,
+		Spec:       NewCreateGroupRequest(),
+	}
+}
+
+func (req *UpdateGroupRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDeleteGroupRequest(id string) *DeleteGroupRequest {
+	return &DeleteGroupRequest{
+		Id: id,
+	}
+}
+
+func (req *DeleteGroupRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewAddUserToGroupRequest(groupId string, userIds ...string) *AddUserToGroupRequest {
+	return &AddUserToGroupRequest{
+		GroupId: groupId,
+		UserIds: userIds,
+	}
+}
+
+func (req *AddUserToGroupRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewRemoveUserFromGroupRequest(groupId string, userIds ...string) *RemoveUserFromGroupRequest {
+	return &RemoveUserFromGroupRequest{
+		GroupId: groupId,
+		UserIds: userIds,
+	}
+}
+
+func (req *RemoveUserFromGroupRequest) Validate() error {
+	return validate.Struct(req)
+}
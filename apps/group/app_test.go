@@ -0,0 +1,45 @@
+package group_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/group"
+)
+
+func TestGroupMember(t *testing.T) {
+	should := assert.New(t)
+
+	g := group.NewDefaultGroup()
+	g.AddUser("u1", "u2", "u1")
+	should.Equal([]string{"u1", "u2"}, g.Spec.Users)
+	should.True(g.HasUser("u2"))
+
+	g.RemoveUser("u1", "u3")
+	should.Equal([]string{"u2"}, g.Spec.Users)
+}
+
+func TestParentIds(t *testing.T) {
+	should := assert.New(t)
+
+	newGroup := func(id, parent string) *group.Group {
+		g := group.NewDefaultGroup()
+		g.Id = id
+		g.Spec.ParentId = parent
+		return g
+	}
+
+	set := group.NewGroupSet()
+	set.Add(newGroup("a", "root"))
+	set.Add(newGroup("b", "root"))
+	set.Add(newGroup("c", "a"))
+	set.Add(newGroup("root", ""))
+	should.Empty(set.ParentIds())
+
+	set = group.NewGroupSet()
+	set.Add(newGroup("a", "root"))
+	set.Add(newGroup("b", "root"))
+	set.Add(newGroup("c", "x"))
+	should.Equal([]string{"root", "x"}, set.ParentIds())
+}
@@ -0,0 +1,8 @@
+package group
+
+const (
+	// 用户组最大的嵌套层级
+	MAX_GROUP_DEPTH = 10
+	// 单个用户最多加入的用户组数量
+	MAX_USER_GROUP = 512
+)
@@ -0,0 +1,96 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/infraboard/mcenter/apps/group"
+)
+
+func (i *impl) save(ctx context.Context, ins *group.Group) error {
+	if _, err := i.col.InsertOne(ctx, ins); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return exception.NewConflict("group %s already exists", ins.Spec.Name)
+		}
+		return exception.NewInternalServerError("inserted group(%s) document error, %s",
+			ins.Spec.Name, err)
+	}
+	return nil
+}
+
+func (i *impl) update(ctx context.Context, ins *group.Group) error {
+	if _, err := i.col.UpdateByID(ctx, ins.Id, bson.M{"$set": ins}); err != nil {
+		return exception.NewInternalServerError("update group(%s) document error, %s",
+			ins.Id, err)
+	}
+	return nil
+}
+
+func (i *impl) delete(ctx context.Context, ins *group.Group) error {
+	result, err := i.col.DeleteOne(ctx, bson.M{"_id": ins.Id})
+	if err != nil {
+		return exception.NewInternalServerError("delete group(%s) error, %s", ins.Id, err)
+	}
+
+	if result.DeletedCount == 0 {
+		return exception.NewNotFound("group %s not found", ins.Id)
+	}
+	return nil
+}
+
+func newQueryRequest(r *group.QueryGroupRequest) *queryRequest {
+	return &queryRequest{
+		r,
+	}
+}
+
+type queryRequest struct {
+	*group.QueryGroupRequest
+}
+
+func (r *queryRequest) FindOptions() *options.FindOptions {
+	pageSize := int64(r.Page.PageSize)
+	skip := r.Page.ComputeOffset()
+
+	opt := &options.FindOptions{
+		Sort: bson.D{
+			{Key: "create_at", Value: -1},
+		},
+		Limit: &pageSize,
+		Skip:  &skip,
+	}
+
+	return opt
+}
+
+func (r *queryRequest) FindFilter() bson.M {
+	filter := bson.M{}
+
+	if r.Domain != "" {
+		filter["spec.domain"] = r.Domain
+	}
+	if len(r.GroupIds) > 0 {
+		filter["_id"] = bson.M{"$in": r.GroupIds}
+	}
+	if r.Name != "" {
+		filter["spec.name"] = r.Name
+	}
+	if r.UserId != "" {
+		filter["spec.users"] = r.UserId
+	}
+	if r.ParentId != "" {
+		filter["spec.parent_id"] = r.ParentId
+	}
+	if r.Keywords != "" {
+		filter["$or"] = bson.A{
+			bson.M{"spec.name": bson.M{"$regex": r.Keywords, "$options": "im"}},
+			bson.M{"spec.description": bson.M{"$regex": r.Keywords, "$options": "im"}},
+		}
+	}
+
+	return filter
+}
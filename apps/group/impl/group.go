@@ -0,0 +1,289 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/http/request"
+	pb_request "github.com/infraboard/mcube/pb/request"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/policy"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// 创建用户组
+func (i *impl) CreateGroup(ctx context.Context, req *group.CreateGroupRequest) (*group.Group, error) {
+	ins, err := group.New(req)
+	if err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	if err := i.checkUsers(ctx, ins.Spec.Domain, ins.Spec.Users); err != nil {
+		return nil, err
+	}
+	if err := i.checkParent(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	if err := i.save(ctx, ins); err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
+
+// 查询用户组列表
+func (i *impl) QueryGroup(ctx context.Context, req *group.QueryGroupRequest) (*group.GroupSet, error) {
+	r := newQueryRequest(req)
+	resp, err := i.col.Find(ctx, r.FindFilter(), r.FindOptions())
+	if err != nil {
+		return nil, exception.NewInternalServerError("find group error, error is %s", err)
+	}
+
+	set := group.NewGroupSet()
+	for resp.Next(ctx) {
+		ins := group.NewDefaultGroup()
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode group error, error is %s", err)
+		}
+		set.Add(ins)
+	}
+
+	count, err := i.col.CountDocuments(ctx, r.FindFilter())
+	if err != nil {
+		return nil, exception.NewInternalServerError("get group count error, error is %s", err)
+	}
+	set.Total = count
+	return set, nil
+}
+
+// 查询用户组详情
+func (i *impl) DescribeGroup(ctx context.Context, req *group.DescribeGroupRequest) (*group.Group, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins := group.NewDefaultGroup()
+	if err := i.col.FindOne(ctx, bson.M{"_id": req.Id}).Decode(ins); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, exception.NewNotFound("group %s not found", req.Id)
+		}
+		return nil, exception.NewInternalServerError("find group %s error, %s", req.Id, err)
+	}
+	return ins, nil
+}
+
+// 修改用户组
+func (i *impl) UpdateGroup(ctx context.Context, req *group.UpdateGroupRequest) (*group.Group, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := i.DescribeGroup(ctx, group.NewDescribeGroupRequest(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.UpdateMode {
+	case pb_request.UpdateMode_PUT:
+		ins.Update(req)
+	case pb_request.UpdateMode_PATCH:
+		if err := ins.Patch(req); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ins.Spec.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+	if err := i.checkUsers(ctx, ins.Spec.Domain, ins.Spec.Users); err != nil {
+		return nil, err
+	}
+	if err := i.checkParent(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	if err := i.update(ctx, ins); err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
+
+// 删除用户组
+func (i *impl) DeleteGroup(ctx context.Context, req *group.DeleteGroupRequest) (*group.Group, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := i.DescribeGroup(ctx, group.NewDescribeGroupRequest(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	// 还有子组时不允许删除
+	subReq := group.NewQueryGroupRequest()
+	subReq.Domain = ins.Spec.Domain
+	subReq.ParentId = ins.Id
+	subs, err := i.QueryGroup(ctx, subReq)
+	if err != nil {
+		return nil, err
+	}
+	if subs.Total > 0 {
+		return nil, exception.NewBadRequest("该用户组还有子组, 请先删除或者移动子组")
+	}
+
+	// 还关联得有策略时不允许删除
+	policyReq := policy.NewQueryPolicyRequest()
+	policyReq.Page = request.NewPageRequest(20, 1)
+	policyReq.Domain = ins.Spec.Domain
+	policyReq.Group = ins.Id
+	ps, err := i.policy.QueryPolicy(ctx, policyReq)
+	if err != nil {
+		return nil, err
+	}
+	if ps.Total > 0 {
+		return nil, exception.NewBadRequest("该用户组还关联得有策略, 请先删除关联策略")
+	}
+
+	if err := i.delete(ctx, ins); err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
+
+// 添加组成员
+func (i *impl) AddUserToGroup(ctx context.Context, req *group.AddUserToGroupRequest) (*group.Group, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := i.DescribeGroup(ctx, group.NewDescribeGroupRequest(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.checkUsers(ctx, ins.Spec.Domain, req.UserIds); err != nil {
+		return nil, err
+	}
+
+	ins.AddUser(req.UserIds...)
+	if err := i.update(ctx, ins); err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
+
+// 移除组成员
+func (i *impl) RemoveUserFromGroup(ctx context.Context, req *group.RemoveUserFromGroupRequest) (*group.Group, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := i.DescribeGroup(ctx, group.NewDescribeGroupRequest(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	ins.RemoveUser(req.UserIds...)
+	if err := i.update(ctx, ins); err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
+
+// 查询用户所在的用户组, 包含通过子组间接加入的父组
+func (i *impl) QueryUserGroup(ctx context.Context, req *group.QueryUserGroupRequest) (*group.GroupSet, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	query := group.NewQueryGroupRequest()
+	query.Page = request.NewPageRequest(group.MAX_USER_GROUP, 1)
+	query.Domain = req.Domain
+	query.UserId = req.UserId
+	set, err := i.QueryGroup(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if set.Total > group.MAX_USER_GROUP {
+		i.log.Warnf("user %s group large than max group count %d, total: %d", req.UserId, group.MAX_USER_GROUP, set.Total)
+	}
+
+	if !req.WithParent {
+		return set, nil
+	}
+
+	// 逐层查询父组
+	for depth := 0; depth < group.MAX_GROUP_DEPTH; depth++ {
+		pids := set.ParentIds()
+		if len(pids) == 0 {
+			break
+		}
+
+		query := group.NewQueryGroupRequest()
+		query.Page = request.NewPageRequest(uint(len(pids)), 1)
+		query.Domain = req.Domain
+		query.GroupIds = pids
+		parents, err := i.QueryGroup(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		if parents.Len() == 0 {
+			break
+		}
+		for _, p := range parents.Items {
+			set.Add(p)
+		}
+	}
+
+	set.Total = int64(set.Len())
+	return set, nil
+}
+
+// 父组必须是同一个域下的用户组, 并且不能形成环
+func (i *impl) checkParent(ctx context.Context, ins *group.Group) error {
+	pid := ins.Spec.ParentId
+	for depth := 0; pid != ""; depth++ {
+		if pid == ins.Id {
+			return exception.NewBadRequest("group %s can't be nested in itself", ins.Spec.Name)
+		}
+		if depth >= group.MAX_GROUP_DEPTH {
+			return exception.NewBadRequest("group nested depth large than %d", group.MAX_GROUP_DEPTH)
+		}
+
+		p, err := i.DescribeGroup(ctx, group.NewDescribeGroupRequest(pid))
+		if err != nil {
+			return err
+		}
+		if p.Spec.Domain != ins.Spec.Domain {
+			return exception.NewBadRequest("parent group %s not found in domain %s", pid, ins.Spec.Domain)
+		}
+		pid = p.Spec.ParentId
+	}
+	return nil
+}
+
+// 组成员必须是同一个域下的用户
+func (i *impl) checkUsers(ctx context.Context, domain string, userIds []string) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	req := user.NewQueryUserRequest()
+	req.Domain = domain
+	req.UserIds = userIds
+	req.Page.PageSize = uint64(len(userIds))
+	set, err := i.user.QueryUser(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for _, uid := range userIds {
+		if !set.HasUser(uid) {
+			return exception.NewBadRequest("user %s not found in domain %s", uid, domain)
+		}
+	}
+	return nil
+}
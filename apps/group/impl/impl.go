@@ -0,0 +1,82 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+	"google.golang.org/grpc"
+
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/policy"
+	"github.com/infraboard/mcenter/apps/user"
+	"github.com/infraboard/mcenter/conf"
+)
+
+var (
+	// Service 服务实例
+	svr = &impl{}
+)
+
+type impl struct {
+	col *mongo.Collection
+	log logger.Logger
+	group.UnimplementedRPCServer
+
+	user   user.Service
+	policy policy.Service
+}
+
+func (i *impl) Config() error {
+	db, err := conf.C().Mongo.GetDB()
+	if err != nil {
+		return err
+	}
+
+	dc := db.Collection(i.Name())
+	indexs := []mongo.IndexModel{
+		{
+			Keys: bsonx.Doc{
+				{Key: "spec.domain", Value: bsonx.Int32(-1)},
+				{Key: "spec.name", Value: bsonx.Int32(-1)},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bsonx.Doc{{Key: "spec.users", Value: bsonx.Int32(-1)}},
+		},
+		{
+			Keys: bsonx.Doc{{Key: "spec.parent_id", Value: bsonx.Int32(-1)}},
+		},
+		{
+			Keys: bsonx.Doc{{Key: "create_at", Value: bsonx.Int32(-1)}},
+		},
+	}
+	_, err = dc.Indexes().CreateMany(context.Background(), indexs)
+	if err != nil {
+		return err
+	}
+
+	i.col = dc
+	i.log = zap.L().Named(i.Name())
+	i.user = app.GetInternalApp(user.AppName).(user.Service)
+	i.policy = app.GetInternalApp(policy.AppName).(policy.Service)
+	return nil
+}
+
+func (i *impl) Name() string {
+	return group.AppName
+}
+
+func (i *impl) Registry(server *grpc.Server) {
+	group.RegisterRPCServer(server, svr)
+}
+
+func init() {
+	app.RegistryInternalApp(svr)
+	app.RegistryGrpcApp(svr)
+}
@@ -0,0 +1,74 @@
+package impl_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/test/tools"
+	"github.com/infraboard/mcube/app"
+)
+
+var (
+	impl group.Service
+	ctx  = context.Background()
+)
+
+func TestCreateGroup(t *testing.T) {
+	req := group.NewCreateGroupRequest()
+	req.Domain = domain.DEFAULT_DOMAIN
+	req.Name = "dev"
+	req.CreateBy = "admin"
+	r, err := impl.CreateGroup(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
+func TestQueryGroup(t *testing.T) {
+	req := group.NewQueryGroupRequest()
+	req.Domain = domain.DEFAULT_DOMAIN
+	r, err := impl.QueryGroup(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
+func TestAddUserToGroup(t *testing.T) {
+	req := group.NewAddUserToGroupRequest("cdu7n4ea0brlnbcmbj2g", "cdu7n4ea0brlnbcmbj3g")
+	r, err := impl.AddUserToGroup(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
+func TestCreateSubGroup(t *testing.T) {
+	req := group.NewCreateGroupRequest()
+	req.Domain = domain.DEFAULT_DOMAIN
+	req.Name = "dev-backend"
+	req.ParentId = "cdu7n4ea0brlnbcmbj2g"
+	req.CreateBy = "admin"
+	r, err := impl.CreateGroup(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
+func TestQueryUserGroup(t *testing.T) {
+	req := group.NewQueryUserGroupRequest(domain.DEFAULT_DOMAIN, "cdu7n4ea0brlnbcmbj3g")
+	r, err := impl.QueryUserGroup(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
+func init() {
+	tools.DevelopmentSetup()
+	impl = app.GetInternalApp(group.AppName).(group.Service)
+}
@@ -0,0 +1,17 @@
+package group
+
+import context "context"
+
+type Service interface {
+	// 创建用户组
+	CreateGroup(context.Context, *CreateGroupRequest) (*Group, error)
+	// 修改用户组
+	UpdateGroup(context.Context, *UpdateGroupRequest) (*Group, error)
+	// 删除用户组
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*Group, error)
+	// 添加组成员
+	AddUserToGroup(context.Context, *AddUserToGroupRequest) (*Group, error)
+	// 移除组成员
+	RemoveUserFromGroup(context.Context, *RemoveUserFromGroupRequest) (*Group, error)
+	RPCServer
+}
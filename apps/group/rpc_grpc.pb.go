@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.6
+// source: apps/group/pb/rpc.proto
+
+package group
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RPCClient is the client API for RPC service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RPCClient interface {
+	// 查询用户组列表
+	QueryGroup(ctx context.Context, in *QueryGroupRequest, opts ...grpc.CallOption) (*GroupSet, error)
+	// 查询用户组详情
+	DescribeGroup(ctx context.Context, in *DescribeGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	// 查询用户所在的用户组
+	QueryUserGroup(ctx context.Context, in *QueryUserGroupRequest, opts ...grpc.CallOption) (*GroupSet, error)
+}
+
+type rPCClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRPCClient(cc grpc.ClientConnInterface) RPCClient {
+	return &rPCClient{cc}
+}
+
+func (c *rPCClient) QueryGroup(ctx context.Context, in *QueryGroupRequest, opts ...grpc.CallOption) (*GroupSet, error) {
+	out := new(GroupSet)
+	err := c.cc.Invoke(ctx, "/infraboard.mcenter.group.RPC/QueryGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) DescribeGroup(ctx context.Context, in *DescribeGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	out := new(Group)
+	err := c.cc.Invoke(ctx, "/infraboard.mcenter.group.RPC/DescribeGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) QueryUserGroup(ctx context.Context, in *QueryUserGroupRequest, opts ...grpc.CallOption) (*GroupSet, error) {
+	out := new(GroupSet)
+	err := c.cc.Invoke(ctx, "/infraboard.mcenter.group.RPC/QueryUserGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCServer is the server API for RPC service.
+// All implementations must embed UnimplementedRPCServer
+// for forward compatibility
+type RPCServer interface {
+	// 查询用户组列表
+	QueryGroup(context.Context, *QueryGroupRequest) (*GroupSet, error)
+	// 查询用户组详情
+	DescribeGroup(context.Context, *DescribeGroupRequest) (*Group, error)
+	// 查询用户所在的用户组
+	QueryUserGroup(context.Context, *QueryUserGroupRequest) (*GroupSet, error)
+	mustEmbedUnimplementedRPCServer()
+}
+
+// UnimplementedRPCServer must be embedded to have forward compatible implementations.
+type UnimplementedRPCServer struct {
+}
+
+func (UnimplementedRPCServer) QueryGroup(context.Context, *QueryGroupRequest) (*GroupSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroup not implemented")
+}
+func (UnimplementedRPCServer) DescribeGroup(context.Context, *DescribeGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeGroup not implemented")
+}
+func (UnimplementedRPCServer) QueryUserGroup(context.Context, *QueryUserGroupRequest) (*GroupSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUserGroup not implemented")
+}
+func (UnimplementedRPCServer) mustEmbedUnimplementedRPCServer() {}
+
+// UnsafeRPCServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RPCServer will
+// result in compilation errors.
+type UnsafeRPCServer interface {
+	mustEmbedUnimplementedRPCServer()
+}
+
+func RegisterRPCServer(s grpc.ServiceRegistrar, srv RPCServer) {
+	s.RegisterService(&RPC_ServiceDesc, srv)
+}
+
+func _RPC_QueryGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).QueryGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.mcenter.group.RPC/QueryGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).QueryGroup(ctx, req.(*QueryGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_DescribeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).DescribeGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.mcenter.group.RPC/DescribeGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).DescribeGroup(ctx, req.(*DescribeGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_QueryUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).QueryUserGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.mcenter.group.RPC/QueryUserGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).QueryUserGroup(ctx, req.(*QueryUserGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RPC_ServiceDesc is the grpc.ServiceDesc for RPC service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RPC_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "infraboard.mcenter.group.RPC",
+	HandlerType: (*RPCServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryGroup",
+			Handler:    _RPC_QueryGroup_Handler,
+		},
+		{
+			MethodName: "DescribeGroup",
+			Handler:    _RPC_DescribeGroup_Handler,
+		},
+		{
+			MethodName: "QueryUserGroup",
+			Handler:    _RPC_QueryUserGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apps/group/pb/rpc.proto",
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/infraboard/mcube/logger/zap"
 	"google.golang.org/grpc"
 
+	"github.com/infraboard/mcenter/apps/domain"
 	"github.com/infraboard/mcenter/apps/instance"
+	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/service"
 	"github.com/infraboard/mcenter/conf"
 )
@@ -23,7 +25,9 @@ type impl struct {
 	log logger.Logger
 	instance.UnimplementedRPCServer
 
-	app service.MetaService
+	app       service.MetaService
+	domain    domain.Service
+	namespace namespace.Service
 }
 
 func (i *impl) Config() error {
@@ -35,6 +39,8 @@ func (i *impl) Config() error {
 	i.log = zap.L().Named(i.Name())
 
 	i.app = app.GetGrpcApp(service.AppName).(service.MetaService)
+	i.domain = app.GetInternalApp(domain.AppName).(domain.Service)
+	i.namespace = app.GetInternalApp(namespace.AppName).(namespace.Service)
 	return nil
 }
 
@@ -3,7 +3,11 @@ package impl
 import (
 	"context"
 
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/infraboard/mcenter/apps/domain"
 	"github.com/infraboard/mcenter/apps/instance"
+	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/service"
 	"github.com/infraboard/mcenter/client/rpc"
 	"github.com/infraboard/mcube/exception"
@@ -23,6 +27,16 @@ func (i *impl) RegistryInstance(ctx context.Context, req *instance.RegistryReque
 		return nil, exception.NewBadRequest("validate create instance error, %s", err)
 	}
 
+	// 已归档和删除中的空间不允许再注册实例
+	if err := i.checkNamespaceWritable(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	// 实例重复注册时只更新, 新实例才检查域的实例配额
+	if err := i.checkQuota(ctx, ins); err != nil {
+		return nil, err
+	}
+
 	if err := i.upsert(ctx, ins); err != nil {
 		return nil, err
 	}
@@ -64,3 +78,96 @@ func (i *impl) Search(ctx context.Context, r *instance.SearchRequest) (
 
 	return set, nil
 }
+
+// 删除域下所有的实例
+func (i *impl) DeleteDomainResource(ctx context.Context, req *domain.DeleteDomainResourceRequest) (
+	*domain.DeleteDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	rs, err := i.col.DeleteMany(ctx, bson.M{"domain": req.Domain})
+	if err != nil {
+		return nil, exception.NewInternalServerError("delete domain %s instance error, %s", req.Domain, err)
+	}
+	return domain.NewDeleteDomainResourceResponse(rs.DeletedCount), nil
+}
+
+// 统计域下的实例数量, 用于配额检查
+func (i *impl) CountDomainResource(ctx context.Context, req *domain.CountDomainResourceRequest) (
+	*domain.CountDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	count, err := i.col.CountDocuments(ctx, bson.M{"domain": req.Domain})
+	if err != nil {
+		return nil, exception.NewInternalServerError("count domain %s instance error, %s", req.Domain, err)
+	}
+	return domain.NewCountDomainResourceResponse(count), nil
+}
+
+// 统计空间下的实例数量, 删除空间前检查依赖
+func (i *impl) CountNamespaceResource(ctx context.Context, req *namespace.CountNamespaceResourceRequest) (
+	*namespace.CountNamespaceResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	count, err := i.col.CountDocuments(ctx, bson.M{"domain": req.Domain, "namespace": req.Namespace})
+	if err != nil {
+		return nil, exception.NewInternalServerError("count namespace %s instance error, %s", req.Namespace, err)
+	}
+	return namespace.NewCountNamespaceResourceResponse(count), nil
+}
+
+// 删除空间下所有的实例
+func (i *impl) DeleteNamespaceResource(ctx context.Context, req *namespace.DeleteNamespaceResourceRequest) (
+	*namespace.DeleteNamespaceResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	rs, err := i.col.DeleteMany(ctx, bson.M{"domain": req.Domain, "namespace": req.Namespace})
+	if err != nil {
+		return nil, exception.NewInternalServerError("delete namespace %s instance error, %s", req.Namespace, err)
+	}
+	return namespace.NewDeleteNamespaceResourceResponse(rs.DeletedCount), nil
+}
+
+func (i *impl) checkQuota(ctx context.Context, ins *instance.Instance) error {
+	if ins.Domain == "" {
+		return nil
+	}
+
+	exist, err := i.col.CountDocuments(ctx, bson.M{"_id": ins.Id})
+	if err != nil {
+		return exception.NewInternalServerError("find instance %s error, %s", ins.Id, err)
+	}
+	if exist > 0 {
+		return nil
+	}
+
+	resp, err := i.CountDomainResource(ctx, domain.NewCountDomainResourceRequest(ins.Domain))
+	if err != nil {
+		return err
+	}
+	_, err = i.domain.CheckQuota(ctx, domain.NewCheckQuotaRequest(ins.Domain, domain.QUOTA_RESOURCE_INSTANCE, resp.Count))
+	return err
+}
+
+// 实例所在的空间必须可写, 服务没有指定空间时不检查
+func (i *impl) checkNamespaceWritable(ctx context.Context, ins *instance.Instance) error {
+	if ins.Namespace == "" {
+		return nil
+	}
+
+	ns, err := i.namespace.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(ins.Domain, ins.Namespace))
+	if err != nil {
+		return err
+	}
+	if err := ns.CheckWritable(); err != nil {
+		return exception.NewBadRequest(err.Error())
+	}
+	return nil
+}
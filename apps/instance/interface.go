@@ -1,12 +1,15 @@
 package instance
 
 import (
+	"context"
 	"fmt"
 	"hash/fnv"
 	"sort"
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/service"
 	"github.com/infraboard/mcenter/apps/token"
 	"github.com/infraboard/mcube/http/request"
@@ -27,6 +30,14 @@ var (
 )
 
 type Service interface {
+	// 删除域下所有的实例, 清除域时调用
+	DeleteDomainResource(context.Context, *domain.DeleteDomainResourceRequest) (*domain.DeleteDomainResourceResponse, error)
+	// 统计域下的实例数量, 用于配额检查
+	CountDomainResource(context.Context, *domain.CountDomainResourceRequest) (*domain.CountDomainResourceResponse, error)
+	// 统计空间下的实例数量, 删除空间前检查依赖
+	CountNamespaceResource(context.Context, *namespace.CountNamespaceResourceRequest) (*namespace.CountNamespaceResourceResponse, error)
+	// 删除空间下所有的实例, 级联删除空间时调用
+	DeleteNamespaceResource(context.Context, *namespace.DeleteNamespaceResourceRequest) (*namespace.DeleteNamespaceResourceResponse, error)
 	RPCServer
 }
 
@@ -0,0 +1,61 @@
+package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/mcenter/apps/ldapsync"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service ldapsync.Service
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(ldapsync.AppName)
+	h.service = app.GetInternalApp(ldapsync.AppName).(ldapsync.Service)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return ldapsync.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"LDAP同步"}
+
+	ws.Route(ws.POST("/").To(h.RunSync).
+		Doc("手动同步LDAP用户和用户组, dry_run=true时只计算变更不执行").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(ldapsync.RunSyncRequest{}).
+		Returns(200, "OK", ldapsync.SyncRun{}))
+
+	ws.Route(ws.GET("/").To(h.QuerySyncRun).
+		Doc("查询同步记录").
+		Param(ws.QueryParameter("domain", "domain of the sync run").DataType("string")).
+		Param(ws.QueryParameter("dry_run", "only dry run or not").DataType("boolean")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", ldapsync.SyncRunSet{}))
+
+	ws.Route(ws.GET("/{id}").To(h.DescribeSyncRun).
+		Doc("查询同步记录详情, 包含变更列表").
+		Param(ws.PathParameter("id", "identifier of the sync run").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", ldapsync.SyncRun{}))
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
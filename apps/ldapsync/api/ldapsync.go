@@ -0,0 +1,44 @@
+package api
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/http/restful/response"
+
+	"github.com/infraboard/mcenter/apps/ldapsync"
+)
+
+func (h *handler) RunSync(r *restful.Request, w *restful.Response) {
+	req := ldapsync.NewRunSyncRequest("")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Trigger = ldapsync.TRIGGER_MANUAL
+
+	ins, err := h.service.RunSync(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) QuerySyncRun(r *restful.Request, w *restful.Response) {
+	req := ldapsync.NewQuerySyncRunRequestFromHTTP(r.Request)
+	set, err := h.service.QuerySyncRun(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *handler) DescribeSyncRun(r *restful.Request, w *restful.Response) {
+	req := ldapsync.NewDescribeSyncRunRequest(r.PathParameter("id"))
+	ins, err := h.service.DescribeSyncRun(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
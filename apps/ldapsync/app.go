@@ -0,0 +1,107 @@
+package ldapsync
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	request "github.com/infraboard/mcube/http/request"
+	"github.com/rs/xid"
+)
+
+const (
+	AppName = "ldapsync"
+)
+
+// use a single instance of Validate, it caches struct info
+var (
+	validate = validator.New()
+)
+
+// NewSyncRun 同步记录
+func NewSyncRun(req *RunSyncRequest) *SyncRun {
+	return &SyncRun{
+		Id:      xid.New().String(),
+		Domain:  req.Domain,
+		DryRun:  req.DryRun,
+		Trigger: req.Trigger,
+		Status:  STATUS_RUNNING,
+		StartAt: time.Now().UnixMilli(),
+		Changes: []*Change{},
+	}
+}
+
+// Success 同步完成
+func (r *SyncRun) Success(changes []*Change) {
+	r.Status = STATUS_SUCCESS
+	r.EndAt = time.Now().UnixMilli()
+	r.Changes = changes
+}
+
+// Failed 同步失败
+func (r *SyncRun) Failed(err error) {
+	r.Status = STATUS_FAILED
+	r.EndAt = time.Now().UnixMilli()
+	r.Message = err.Error()
+}
+
+func NewSyncRunSet() *SyncRunSet {
+	return &SyncRunSet{
+		Items: []*SyncRun{},
+	}
+}
+
+func (s *SyncRunSet) Add(item *SyncRun) {
+	s.Items = append(s.Items, item)
+}
+
+func NewRunSyncRequest(domain string) *RunSyncRequest {
+	return &RunSyncRequest{
+		Domain: domain,
+	}
+}
+
+func (req *RunSyncRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewQuerySyncRunRequest() *QuerySyncRunRequest {
+	return &QuerySyncRunRequest{
+		Page: request.NewDefaultPageRequest(),
+	}
+}
+
+// NewQuerySyncRunRequestFromHTTP todo
+func NewQuerySyncRunRequestFromHTTP(r *http.Request) *QuerySyncRunRequest {
+	req := NewQuerySyncRunRequest()
+
+	qs := r.URL.Query()
+	req.Page = request.NewPageRequestFromHTTP(r)
+	req.Domain = qs.Get("domain")
+	if v := qs.Get("dry_run"); v != "" {
+		dryRun := v == "true"
+		req.DryRun = &dryRun
+	}
+	return req
+}
+
+func NewDescribeSyncRunRequest(id string) *DescribeSyncRunRequest {
+	return &DescribeSyncRunRequest{
+		Id: id,
+	}
+}
+
+func (req *DescribeSyncRunRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// NewChange 变更
+func NewChange(resource RESOURCE, action ACTION, name, dn, detail string) *Change {
+	return &Change{
+		Resource: resource,
+		Action:   action,
+		Name:     name,
+		Dn:       dn,
+		Detail:   detail,
+	}
+}
@@ -0,0 +1,122 @@
+package impl
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/domain/password"
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/ldapsync"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// apply 执行同步变更, 单个变更失败时记录错误, 不影响其他变更
+func (i *impl) apply(ctx context.Context, dom *domain.Domain, plan *ldapsync.Plan, users *user.UserSet) {
+	ids := map[string]string{}
+	ldapIds := map[string]bool{}
+	for _, u := range users.Items {
+		ids[u.Spec.Username] = u.Id
+		if u.Spec.Provider.Equal(user.PROVIDER_LDAP) {
+			ldapIds[u.Id] = true
+		}
+	}
+
+	for _, c := range plan.Users {
+		if err := i.applyUser(ctx, dom, c, ids); err != nil {
+			i.log.Errorf("sync ldap user %s error, %s", c.Name, err)
+			c.Error = err.Error()
+		}
+	}
+	for _, c := range plan.Groups {
+		if err := i.applyGroup(ctx, dom, c, ids, ldapIds); err != nil {
+			i.log.Errorf("sync ldap group %s error, %s", c.Name, err)
+			c.Error = err.Error()
+		}
+	}
+}
+
+func (i *impl) applyUser(ctx context.Context, dom *domain.Domain, c *ldapsync.UserChange, ids map[string]string) error {
+	switch c.Action {
+	case ldapsync.ACTION_CREATE:
+		// 用户通过LDAP认证, 本地密码随机生成
+		pass, err := password.New(passwordSecurity(dom)).GenerateValid()
+		if err != nil {
+			return err
+		}
+		req := user.NewLDAPCreateUserRequest(dom.Spec.Name, c.Profile.Username, *pass, "同步自LDAP")
+		req.ExternalId = c.Profile.DN
+		u, err := i.user.CreateUser(ctx, req)
+		if err != nil {
+			return err
+		}
+		ids[u.Spec.Username] = u.Id
+
+		update := user.NewPatchUserRequest(u.Id)
+		update.Profile = ldapsync.ApplyProfile(nil, c.Profile)
+		_, err = i.user.UpdateUser(ctx, update)
+		return err
+	case ldapsync.ACTION_UPDATE:
+		req := user.NewPatchUserRequest(c.User.Id)
+		req.Profile = ldapsync.ApplyProfile(nil, c.Profile)
+		_, err := i.user.UpdateUser(ctx, req)
+		return err
+	case ldapsync.ACTION_LOCK, ldapsync.ACTION_UNLOCK:
+		req := user.NewUpdateUserStatusRequest(c.User.Id)
+		req.Locked = c.Action.Equal(ldapsync.ACTION_LOCK)
+		req.Reason = c.Detail
+		req.Operator = ldapsync.SYNC_OPERATOR
+		_, err := i.user.UpdateUserStatus(ctx, req)
+		return err
+	}
+	return nil
+}
+
+func (i *impl) applyGroup(ctx context.Context, dom *domain.Domain, c *ldapsync.GroupChange, ids map[string]string, ldapIds map[string]bool) error {
+	members := []string{}
+	for _, name := range c.Members {
+		// 创建失败的用户不加入用户组
+		if id, ok := ids[name]; ok {
+			members = append(members, id)
+		}
+	}
+
+	switch c.Action {
+	case ldapsync.ACTION_CREATE:
+		req := group.NewCreateGroupRequest()
+		req.Domain = dom.Spec.Name
+		req.Name = c.Profile.Name
+		req.Description = "同步自LDAP"
+		req.CreateBy = ldapsync.SYNC_OPERATOR
+		req.ExternalId = c.Profile.DN
+		req.Users = members
+		_, err := i.group.CreateGroup(ctx, req)
+		return err
+	case ldapsync.ACTION_UPDATE:
+		// 保留本地添加的非LDAP用户
+		for _, uid := range c.Group.Spec.Users {
+			if !ldapIds[uid] {
+				members = append(members, uid)
+			}
+		}
+		req := group.NewPutGroupRequest(c.Group.Id)
+		req.UpdateBy = ldapsync.SYNC_OPERATOR
+		req.Spec = proto.Clone(c.Group.Spec).(*group.CreateGroupRequest)
+		req.Spec.Users = members
+		if c.Profile != nil {
+			req.Spec.ExternalId = c.Profile.DN
+		}
+		_, err := i.group.UpdateGroup(ctx, req)
+		return err
+	}
+	return nil
+}
+
+// passwordSecurity 域的密码策略, 未设置时使用默认策略
+func passwordSecurity(dom *domain.Domain) *domain.PasswordSecurity {
+	if dom.Spec.SecuritySetting == nil || dom.Spec.SecuritySetting.PasswordSecurity == nil {
+		return domain.NewDefaulPasswordSecurity()
+	}
+	return proto.Clone(dom.Spec.SecuritySetting.PasswordSecurity).(*domain.PasswordSecurity)
+}
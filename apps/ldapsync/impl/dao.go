@@ -0,0 +1,65 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/infraboard/mcenter/apps/ldapsync"
+)
+
+func (i *impl) save(ctx context.Context, ins *ldapsync.SyncRun) error {
+	if _, err := i.col.InsertOne(ctx, ins); err != nil {
+		return exception.NewInternalServerError("inserted sync run(%s) document error, %s",
+			ins.Id, err)
+	}
+	return nil
+}
+
+func (i *impl) update(ctx context.Context, ins *ldapsync.SyncRun) error {
+	if _, err := i.col.UpdateByID(ctx, ins.Id, bson.M{"$set": ins}); err != nil {
+		return exception.NewInternalServerError("update sync run(%s) document error, %s",
+			ins.Id, err)
+	}
+	return nil
+}
+
+func newQueryRequest(r *ldapsync.QuerySyncRunRequest) *queryRequest {
+	return &queryRequest{
+		r,
+	}
+}
+
+type queryRequest struct {
+	*ldapsync.QuerySyncRunRequest
+}
+
+func (r *queryRequest) FindOptions() *options.FindOptions {
+	pageSize := int64(r.Page.PageSize)
+	skip := r.Page.ComputeOffset()
+
+	opt := &options.FindOptions{
+		Sort: bson.D{
+			{Key: "start_at", Value: -1},
+		},
+		Limit: &pageSize,
+		Skip:  &skip,
+	}
+
+	return opt
+}
+
+func (r *queryRequest) FindFilter() bson.M {
+	filter := bson.M{}
+
+	if r.Domain != "" {
+		filter["domain"] = r.Domain
+	}
+	if r.DryRun != nil {
+		filter["dry_run"] = *r.DryRun
+	}
+
+	return filter
+}
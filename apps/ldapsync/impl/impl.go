@@ -0,0 +1,81 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+	"google.golang.org/grpc"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/ldapsync"
+	"github.com/infraboard/mcenter/apps/lease"
+	"github.com/infraboard/mcenter/apps/user"
+	"github.com/infraboard/mcenter/conf"
+)
+
+var (
+	// Service 服务实例
+	svr = &impl{}
+)
+
+type impl struct {
+	col *mongo.Collection
+	log logger.Logger
+	ldapsync.UnimplementedRPCServer
+
+	domain domain.Service
+	user   user.Service
+	group  group.Service
+	// 同一个域同时只允许一个同步任务, 多副本部署时同样有效
+	lease lease.Service
+}
+
+func (i *impl) Config() error {
+	db, err := conf.C().Mongo.GetDB()
+	if err != nil {
+		return err
+	}
+
+	dc := db.Collection("ldap_sync_run")
+	indexs := []mongo.IndexModel{
+		{
+			Keys: bsonx.Doc{
+				{Key: "domain", Value: bsonx.Int32(-1)},
+				{Key: "start_at", Value: bsonx.Int32(-1)},
+			},
+		},
+	}
+	_, err = dc.Indexes().CreateMany(context.Background(), indexs)
+	if err != nil {
+		return err
+	}
+
+	i.col = dc
+	i.log = zap.L().Named(i.Name())
+	i.domain = app.GetInternalApp(domain.AppName).(domain.Service)
+	i.user = app.GetInternalApp(user.AppName).(user.Service)
+	i.group = app.GetInternalApp(group.AppName).(group.Service)
+	i.lease = app.GetInternalApp(lease.AppName).(lease.Service)
+
+	// 后台按照域的配置定时同步
+	go i.runScheduler()
+	return nil
+}
+
+func (i *impl) Name() string {
+	return ldapsync.AppName
+}
+
+func (i *impl) Registry(server *grpc.Server) {
+	ldapsync.RegisterRPCServer(server, svr)
+}
+
+func init() {
+	app.RegistryInternalApp(svr)
+	app.RegistryGrpcApp(svr)
+}
@@ -0,0 +1,179 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/ldapsync"
+	"github.com/infraboard/mcenter/apps/lease"
+	"github.com/infraboard/mcenter/apps/token/provider/ldap"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+const (
+	// 查询本地用户和用户组时, 每次从数据库加载的数据量
+	SCAN_PAGE_SIZE = 500
+)
+
+// 同步域关联的LDAP中的用户和用户组
+func (i *impl) RunSync(ctx context.Context, req *ldapsync.RunSyncRequest) (*ldapsync.SyncRun, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	dom, err := i.domain.DescribeDomain(ctx, domain.NewDescribeDomainRequestByName(req.Domain))
+	if err != nil {
+		return nil, err
+	}
+	if dom.Spec.LdapSetting == nil {
+		return nil, exception.NewBadRequest("domain %s ldap not setting", req.Domain)
+	}
+
+	name := syncLeaseName(req.Domain)
+	if _, err := i.lease.Acquire(ctx, lease.NewAcquireRequest(name, SYNC_LEASE_TTL)); err != nil {
+		if exception.IsConflictError(err) {
+			return nil, exception.NewConflict("domain %s ldap sync is running", req.Domain)
+		}
+		return nil, err
+	}
+	defer func() {
+		if err := i.lease.Release(context.Background(), lease.NewReleaseRequest(name)); err != nil {
+			i.log.Errorf("release domain %s ldap sync lease error, %s", req.Domain, err)
+		}
+	}()
+
+	run := ldapsync.NewSyncRun(req)
+	if err := i.save(ctx, run); err != nil {
+		return nil, err
+	}
+
+	var changes []*ldapsync.Change
+	lease.Hold(ctx, i.lease, name, SYNC_LEASE_TTL, func(ctx context.Context) {
+		changes, err = i.sync(ctx, dom, run)
+	})
+	if err != nil {
+		i.log.Errorf("domain %s ldap sync failed, %s", req.Domain, err)
+		run.Failed(err)
+	} else {
+		run.Success(changes)
+	}
+	if err := i.update(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// sync 对比LDAP和本地数据, 非预演时执行变更
+func (i *impl) sync(ctx context.Context, dom *domain.Domain, run *ldapsync.SyncRun) ([]*ldapsync.Change, error) {
+	p := ldap.NewProvider(dom.Spec.LdapSetting)
+	ldapUsers, err := p.ListUsers()
+	if err != nil {
+		return nil, err
+	}
+	ldapGroups, err := p.ListGroups()
+	if err != nil {
+		return nil, err
+	}
+	run.LdapUsers = int64(len(ldapUsers))
+	run.LdapGroups = int64(len(ldapGroups))
+
+	users, err := i.queryUsers(ctx, dom.Spec.Name)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := i.queryGroups(ctx, dom.Spec.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := ldapsync.NewPlan(ldapUsers, ldapGroups, users, groups)
+	if !run.DryRun {
+		i.apply(ctx, dom, plan, users)
+	}
+	return plan.Changes(), nil
+}
+
+// queryUsers 域内所有的用户
+func (i *impl) queryUsers(ctx context.Context, domain string) (*user.UserSet, error) {
+	users := user.NewUserSet()
+	req := user.NewQueryUserRequest()
+	req.Domain = domain
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := i.user.QueryUser(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		users.Items = append(users.Items, set.Items...)
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return users, nil
+		}
+		req.Page.PageNumber++
+	}
+}
+
+// queryGroups 域内所有的用户组
+func (i *impl) queryGroups(ctx context.Context, domain string) (*group.GroupSet, error) {
+	groups := group.NewGroupSet()
+	req := group.NewQueryGroupRequest()
+	req.Domain = domain
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := i.group.QueryGroup(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		groups.Items = append(groups.Items, set.Items...)
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return groups, nil
+		}
+		req.Page.PageNumber++
+	}
+}
+
+// 查询同步记录, 列表中不返回变更详情
+func (i *impl) QuerySyncRun(ctx context.Context, req *ldapsync.QuerySyncRunRequest) (*ldapsync.SyncRunSet, error) {
+	r := newQueryRequest(req)
+	resp, err := i.col.Find(ctx, r.FindFilter(), r.FindOptions())
+	if err != nil {
+		return nil, exception.NewInternalServerError("find sync run error, error is %s", err)
+	}
+
+	set := ldapsync.NewSyncRunSet()
+	for resp.Next(ctx) {
+		ins := &ldapsync.SyncRun{}
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode sync run error, error is %s", err)
+		}
+		ins.Changes = nil
+		set.Add(ins)
+	}
+
+	count, err := i.col.CountDocuments(ctx, r.FindFilter())
+	if err != nil {
+		return nil, exception.NewInternalServerError("get sync run count error, error is %s", err)
+	}
+	set.Total = count
+	return set, nil
+}
+
+// 查询同步记录详情
+func (i *impl) DescribeSyncRun(ctx context.Context, req *ldapsync.DescribeSyncRunRequest) (*ldapsync.SyncRun, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins := &ldapsync.SyncRun{}
+	if err := i.col.FindOne(ctx, bson.M{"_id": req.Id}).Decode(ins); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, exception.NewNotFound("sync run %s not found", req.Id)
+		}
+		return nil, exception.NewInternalServerError("find sync run %s error, %s", req.Id, err)
+	}
+	return ins, nil
+}
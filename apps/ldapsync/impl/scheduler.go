@@ -0,0 +1,88 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/ldapsync"
+	"github.com/infraboard/mcenter/apps/lease"
+)
+
+const (
+	// 检查是否需要定时同步的间隔
+	SCHEDULE_CHECK_INTERVAL = time.Minute
+	// 定时同步的租约, 多副本部署时只有一个副本执行定时同步
+	SCHEDULE_LEASE_NAME = "ldapsync.scheduler"
+	// 同步任务的租约有效期, 同步期间定期续约
+	SYNC_LEASE_TTL = time.Minute
+)
+
+// runScheduler 按照域的LDAP配置定时同步
+func (i *impl) runScheduler() {
+	lease.Schedule(i.lease, SCHEDULE_LEASE_NAME, SCHEDULE_CHECK_INTERVAL, func(ctx context.Context) {
+		if err := i.schedule(ctx); err != nil {
+			i.log.Errorf("schedule ldap sync error, %s", err)
+		}
+	})
+}
+
+// 域同步任务的租约名称
+func syncLeaseName(domain string) string {
+	return "ldapsync.domain." + domain
+}
+
+func (i *impl) schedule(ctx context.Context) error {
+	req := domain.NewQueryDomainRequest()
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := i.domain.QueryDoamin(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, d := range set.Items {
+			if i.isDue(ctx, d) {
+				i.scheduleDomain(ctx, d.Spec.Name)
+			}
+		}
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return nil
+		}
+		req.Page.PageNumber++
+	}
+}
+
+func (i *impl) scheduleDomain(ctx context.Context, domain string) {
+	req := ldapsync.NewRunSyncRequest(domain)
+	req.Trigger = ldapsync.TRIGGER_SCHEDULE
+	run, err := i.RunSync(ctx, req)
+	if err != nil {
+		i.log.Errorf("domain %s scheduled ldap sync error, %s", domain, err)
+		return
+	}
+	i.log.Infof("domain %s scheduled ldap sync %s, %d changes", domain, run.Status, len(run.Changes))
+}
+
+// isDue 距离上次同步(不包含预演)超过同步间隔
+func (i *impl) isDue(ctx context.Context, d *domain.Domain) bool {
+	conf := d.Spec.LdapSetting
+	if conf == nil || !conf.SyncEnabled || conf.SyncIntervalMinutes == 0 {
+		return false
+	}
+
+	last := &ldapsync.SyncRun{}
+	opts := options.FindOne().SetSort(bson.D{{Key: "start_at", Value: -1}})
+	err := i.col.FindOne(ctx, bson.M{"domain": d.Spec.Name, "dry_run": false}, opts).Decode(last)
+	if err == mongo.ErrNoDocuments {
+		return true
+	}
+	if err != nil {
+		i.log.Errorf("query domain %s last ldap sync error, %s", d.Spec.Name, err)
+		return false
+	}
+	return time.Since(time.UnixMilli(last.StartAt)) >= conf.SyncInterval()
+}
@@ -0,0 +1,10 @@
+package ldapsync
+
+import context "context"
+
+type Service interface {
+	// 同步域关联的LDAP中的用户和用户组, 预演时只计算变更
+	RunSync(context.Context, *RunSyncRequest) (*SyncRun, error)
+	// RPC
+	RPCServer
+}
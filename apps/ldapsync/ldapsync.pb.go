@@ -0,0 +1,712 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: apps/ldapsync/pb/ldapsync.proto
+
+package ldapsync
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 同步的触发方式
+type TRIGGER int32
+
+const (
+	// 手动触发
+	TRIGGER_MANUAL TRIGGER = 0
+	// 定时触发
+	TRIGGER_SCHEDULE TRIGGER = 1
+)
+
+// Enum value maps for TRIGGER.
+var (
+	TRIGGER_name = map[int32]string{
+		0: "MANUAL",
+		1: "SCHEDULE",
+	}
+	TRIGGER_value = map[string]int32{
+		"MANUAL":   0,
+		"SCHEDULE": 1,
+	}
+)
+
+func (x TRIGGER) Enum() *TRIGGER {
+	p := new(TRIGGER)
+	*p = x
+	return p
+}
+
+func (x TRIGGER) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TRIGGER) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_ldapsync_pb_ldapsync_proto_enumTypes[0].Descriptor()
+}
+
+func (TRIGGER) Type() protoreflect.EnumType {
+	return &file_apps_ldapsync_pb_ldapsync_proto_enumTypes[0]
+}
+
+func (x TRIGGER) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TRIGGER.Descriptor instead.
+func (TRIGGER) EnumDescriptor() ([]byte, []int) {
+	return file_apps_ldapsync_pb_ldapsync_proto_rawDescGZIP(), []int{0}
+}
+
+// 同步状态
+type STATUS int32
+
+const (
+	// 同步中
+	STATUS_RUNNING STATUS = 0
+	// 同步完成, 部分变更可能执行失败, 具体查看变更的错误信息
+	STATUS_SUCCESS STATUS = 1
+	// 同步失败
+	STATUS_FAILED STATUS = 2
+)
+
+// Enum value maps for STATUS.
+var (
+	STATUS_name = map[int32]string{
+		0: "RUNNING",
+		1: "SUCCESS",
+		2: "FAILED",
+	}
+	STATUS_value = map[string]int32{
+		"RUNNING": 0,
+		"SUCCESS": 1,
+		"FAILED":  2,
+	}
+)
+
+func (x STATUS) Enum() *STATUS {
+	p := new(STATUS)
+	*p = x
+	return p
+}
+
+func (x STATUS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (STATUS) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_ldapsync_pb_ldapsync_proto_enumTypes[1].Descriptor()
+}
+
+func (STATUS) Type() protoreflect.EnumType {
+	return &file_apps_ldapsync_pb_ldapsync_proto_enumTypes[1]
+}
+
+func (x STATUS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use STATUS.Descriptor instead.
+func (STATUS) EnumDescriptor() ([]byte, []int) {
+	return file_apps_ldapsync_pb_ldapsync_proto_rawDescGZIP(), []int{1}
+}
+
+// 变更的资源类型
+type RESOURCE int32
+
+const (
+	// 用户
+	RESOURCE_USER RESOURCE = 0
+	// 用户组
+	RESOURCE_GROUP RESOURCE = 1
+)
+
+// Enum value maps for RESOURCE.
+var (
+	RESOURCE_name = map[int32]string{
+		0: "USER",
+		1: "GROUP",
+	}
+	RESOURCE_value = map[string]int32{
+		"USER":  0,
+		"GROUP": 1,
+	}
+)
+
+func (x RESOURCE) Enum() *RESOURCE {
+	p := new(RESOURCE)
+	*p = x
+	return p
+}
+
+func (x RESOURCE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RESOURCE) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_ldapsync_pb_ldapsync_proto_enumTypes[2].Descriptor()
+}
+
+func (RESOURCE) Type() protoreflect.EnumType {
+	return &file_apps_ldapsync_pb_ldapsync_proto_enumTypes[2]
+}
+
+func (x RESOURCE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RESOURCE.Descriptor instead.
+func (RESOURCE) EnumDescriptor() ([]byte, []int) {
+	return file_apps_ldapsync_pb_ldapsync_proto_rawDescGZIP(), []int{2}
+}
+
+// 变更动作
+type ACTION int32
+
+const (
+	// 创建
+	ACTION_CREATE ACTION = 0
+	// 更新信息或者组成员
+	ACTION_UPDATE ACTION = 1
+	// 冻结用户
+	ACTION_LOCK ACTION = 2
+	// 解冻用户
+	ACTION_UNLOCK ACTION = 3
+	// 与本地数据冲突, 跳过
+	ACTION_SKIP ACTION = 4
+)
+
+// Enum value maps for ACTION.
+var (
+	ACTION_name = map[int32]string{
+		0: "CREATE",
+		1: "UPDATE",
+		2: "LOCK",
+		3: "UNLOCK",
+		4: "SKIP",
+	}
+	ACTION_value = map[string]int32{
+		"CREATE": 0,
+		"UPDATE": 1,
+		"LOCK":   2,
+		"UNLOCK": 3,
+		"SKIP":   4,
+	}
+)
+
+func (x ACTION) Enum() *ACTION {
+	p := new(ACTION)
+	*p = x
+	return p
+}
+
+func (x ACTION) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ACTION) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_ldapsync_pb_ldapsync_proto_enumTypes[3].Descriptor()
+}
+
+func (ACTION) Type() protoreflect.EnumType {
+	return &file_apps_ldapsync_pb_ldapsync_proto_enumTypes[3]
+}
+
+func (x ACTION) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ACTION.Descriptor instead.
+func (ACTION) EnumDescriptor() ([]byte, []int) {
+	return file_apps_ldapsync_pb_ldapsync_proto_rawDescGZIP(), []int{3}
+}
+
+// Change 同步产生的变更
+type Change struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源类型
+	// @gotags: bson:"resource" json:"resource"
+	Resource RESOURCE `protobuf:"varint,1,opt,name=resource,proto3,enum=infraboard.mcenter.ldapsync.RESOURCE" json:"resource" bson:"resource"`
+	// 变更动作
+	// @gotags: bson:"action" json:"action"
+	Action ACTION `protobuf:"varint,2,opt,name=action,proto3,enum=infraboard.mcenter.ldapsync.ACTION" json:"action" bson:"action"`
+	// 用户名或者用户组名称
+	// @gotags: bson:"name" json:"name"
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name" bson:"name"`
+	// LDAP中的DN
+	// @gotags: bson:"dn" json:"dn"
+	Dn string `protobuf:"bytes,4,opt,name=dn,proto3" json:"dn" bson:"dn"`
+	// 变更详情
+	// @gotags: bson:"detail" json:"detail"
+	Detail string `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail" bson:"detail"`
+	// 执行失败的原因
+	// @gotags: bson:"error" json:"error"
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error" bson:"error"`
+}
+
+func (x *Change) Reset() {
+	*x = Change{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_ldapsync_pb_ldapsync_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Change) ProtoMessage() {}
+
+func (x *Change) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_ldapsync_pb_ldapsync_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Change.ProtoReflect.Descriptor instead.
+func (*Change) Descriptor() ([]byte, []int) {
+	return file_apps_ldapsync_pb_ldapsync_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Change) GetResource() RESOURCE {
+	if x != nil {
+		return x.Resource
+	}
+	return RESOURCE_USER
+}
+
+func (x *Change) GetAction() ACTION {
+	if x != nil {
+		return x.Action
+	}
+	return ACTION_CREATE
+}
+
+func (x *Change) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Change) GetDn() string {
+	if x != nil {
+		return x.Dn
+	}
+	return ""
+}
+
+func (x *Change) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Change) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SyncRun 一次同步的执行记录
+type SyncRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 记录Id
+	// @gotags: bson:"_id" json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id"`
+	// 同步的域
+	// @gotags: bson:"domain" json:"domain"
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain" bson:"domain"`
+	// 是否只计算变更, 不执行
+	// @gotags: bson:"dry_run" json:"dry_run"
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run" bson:"dry_run"`
+	// 触发方式
+	// @gotags: bson:"trigger" json:"trigger"
+	Trigger TRIGGER `protobuf:"varint,4,opt,name=trigger,proto3,enum=infraboard.mcenter.ldapsync.TRIGGER" json:"trigger" bson:"trigger"`
+	// 同步状态
+	// @gotags: bson:"status" json:"status"
+	Status STATUS `protobuf:"varint,5,opt,name=status,proto3,enum=infraboard.mcenter.ldapsync.STATUS" json:"status" bson:"status"`
+	// 开始时间
+	// @gotags: bson:"start_at" json:"start_at"
+	StartAt int64 `protobuf:"varint,6,opt,name=start_at,json=startAt,proto3" json:"start_at" bson:"start_at"`
+	// 结束时间
+	// @gotags: bson:"end_at" json:"end_at"
+	EndAt int64 `protobuf:"varint,7,opt,name=end_at,json=endAt,proto3" json:"end_at" bson:"end_at"`
+	// 同步失败的原因
+	// @gotags: bson:"message" json:"message"
+	Message string `protobuf:"bytes,8,opt,name=message,proto3" json:"message" bson:"message"`
+	// LDAP中的用户数量
+	// @gotags: bson:"ldap_users" json:"ldap_users"
+	LdapUsers int64 `protobuf:"varint,9,opt,name=ldap_users,json=ldapUsers,proto3" json:"ldap_users" bson:"ldap_users"`
+	// LDAP中的用户组数量
+	// @gotags: bson:"ldap_groups" json:"ldap_groups"
+	LdapGroups int64 `protobuf:"varint,10,opt,name=ldap_groups,json=ldapGroups,proto3" json:"ldap_groups" bson:"ldap_groups"`
+	// 变更列表
+	// @gotags: bson:"changes" json:"changes"
+	Changes []*Change `protobuf:"bytes,11,rep,name=changes,proto3" json:"changes" bson:"changes"`
+}
+
+func (x *SyncRun) Reset() {
+	*x = SyncRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_ldapsync_pb_ldapsync_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRun) ProtoMessage() {}
+
+func (x *SyncRun) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_ldapsync_pb_ldapsync_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRun.ProtoReflect.Descriptor instead.
+func (*SyncRun) Descriptor() ([]byte, []int) {
+	return file_apps_ldapsync_pb_ldapsync_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SyncRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SyncRun) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *SyncRun) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SyncRun) GetTrigger() TRIGGER {
+	if x != nil {
+		return x.Trigger
+	}
+	return TRIGGER_MANUAL
+}
+
+func (x *SyncRun) GetStatus() STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return STATUS_RUNNING
+}
+
+func (x *SyncRun) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *SyncRun) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *SyncRun) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SyncRun) GetLdapUsers() int64 {
+	if x != nil {
+		return x.LdapUsers
+	}
+	return 0
+}
+
+func (x *SyncRun) GetLdapGroups() int64 {
+	if x != nil {
+		return x.LdapGroups
+	}
+	return 0
+}
+
+func (x *SyncRun) GetChanges() []*Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type SyncRunSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 总数量
+	// @gotags: bson:"total" json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total" bson:"total"`
+	// 数据项
+	// @gotags: bson:"items" json:"items"
+	Items []*SyncRun `protobuf:"bytes,2,rep,name=items,proto3" json:"items" bson:"items"`
+}
+
+func (x *SyncRunSet) Reset() {
+	*x = SyncRunSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_ldapsync_pb_ldapsync_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncRunSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRunSet) ProtoMessage() {}
+
+func (x *SyncRunSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_ldapsync_pb_ldapsync_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRunSet.ProtoReflect.Descriptor instead.
+func (*SyncRunSet) Descriptor() ([]byte, []int) {
+	return file_apps_ldapsync_pb_ldapsync_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SyncRunSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SyncRunSet) GetItems() []*SyncRun {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_apps_ldapsync_pb_ldapsync_proto protoreflect.FileDescriptor
+
+var file_apps_ldapsync_pb_ldapsync_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2f,
+	0x70, 0x62, 0x2f, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x1b, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x22, 0xda,
+	0x01, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x69,
+	0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x2e, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x64, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x92, 0x03, 0x0a, 0x07,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x3e, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x72,
+	0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6c,
+	0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x52,
+	0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6c, 0x64,
+	0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x64, 0x61, 0x70, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x64, 0x61, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x64, 0x61, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x64, 0x61, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x12, 0x3d, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x22, 0x5e, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e,
+	0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x2a, 0x23, 0x0a, 0x07, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
+	0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x10, 0x01, 0x2a, 0x2e, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x1f, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x10, 0x01, 0x2a, 0x40, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x08,
+	0x0a, 0x04, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x04, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x6c,
+	0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_ldapsync_pb_ldapsync_proto_rawDescOnce sync.Once
+	file_apps_ldapsync_pb_ldapsync_proto_rawDescData = file_apps_ldapsync_pb_ldapsync_proto_rawDesc
+)
+
+func file_apps_ldapsync_pb_ldapsync_proto_rawDescGZIP() []byte {
+	file_apps_ldapsync_pb_ldapsync_proto_rawDescOnce.Do(func() {
+		file_apps_ldapsync_pb_ldapsync_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_ldapsync_pb_ldapsync_proto_rawDescData)
+	})
+	return file_apps_ldapsync_pb_ldapsync_proto_rawDescData
+}
+
+var file_apps_ldapsync_pb_ldapsync_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_apps_ldapsync_pb_ldapsync_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_apps_ldapsync_pb_ldapsync_proto_goTypes = []interface{}{
+	(TRIGGER)(0),       // 0: infraboard.mcenter.ldapsync.TRIGGER
+	(STATUS)(0),        // 1: infraboard.mcenter.ldapsync.STATUS
+	(RESOURCE)(0),      // 2: infraboard.mcenter.ldapsync.RESOURCE
+	(ACTION)(0),        // 3: infraboard.mcenter.ldapsync.ACTION
+	(*Change)(nil),     // 4: infraboard.mcenter.ldapsync.Change
+	(*SyncRun)(nil),    // 5: infraboard.mcenter.ldapsync.SyncRun
+	(*SyncRunSet)(nil), // 6: infraboard.mcenter.ldapsync.SyncRunSet
+}
+var file_apps_ldapsync_pb_ldapsync_proto_depIdxs = []int32{
+	2, // 0: infraboard.mcenter.ldapsync.Change.resource:type_name -> infraboard.mcenter.ldapsync.RESOURCE
+	3, // 1: infraboard.mcenter.ldapsync.Change.action:type_name -> infraboard.mcenter.ldapsync.ACTION
+	0, // 2: infraboard.mcenter.ldapsync.SyncRun.trigger:type_name -> infraboard.mcenter.ldapsync.TRIGGER
+	1, // 3: infraboard.mcenter.ldapsync.SyncRun.status:type_name -> infraboard.mcenter.ldapsync.STATUS
+	4, // 4: infraboard.mcenter.ldapsync.SyncRun.changes:type_name -> infraboard.mcenter.ldapsync.Change
+	5, // 5: infraboard.mcenter.ldapsync.SyncRunSet.items:type_name -> infraboard.mcenter.ldapsync.SyncRun
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_apps_ldapsync_pb_ldapsync_proto_init() }
+func file_apps_ldapsync_pb_ldapsync_proto_init() {
+	if File_apps_ldapsync_pb_ldapsync_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_ldapsync_pb_ldapsync_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Change); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_ldapsync_pb_ldapsync_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_ldapsync_pb_ldapsync_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncRunSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_ldapsync_pb_ldapsync_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apps_ldapsync_pb_ldapsync_proto_goTypes,
+		DependencyIndexes: file_apps_ldapsync_pb_ldapsync_proto_depIdxs,
+		EnumInfos:         file_apps_ldapsync_pb_ldapsync_proto_enumTypes,
+		MessageInfos:      file_apps_ldapsync_pb_ldapsync_proto_msgTypes,
+	}.Build()
+	File_apps_ldapsync_pb_ldapsync_proto = out.File
+	file_apps_ldapsync_pb_ldapsync_proto_rawDesc = nil
+	file_apps_ldapsync_pb_ldapsync_proto_goTypes = nil
+	file_apps_ldapsync_pb_ldapsync_proto_depIdxs = nil
+}
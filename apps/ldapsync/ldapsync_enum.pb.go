@@ -0,0 +1,190 @@
+// Code generated by github.com/infraboard/mcube
+// DO NOT EDIT
+
+package ldapsync
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseTRIGGERFromString Parse TRIGGER from string
+func ParseTRIGGERFromString(str string) (TRIGGER, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := TRIGGER_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown TRIGGER: %s", str)
+	}
+
+	return TRIGGER(v), nil
+}
+
+// Equal type compare
+func (t TRIGGER) Equal(target TRIGGER) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t TRIGGER) IsIn(targets ...TRIGGER) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t TRIGGER) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *TRIGGER) UnmarshalJSON(b []byte) error {
+	ins, err := ParseTRIGGERFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseSTATUSFromString Parse STATUS from string
+func ParseSTATUSFromString(str string) (STATUS, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := STATUS_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown STATUS: %s", str)
+	}
+
+	return STATUS(v), nil
+}
+
+// Equal type compare
+func (t STATUS) Equal(target STATUS) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t STATUS) IsIn(targets ...STATUS) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t STATUS) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *STATUS) UnmarshalJSON(b []byte) error {
+	ins, err := ParseSTATUSFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseRESOURCEFromString Parse RESOURCE from string
+func ParseRESOURCEFromString(str string) (RESOURCE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := RESOURCE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown RESOURCE: %s", str)
+	}
+
+	return RESOURCE(v), nil
+}
+
+// Equal type compare
+func (t RESOURCE) Equal(target RESOURCE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t RESOURCE) IsIn(targets ...RESOURCE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t RESOURCE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *RESOURCE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseRESOURCEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseACTIONFromString Parse ACTION from string
+func ParseACTIONFromString(str string) (ACTION, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := ACTION_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown ACTION: %s", str)
+	}
+
+	return ACTION(v), nil
+}
+
+// Equal type compare
+func (t ACTION) Equal(target ACTION) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t ACTION) IsIn(targets ...ACTION) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t ACTION) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *ACTION) UnmarshalJSON(b []byte) error {
+	ins, err := ParseACTIONFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
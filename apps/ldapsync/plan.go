@@ -0,0 +1,293 @@
+package ldapsync
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/token/provider/ldap"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+const (
+	// 同步创建的用户组的创建人
+	SYNC_OPERATOR = "ldap-sync"
+	// 同步冻结用户的原因, 同步只解冻自己冻结的用户
+	LOCK_REASON_REMOVED  = "removed from ldap"
+	LOCK_REASON_DISABLED = "disabled in ldap"
+)
+
+// Plan 一次同步需要执行的变更, 用户的变更先于用户组执行
+type Plan struct {
+	Users  []*UserChange
+	Groups []*GroupChange
+}
+
+// UserChange 用户变更
+type UserChange struct {
+	*Change
+	// LDAP中的用户, 用户被删除时为空
+	Profile *ldap.UserProfile
+	// 本地用户, 新建时为空
+	User *user.User
+}
+
+// GroupChange 用户组变更
+type GroupChange struct {
+	*Change
+	// LDAP中的用户组, 用户组被删除时为空
+	Profile *ldap.GroupProfile
+	// 本地用户组, 新建时为空
+	Group *group.Group
+	// 同步后来自LDAP的组成员, 用户名列表, 本地用户不受影响
+	Members []string
+}
+
+// NewPlan 对比LDAP和本地的用户及用户组, 计算需要执行的变更
+func NewPlan(ldapUsers []*ldap.UserProfile, ldapGroups []*ldap.GroupProfile, users *user.UserSet, groups *group.GroupSet) *Plan {
+	p := &Plan{
+		Users:  []*UserChange{},
+		Groups: []*GroupChange{},
+	}
+	managed := p.planUsers(ldapUsers, users)
+	p.planGroups(ldapUsers, ldapGroups, users, groups, managed)
+	return p
+}
+
+// planUsers 计算用户变更, 返回同步后由LDAP管理的用户名
+func (p *Plan) planUsers(ldapUsers []*ldap.UserProfile, users *user.UserSet) map[string]bool {
+	locals := map[string]*user.User{}
+	for _, u := range users.Items {
+		locals[u.Spec.Username] = u
+	}
+
+	managed := map[string]bool{}
+	for _, lu := range ldapUsers {
+		u, ok := locals[lu.Username]
+		switch {
+		case !ok:
+			// 已经禁用的用户不再导入
+			if lu.Disabled {
+				continue
+			}
+			p.addUser(ACTION_CREATE, lu, nil, profileDetail(nil, lu))
+		case !u.Spec.Provider.Equal(user.PROVIDER_LDAP):
+			p.addUser(ACTION_SKIP, lu, u, "local user with the same username already exists")
+			continue
+		default:
+			if detail := profileDetail(u.Profile, lu); detail != "" {
+				p.addUser(ACTION_UPDATE, lu, u, detail)
+			}
+			if lu.Disabled && !u.IsLocked() {
+				p.addUser(ACTION_LOCK, lu, u, LOCK_REASON_DISABLED)
+			}
+			if !lu.Disabled && u.IsLocked() && isLockedBySync(u) {
+				p.addUser(ACTION_UNLOCK, lu, u, "")
+			}
+		}
+		managed[lu.Username] = true
+	}
+
+	// LDAP中已经删除的用户
+	for _, u := range users.Items {
+		if !u.Spec.Provider.Equal(user.PROVIDER_LDAP) || u.IsLocked() {
+			continue
+		}
+		if managed[u.Spec.Username] {
+			continue
+		}
+		c := &UserChange{
+			Change: NewChange(RESOURCE_USER, ACTION_LOCK, u.Spec.Username, u.Spec.ExternalId, LOCK_REASON_REMOVED),
+			User:   u,
+		}
+		p.Users = append(p.Users, c)
+	}
+	return managed
+}
+
+func (p *Plan) addUser(action ACTION, lu *ldap.UserProfile, u *user.User, detail string) {
+	p.Users = append(p.Users, &UserChange{
+		Change:  NewChange(RESOURCE_USER, action, lu.Username, lu.DN, detail),
+		Profile: lu,
+		User:    u,
+	})
+}
+
+// planGroups 计算用户组变更, 只维护由LDAP管理的组成员
+func (p *Plan) planGroups(ldapUsers []*ldap.UserProfile, ldapGroups []*ldap.GroupProfile, users *user.UserSet, groups *group.GroupSet, managed map[string]bool) {
+	dnToName := map[string]string{}
+	for _, lu := range ldapUsers {
+		dnToName[strings.ToLower(lu.DN)] = lu.Username
+	}
+	idToUser := map[string]*user.User{}
+	for _, u := range users.Items {
+		idToUser[u.Id] = u
+	}
+	byExternalId, byName := map[string]*group.Group{}, map[string]*group.Group{}
+	for _, g := range groups.Items {
+		if g.Spec.ExternalId != "" {
+			byExternalId[strings.ToLower(g.Spec.ExternalId)] = g
+		}
+		byName[g.Spec.Name] = g
+	}
+
+	synced := map[string]bool{}
+	for _, lg := range ldapGroups {
+		members := []string{}
+		for _, m := range lg.Members {
+			// 成员的值可能是DN, 也可能是用户名(比如memberUid)
+			name, ok := dnToName[strings.ToLower(m)]
+			if !ok {
+				name = m
+			}
+			if managed[name] {
+				members = append(members, name)
+			}
+		}
+		members = uniqueSorted(members)
+
+		g, ok := byExternalId[strings.ToLower(lg.DN)]
+		if !ok {
+			g, ok = byName[lg.Name]
+			if ok && g.Spec.ExternalId != "" {
+				p.addGroup(ACTION_SKIP, lg, g, nil, "local group with the same name already exists")
+				continue
+			}
+		}
+		if !ok {
+			p.addGroup(ACTION_CREATE, lg, nil, members, fmt.Sprintf("members: %s", strings.Join(members, ",")))
+			continue
+		}
+
+		synced[g.Id] = true
+		diff := []string{}
+		if g.Spec.ExternalId == "" {
+			diff = append(diff, "bind to "+lg.DN)
+		}
+		if detail := membersDetail(ldapMembers(g, idToUser), members); detail != "" {
+			diff = append(diff, detail)
+		}
+		if detail := strings.Join(diff, "; "); detail != "" {
+			p.addGroup(ACTION_UPDATE, lg, g, members, detail)
+		}
+	}
+
+	// LDAP中已经删除的用户组, 移除来自LDAP的成员, 保留用户组和关联的策略
+	for _, g := range groups.Items {
+		if synced[g.Id] || g.Spec.CreateBy != SYNC_OPERATOR {
+			continue
+		}
+		current := ldapMembers(g, idToUser)
+		if len(current) == 0 {
+			continue
+		}
+		p.Groups = append(p.Groups, &GroupChange{
+			Change:  NewChange(RESOURCE_GROUP, ACTION_UPDATE, g.Spec.Name, g.Spec.ExternalId, "removed from ldap; "+membersDetail(current, nil)),
+			Group:   g,
+			Members: []string{},
+		})
+	}
+}
+
+func (p *Plan) addGroup(action ACTION, lg *ldap.GroupProfile, g *group.Group, members []string, detail string) {
+	p.Groups = append(p.Groups, &GroupChange{
+		Change:  NewChange(RESOURCE_GROUP, action, lg.Name, lg.DN, detail),
+		Profile: lg,
+		Group:   g,
+		Members: members,
+	})
+}
+
+// Changes 所有的变更
+func (p *Plan) Changes() []*Change {
+	changes := []*Change{}
+	for i := range p.Users {
+		changes = append(changes, p.Users[i].Change)
+	}
+	for i := range p.Groups {
+		changes = append(changes, p.Groups[i].Change)
+	}
+	return changes
+}
+
+// ApplyProfile 使用LDAP中的信息更新用户Profile, LDAP中为空的字段保留原值
+func ApplyProfile(profile *user.Profile, lu *ldap.UserProfile) *user.Profile {
+	if profile == nil {
+		profile = user.NewProfile()
+	}
+	if v := lu.Email(); v != "" {
+		profile.Email = v
+	}
+	if lu.DisplayName != "" {
+		profile.NickName = lu.DisplayName
+	}
+	return profile
+}
+
+func profileDetail(profile *user.Profile, lu *ldap.UserProfile) string {
+	if profile == nil {
+		profile = user.NewProfile()
+	}
+	diff := []string{}
+	if v := lu.Email(); v != "" && v != profile.Email {
+		diff = append(diff, fmt.Sprintf("email: %s -> %s", profile.Email, v))
+	}
+	if lu.DisplayName != "" && lu.DisplayName != profile.NickName {
+		diff = append(diff, fmt.Sprintf("nick_name: %s -> %s", profile.NickName, lu.DisplayName))
+	}
+	return strings.Join(diff, "; ")
+}
+
+// ldapMembers 用户组中来自LDAP的成员
+func ldapMembers(g *group.Group, idToUser map[string]*user.User) []string {
+	names := []string{}
+	for _, uid := range g.Spec.Users {
+		if u, ok := idToUser[uid]; ok && u.Spec.Provider.Equal(user.PROVIDER_LDAP) {
+			names = append(names, u.Spec.Username)
+		}
+	}
+	return uniqueSorted(names)
+}
+
+func membersDetail(current, target []string) string {
+	diff := []string{}
+	if added := subtract(target, current); len(added) > 0 {
+		diff = append(diff, "add members: "+strings.Join(added, ","))
+	}
+	if removed := subtract(current, target); len(removed) > 0 {
+		diff = append(diff, "remove members: "+strings.Join(removed, ","))
+	}
+	return strings.Join(diff, "; ")
+}
+
+func isLockedBySync(u *user.User) bool {
+	return u.Status.LockedReson == LOCK_REASON_REMOVED || u.Status.LockedReson == LOCK_REASON_DISABLED
+}
+
+func subtract(a, b []string) []string {
+	m := map[string]bool{}
+	for _, v := range b {
+		m[v] = true
+	}
+	diff := []string{}
+	for _, v := range a {
+		if !m[v] {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
+
+func uniqueSorted(items []string) []string {
+	m := map[string]bool{}
+	unique := []string{}
+	for _, v := range items {
+		if !m[v] {
+			m[v] = true
+			unique = append(unique, v)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
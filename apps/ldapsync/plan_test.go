@@ -0,0 +1,170 @@
+package ldapsync_test
+
+import (
+	"testing"
+
+	"github.com/infraboard/mcube/logger/zap"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/ldapsync"
+	"github.com/infraboard/mcenter/apps/token/provider/ldap"
+	"github.com/infraboard/mcenter/apps/token/provider/ldap/ldaptest"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+const (
+	baseDN = "dc=example,dc=org"
+)
+
+func TestNewPlan(t *testing.T) {
+	should := assert.New(t)
+
+	s, err := ldaptest.NewServer("cn=admin,"+baseDN, "admin")
+	if !should.NoError(err) {
+		return
+	}
+	defer s.Close()
+
+	s.Add(
+		newLdapUser("alice", "alice@example.org", "Alice"),
+		newLdapUser("bob", "bob@example.org", "Bob"),
+		newLdapUser("carol", "carol@example.org", "Carol").Set("nsAccountLock", "TRUE"),
+		newLdapUser("dave", "dave@example.org", "Dave"),
+		newLdapUser("admin", "admin@example.org", "Admin"),
+		ldaptest.NewEntry("cn=dev,ou=groups,"+baseDN).
+			Set("objectClass", "groupOfNames").
+			Set("cn", "dev").
+			Set("member", "uid=alice,ou=people,"+baseDN, "uid=dave,ou=people,"+baseDN),
+		ldaptest.NewEntry("cn=ops,ou=groups,"+baseDN).
+			Set("objectClass", "groupOfNames").
+			Set("cn", "ops").
+			Set("member", "uid=bob,ou=people,"+baseDN, "uid=admin,ou=people,"+baseDN),
+	)
+
+	conf := domain.NewDefaultConfig()
+	conf.Url = s.URL()
+	conf.BindDn = s.BindDN
+	conf.BindPassword = s.BindPassword
+	conf.BaseDn = baseDN
+	conf.DisabledAttribute = "nsAccountLock"
+	p := ldap.NewProvider(conf)
+
+	ldapUsers, err := p.ListUsers()
+	if !should.NoError(err) {
+		return
+	}
+	ldapGroups, err := p.ListGroups()
+	if !should.NoError(err) {
+		return
+	}
+	should.Len(ldapUsers, 5)
+	should.Len(ldapGroups, 2)
+
+	// bob 信息变更, carol 在LDAP中禁用, erin 从LDAP删除, admin 为同名本地用户
+	users := user.NewUserSet()
+	bob := newUser(t, "bob", user.PROVIDER_LDAP)
+	bob.Profile.Email = "bob@old.org"
+	bob.Profile.NickName = "Bob"
+	carol := newUser(t, "carol", user.PROVIDER_LDAP)
+	carol.Profile.Email = "carol@example.org"
+	carol.Profile.NickName = "Carol"
+	dave := newUser(t, "dave", user.PROVIDER_LDAP)
+	dave.Profile.Email = "dave@example.org"
+	dave.Profile.NickName = "Dave"
+	dave.Status.Locked = true
+	dave.Status.LockedReson = ldapsync.LOCK_REASON_REMOVED
+	erin := newUser(t, "erin", user.PROVIDER_LDAP)
+	admin := newUser(t, "admin", user.PROVIDER_LOCAL)
+	users.Items = append(users.Items, bob, carol, dave, erin, admin)
+
+	// ops 已经存在, 成员中有本地用户 admin 和已经删除的 erin
+	groups := group.NewGroupSet()
+	ops := newGroup(t, "ops", "", bob.Id, erin.Id, admin.Id)
+	groups.Add(ops)
+
+	plan := ldapsync.NewPlan(ldapUsers, ldapGroups, users, groups)
+	for _, c := range plan.Changes() {
+		t.Log(c)
+	}
+
+	actions := map[string][]ldapsync.ACTION{}
+	for _, c := range plan.Users {
+		actions[c.Name] = append(actions[c.Name], c.Action)
+	}
+	should.Equal([]ldapsync.ACTION{ldapsync.ACTION_CREATE}, actions["alice"])
+	should.Equal([]ldapsync.ACTION{ldapsync.ACTION_UPDATE}, actions["bob"])
+	should.Equal([]ldapsync.ACTION{ldapsync.ACTION_LOCK}, actions["carol"])
+	should.Equal([]ldapsync.ACTION{ldapsync.ACTION_UNLOCK}, actions["dave"])
+	should.Equal([]ldapsync.ACTION{ldapsync.ACTION_LOCK}, actions["erin"])
+	should.Equal([]ldapsync.ACTION{ldapsync.ACTION_SKIP}, actions["admin"])
+
+	should.Len(plan.Groups, 2)
+	for _, c := range plan.Groups {
+		switch c.Name {
+		case "dev":
+			should.Equal(ldapsync.ACTION_CREATE, c.Action)
+			should.Equal([]string{"alice", "dave"}, c.Members)
+		case "ops":
+			should.Equal(ldapsync.ACTION_UPDATE, c.Action)
+			should.Equal(ops, c.Group)
+			should.Equal([]string{"bob"}, c.Members)
+			should.Contains(c.Detail, "remove members: erin")
+		}
+	}
+}
+
+func TestNewPlanNoChange(t *testing.T) {
+	should := assert.New(t)
+
+	ldapUsers := []*ldap.UserProfile{
+		{DN: "uid=alice,ou=people," + baseDN, Username: "alice", Emails: []string{"alice@example.org"}, DisplayName: "Alice"},
+	}
+	ldapGroups := []*ldap.GroupProfile{
+		{DN: "cn=dev,ou=groups," + baseDN, Name: "dev", Members: []string{"uid=alice,ou=people," + baseDN}},
+	}
+
+	alice := newUser(t, "alice", user.PROVIDER_LDAP)
+	alice.Profile = ldapsync.ApplyProfile(alice.Profile, ldapUsers[0])
+	groups := group.NewGroupSet()
+	groups.Add(newGroup(t, "dev", ldapGroups[0].DN, alice.Id))
+
+	plan := ldapsync.NewPlan(ldapUsers, ldapGroups, &user.UserSet{Items: []*user.User{alice}}, groups)
+	should.Empty(plan.Changes())
+}
+
+func newLdapUser(username, mail, displayName string) *ldaptest.Entry {
+	return ldaptest.NewEntry("uid="+username+",ou=people,"+baseDN).
+		Set("objectClass", "inetOrgPerson").
+		Set("uid", username).
+		Set("mail", mail).
+		Set("displayName", displayName)
+}
+
+func newUser(t *testing.T, username string, provider user.PROVIDER) *user.User {
+	req := user.NewLDAPCreateUserRequest(domain.DEFAULT_DOMAIN, username, "Abc@123456", "")
+	req.Provider = provider
+	u, err := user.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+func newGroup(t *testing.T, name, externalId string, users ...string) *group.Group {
+	req := group.NewCreateGroupRequest()
+	req.Domain = domain.DEFAULT_DOMAIN
+	req.Name = name
+	req.ExternalId = externalId
+	req.Users = users
+	g, err := group.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func init() {
+	zap.DevelopmentSetup()
+}
@@ -0,0 +1,365 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: apps/ldapsync/pb/rpc.proto
+
+package ldapsync
+
+import (
+	request "github.com/infraboard/mcube/http/request"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RunSyncRequest 执行同步
+type RunSyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 同步的域
+	// @gotags: json:"domain" validate:"required"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain" validate:"required"`
+	// 是否只计算变更, 不执行
+	// @gotags: json:"dry_run"
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	// 触发方式
+	// @gotags: json:"trigger"
+	Trigger TRIGGER `protobuf:"varint,3,opt,name=trigger,proto3,enum=infraboard.mcenter.ldapsync.TRIGGER" json:"trigger"`
+}
+
+func (x *RunSyncRequest) Reset() {
+	*x = RunSyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_ldapsync_pb_rpc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSyncRequest) ProtoMessage() {}
+
+func (x *RunSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_ldapsync_pb_rpc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSyncRequest.ProtoReflect.Descriptor instead.
+func (*RunSyncRequest) Descriptor() ([]byte, []int) {
+	return file_apps_ldapsync_pb_rpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RunSyncRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *RunSyncRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RunSyncRequest) GetTrigger() TRIGGER {
+	if x != nil {
+		return x.Trigger
+	}
+	return TRIGGER_MANUAL
+}
+
+// QuerySyncRunRequest 查询同步记录
+type QuerySyncRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分页参数
+	// @gotags: json:"page"
+	Page *request.PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page"`
+	// 同步的域
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+	// 是否是预演
+	// @gotags: json:"dry_run"
+	DryRun *bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run"`
+}
+
+func (x *QuerySyncRunRequest) Reset() {
+	*x = QuerySyncRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_ldapsync_pb_rpc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySyncRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySyncRunRequest) ProtoMessage() {}
+
+func (x *QuerySyncRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_ldapsync_pb_rpc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySyncRunRequest.ProtoReflect.Descriptor instead.
+func (*QuerySyncRunRequest) Descriptor() ([]byte, []int) {
+	return file_apps_ldapsync_pb_rpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuerySyncRunRequest) GetPage() *request.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *QuerySyncRunRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *QuerySyncRunRequest) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+// DescribeSyncRunRequest 查询同步记录详情
+type DescribeSyncRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 记录Id
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required"`
+}
+
+func (x *DescribeSyncRunRequest) Reset() {
+	*x = DescribeSyncRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_ldapsync_pb_rpc_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeSyncRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeSyncRunRequest) ProtoMessage() {}
+
+func (x *DescribeSyncRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_ldapsync_pb_rpc_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeSyncRunRequest.ProtoReflect.Descriptor instead.
+func (*DescribeSyncRunRequest) Descriptor() ([]byte, []int) {
+	return file_apps_ldapsync_pb_rpc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DescribeSyncRunRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_apps_ldapsync_pb_rpc_proto protoreflect.FileDescriptor
+
+var file_apps_ldapsync_pb_rpc_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2f,
+	0x70, 0x62, 0x2f, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x2f, 0x6d, 0x63, 0x75, 0x62, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2f, 0x70,
+	0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x61, 0x70, 0x70, 0x73, 0x2f,
+	0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x6c, 0x64, 0x61, 0x70,
+	0x73, 0x79, 0x6e, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x52,
+	0x75, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x3e,
+	0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x24, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x54, 0x52,
+	0x49, 0x47, 0x47, 0x45, 0x52, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x22, 0x8f,
+	0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x6d, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x6e, 0x63,
+	0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x32, 0xde, 0x01, 0x0a, 0x03, 0x52,
+	0x50, 0x43, 0x12, 0x69, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x6e, 0x63, 0x52,
+	0x75, 0x6e, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79,
+	0x6e, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x6c, 0x0a,
+	0x0f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e,
+	0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6c, 0x64, 0x61, 0x70, 0x73,
+	0x79, 0x6e, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x42, 0x2d, 0x5a, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70,
+	0x73, 0x2f, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_apps_ldapsync_pb_rpc_proto_rawDescOnce sync.Once
+	file_apps_ldapsync_pb_rpc_proto_rawDescData = file_apps_ldapsync_pb_rpc_proto_rawDesc
+)
+
+func file_apps_ldapsync_pb_rpc_proto_rawDescGZIP() []byte {
+	file_apps_ldapsync_pb_rpc_proto_rawDescOnce.Do(func() {
+		file_apps_ldapsync_pb_rpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_ldapsync_pb_rpc_proto_rawDescData)
+	})
+	return file_apps_ldapsync_pb_rpc_proto_rawDescData
+}
+
+var file_apps_ldapsync_pb_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_apps_ldapsync_pb_rpc_proto_goTypes = []interface{}{
+	(*RunSyncRequest)(nil),         // 0: infraboard.mcenter.ldapsync.RunSyncRequest
+	(*QuerySyncRunRequest)(nil),    // 1: infraboard.mcenter.ldapsync.QuerySyncRunRequest
+	(*DescribeSyncRunRequest)(nil), // 2: infraboard.mcenter.ldapsync.DescribeSyncRunRequest
+	(TRIGGER)(0),                   // 3: infraboard.mcenter.ldapsync.TRIGGER
+	(*request.PageRequest)(nil),    // 4: infraboard.mcube.page.PageRequest
+	(*SyncRunSet)(nil),             // 5: infraboard.mcenter.ldapsync.SyncRunSet
+	(*SyncRun)(nil),                // 6: infraboard.mcenter.ldapsync.SyncRun
+}
+var file_apps_ldapsync_pb_rpc_proto_depIdxs = []int32{
+	3, // 0: infraboard.mcenter.ldapsync.RunSyncRequest.trigger:type_name -> infraboard.mcenter.ldapsync.TRIGGER
+	4, // 1: infraboard.mcenter.ldapsync.QuerySyncRunRequest.page:type_name -> infraboard.mcube.page.PageRequest
+	1, // 2: infraboard.mcenter.ldapsync.RPC.QuerySyncRun:input_type -> infraboard.mcenter.ldapsync.QuerySyncRunRequest
+	2, // 3: infraboard.mcenter.ldapsync.RPC.DescribeSyncRun:input_type -> infraboard.mcenter.ldapsync.DescribeSyncRunRequest
+	5, // 4: infraboard.mcenter.ldapsync.RPC.QuerySyncRun:output_type -> infraboard.mcenter.ldapsync.SyncRunSet
+	6, // 5: infraboard.mcenter.ldapsync.RPC.DescribeSyncRun:output_type -> infraboard.mcenter.ldapsync.SyncRun
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_apps_ldapsync_pb_rpc_proto_init() }
+func file_apps_ldapsync_pb_rpc_proto_init() {
+	if File_apps_ldapsync_pb_rpc_proto != nil {
+		return
+	}
+	file_apps_ldapsync_pb_ldapsync_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_apps_ldapsync_pb_rpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunSyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_ldapsync_pb_rpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySyncRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_ldapsync_pb_rpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeSyncRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_apps_ldapsync_pb_rpc_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_ldapsync_pb_rpc_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_apps_ldapsync_pb_rpc_proto_goTypes,
+		DependencyIndexes: file_apps_ldapsync_pb_rpc_proto_depIdxs,
+		MessageInfos:      file_apps_ldapsync_pb_rpc_proto_msgTypes,
+	}.Build()
+	File_apps_ldapsync_pb_rpc_proto = out.File
+	file_apps_ldapsync_pb_rpc_proto_rawDesc = nil
+	file_apps_ldapsync_pb_rpc_proto_goTypes = nil
+	file_apps_ldapsync_pb_rpc_proto_depIdxs = nil
+}
@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.6
+// source: apps/ldapsync/pb/rpc.proto
+
+package ldapsync
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RPCClient is the client API for RPC service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RPCClient interface {
+	// 查询同步记录
+	QuerySyncRun(ctx context.Context, in *QuerySyncRunRequest, opts ...grpc.CallOption) (*SyncRunSet, error)
+	// 查询同步记录详情
+	DescribeSyncRun(ctx context.Context, in *DescribeSyncRunRequest, opts ...grpc.CallOption) (*SyncRun, error)
+}
+
+type rPCClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRPCClient(cc grpc.ClientConnInterface) RPCClient {
+	return &rPCClient{cc}
+}
+
+func (c *rPCClient) QuerySyncRun(ctx context.Context, in *QuerySyncRunRequest, opts ...grpc.CallOption) (*SyncRunSet, error) {
+	out := new(SyncRunSet)
+	err := c.cc.Invoke(ctx, "/infraboard.mcenter.ldapsync.RPC/QuerySyncRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) DescribeSyncRun(ctx context.Context, in *DescribeSyncRunRequest, opts ...grpc.CallOption) (*SyncRun, error) {
+	out := new(SyncRun)
+	err := c.cc.Invoke(ctx, "/infraboard.mcenter.ldapsync.RPC/DescribeSyncRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RPCServer is the server API for RPC service.
+// All implementations must embed UnimplementedRPCServer
+// for forward compatibility
+type RPCServer interface {
+	// 查询同步记录
+	QuerySyncRun(context.Context, *QuerySyncRunRequest) (*SyncRunSet, error)
+	// 查询同步记录详情
+	DescribeSyncRun(context.Context, *DescribeSyncRunRequest) (*SyncRun, error)
+	mustEmbedUnimplementedRPCServer()
+}
+
+// UnimplementedRPCServer must be embedded to have forward compatible implementations.
+type UnimplementedRPCServer struct {
+}
+
+func (UnimplementedRPCServer) QuerySyncRun(context.Context, *QuerySyncRunRequest) (*SyncRunSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySyncRun not implemented")
+}
+func (UnimplementedRPCServer) DescribeSyncRun(context.Context, *DescribeSyncRunRequest) (*SyncRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSyncRun not implemented")
+}
+func (UnimplementedRPCServer) mustEmbedUnimplementedRPCServer() {}
+
+// UnsafeRPCServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RPCServer will
+// result in compilation errors.
+type UnsafeRPCServer interface {
+	mustEmbedUnimplementedRPCServer()
+}
+
+func RegisterRPCServer(s grpc.ServiceRegistrar, srv RPCServer) {
+	s.RegisterService(&RPC_ServiceDesc, srv)
+}
+
+func _RPC_QuerySyncRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySyncRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).QuerySyncRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.mcenter.ldapsync.RPC/QuerySyncRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).QuerySyncRun(ctx, req.(*QuerySyncRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_DescribeSyncRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeSyncRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).DescribeSyncRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.mcenter.ldapsync.RPC/DescribeSyncRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).DescribeSyncRun(ctx, req.(*DescribeSyncRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RPC_ServiceDesc is the grpc.ServiceDesc for RPC service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RPC_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "infraboard.mcenter.ldapsync.RPC",
+	HandlerType: (*RPCServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QuerySyncRun",
+			Handler:    _RPC_QuerySyncRun_Handler,
+		},
+		{
+			MethodName: "DescribeSyncRun",
+			Handler:    _RPC_DescribeSyncRun_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apps/ldapsync/pb/rpc.proto",
+}
@@ -0,0 +1,80 @@
+package lease
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/rs/xid"
+)
+
+const (
+	AppName = "lease"
+)
+
+var (
+	validate = validator.New()
+	// 当前进程的持有者标识, 进程重启后变化, 重启前持有的租约只能等待过期
+	owner = newOwner()
+)
+
+func newOwner() string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("%s-%s", hostname, xid.New().String())
+}
+
+// Owner 当前进程的持有者标识
+func Owner() string {
+	return owner
+}
+
+// Lease 租约, 同一时间只能有一个持有者, 持有者需要在过期前续约
+type Lease struct {
+	// 租约名称
+	Name string `bson:"_id" json:"name"`
+	// 持有者
+	Owner string `bson:"owner" json:"owner"`
+	// 最近一次获取或者续约的时间
+	RenewAt int64 `bson:"renew_at" json:"renew_at"`
+	// 过期时间, 过期后其他持有者可以获取
+	ExpireAt time.Time `bson:"expire_at" json:"expire_at"`
+}
+
+// NewAcquireRequest 使用当前进程的持有者标识获取租约
+func NewAcquireRequest(name string, ttl time.Duration) *AcquireRequest {
+	return &AcquireRequest{
+		Name:  name,
+		Owner: Owner(),
+		TTL:   ttl,
+	}
+}
+
+// AcquireRequest 获取租约, 持有者相同时为续约
+type AcquireRequest struct {
+	Name  string        `json:"name" validate:"required"`
+	Owner string        `json:"owner" validate:"required"`
+	TTL   time.Duration `json:"ttl" validate:"required"`
+}
+
+func (req *AcquireRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// NewReleaseRequest 释放当前进程持有的租约
+func NewReleaseRequest(name string) *ReleaseRequest {
+	return &ReleaseRequest{
+		Name:  name,
+		Owner: Owner(),
+	}
+}
+
+// ReleaseRequest 释放租约
+type ReleaseRequest struct {
+	Name  string `json:"name" validate:"required"`
+	Owner string `json:"owner" validate:"required"`
+}
+
+func (req *ReleaseRequest) Validate() error {
+	return validate.Struct(req)
+}
@@ -0,0 +1,56 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+
+	"github.com/infraboard/mcenter/apps/lease"
+	"github.com/infraboard/mcenter/conf"
+)
+
+var (
+	// Service 服务实例
+	svr = &service{}
+)
+
+type service struct {
+	col *mongo.Collection
+	log logger.Logger
+}
+
+func (s *service) Config() error {
+	db, err := conf.C().Mongo.GetDB()
+	if err != nil {
+		return err
+	}
+
+	dc := db.Collection("lease")
+	indexs := []mongo.IndexModel{
+		{
+			// 持有者异常退出后留下的租约, 过期后由Mongo自动清理
+			Keys:    bsonx.Doc{{Key: "expire_at", Value: bsonx.Int32(1)}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+	if _, err := dc.Indexes().CreateMany(context.Background(), indexs); err != nil {
+		return err
+	}
+	s.col = dc
+
+	s.log = zap.L().Named(lease.AppName)
+	return nil
+}
+
+func (s *service) Name() string {
+	return lease.AppName
+}
+
+func init() {
+	app.RegistryInternalApp(svr)
+}
@@ -0,0 +1,55 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/infraboard/mcenter/apps/lease"
+)
+
+// 租约由自己持有或者已经过期时更新持有者, 否则upsert会因为_id冲突失败, 整个过程是原子的
+func (s *service) Acquire(ctx context.Context, req *lease.AcquireRequest) (*lease.Lease, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	now := time.Now()
+	filter := bson.M{
+		"_id": req.Name,
+		"$or": bson.A{
+			bson.M{"owner": req.Owner},
+			bson.M{"expire_at": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{
+		"owner":     req.Owner,
+		"renew_at":  now.UnixMilli(),
+		"expire_at": now.Add(req.TTL),
+	}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	ins := &lease.Lease{}
+	if err := s.col.FindOneAndUpdate(ctx, filter, update, opts).Decode(ins); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, exception.NewConflict("lease %s is held by other owner", req.Name)
+		}
+		return nil, exception.NewInternalServerError("acquire lease %s error, %s", req.Name, err)
+	}
+	return ins, nil
+}
+
+func (s *service) Release(ctx context.Context, req *lease.ReleaseRequest) error {
+	if err := req.Validate(); err != nil {
+		return exception.NewBadRequest(err.Error())
+	}
+
+	if _, err := s.col.DeleteOne(ctx, bson.M{"_id": req.Name, "owner": req.Owner}); err != nil {
+		return exception.NewInternalServerError("release lease %s error, %s", req.Name, err)
+	}
+	return nil
+}
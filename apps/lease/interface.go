@@ -0,0 +1,13 @@
+package lease
+
+import (
+	"context"
+)
+
+// Service 基于Mongo的租约, 用于多副本部署时保证同一个任务只在一个副本上执行
+type Service interface {
+	// 获取租约, 租约被其他持有者占用且未过期时返回Conflict, 持有者相同时续约
+	Acquire(context.Context, *AcquireRequest) (*Lease, error)
+	// 释放租约, 只能释放自己持有的租约
+	Release(context.Context, *ReleaseRequest) error
+}
@@ -0,0 +1,127 @@
+package lease
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+)
+
+const (
+	// 任务租约的过期时间, 执行期间按照三分之一定期续约
+	JOB_LEASE_TTL = 30 * time.Second
+	// 检查中断任务的间隔
+	JOB_RECOVER_INTERVAL = time.Minute
+)
+
+var (
+	// 当前进程正在执行的任务的租约, 持有者相同时获取租约为续约, 检查中断任务时需要排除掉
+	// 同时注册为内部服务和gRPC服务的模块会初始化两次, 放在进程级别保证多个执行器看到的一致
+	runningLock sync.Mutex
+	running     = map[string]struct{}{}
+)
+
+// NewJobRunner 后台任务执行器, kind为任务类型, 用于区分不同类型任务的租约
+func NewJobRunner(svc Service, kind string) *JobRunner {
+	return &JobRunner{
+		svc:  svc,
+		kind: kind,
+		log:  zap.L().Named(AppName),
+	}
+}
+
+// JobRunner 每个任务持有独立的租约, 执行任务的副本退出后租约过期,
+// 其他副本检查未完成的任务时能够获取到租约, 说明任务已经中断
+type JobRunner struct {
+	svc  Service
+	kind string
+	log  logger.Logger
+}
+
+func (r *JobRunner) leaseName(id string) string {
+	return fmt.Sprintf("%s/%s", r.kind, id)
+}
+
+// Start 获取任务的租约后保存任务, 然后在后台持有租约执行, 执行完成后释放租约
+// 任务的租约被占用时返回Conflict, 保存失败时释放租约
+func (r *JobRunner) Start(ctx context.Context, id string, save func(ctx context.Context) error, fn func(ctx context.Context)) error {
+	name := r.leaseName(id)
+	if _, err := r.svc.Acquire(ctx, NewAcquireRequest(name, JOB_LEASE_TTL)); err != nil {
+		return err
+	}
+	r.setRunning(id, true)
+
+	if err := save(ctx); err != nil {
+		r.release(id)
+		return err
+	}
+
+	go func() {
+		defer r.release(id)
+		Hold(context.Background(), r.svc, name, JOB_LEASE_TTL, fn)
+	}()
+	return nil
+}
+
+func (r *JobRunner) release(id string) {
+	r.setRunning(id, false)
+	if err := r.svc.Release(context.Background(), NewReleaseRequest(r.leaseName(id))); err != nil {
+		r.log.Errorf("release job lease %s error, %s", r.leaseName(id), err)
+	}
+}
+
+func (r *JobRunner) setRunning(id string, isRunning bool) {
+	runningLock.Lock()
+	defer runningLock.Unlock()
+	if isRunning {
+		running[r.leaseName(id)] = struct{}{}
+	} else {
+		delete(running, r.leaseName(id))
+	}
+}
+
+func (r *JobRunner) isRunning(id string) bool {
+	runningLock.Lock()
+	defer runningLock.Unlock()
+	_, ok := running[r.leaseName(id)]
+	return ok
+}
+
+// Recover 定时检查未完成的任务, active返回未完成任务的Id
+// 能够获取到租约说明执行任务的副本已经退出, 持有租约调用interrupt结束任务
+// 任务可能在检查期间正常结束, interrupt需要只更新仍未完成的任务
+func (r *JobRunner) Recover(active func(ctx context.Context) ([]string, error),
+	interrupt func(ctx context.Context, id string) error) {
+	tk := time.NewTicker(JOB_RECOVER_INTERVAL)
+	defer tk.Stop()
+
+	for range tk.C {
+		ctx := context.Background()
+		ids, err := active(ctx)
+		if err != nil {
+			r.log.Errorf("list %s active job error, %s", r.kind, err)
+			continue
+		}
+		for _, id := range ids {
+			if r.isRunning(id) {
+				continue
+			}
+			if _, err := r.svc.Acquire(ctx, NewAcquireRequest(r.leaseName(id), JOB_LEASE_TTL)); err != nil {
+				if !exception.IsConflictError(err) {
+					r.log.Errorf("acquire job lease %s error, %s", r.leaseName(id), err)
+				}
+				continue
+			}
+			if err := interrupt(ctx, id); err != nil {
+				r.log.Errorf("interrupt %s job %s error, %s", r.kind, id, err)
+			}
+			if err := r.svc.Release(ctx, NewReleaseRequest(r.leaseName(id))); err != nil {
+				r.log.Errorf("release job lease %s error, %s", r.leaseName(id), err)
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package lease
+
+import (
+	"context"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/logger/zap"
+)
+
+// Hold 持有租约执行fn, 执行期间按照ttl的三分之一定期续约
+// 续约失败时取消fn的ctx, 避免租约被其他副本获取后同时执行
+func Hold(ctx context.Context, svc Service, name string, ttl time.Duration, fn func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		tk := time.NewTicker(ttl / 3)
+		defer tk.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-tk.C:
+				if _, err := svc.Acquire(ctx, NewAcquireRequest(name, ttl)); err != nil {
+					zap.L().Named(AppName).Errorf("renew lease %s error, %s", name, err)
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	fn(ctx)
+}
+
+// Schedule 按照间隔定时执行fn, 多副本部署时只有持有租约的副本执行
+// 执行后不释放租约, 持有者每次执行前续约, 持有者退出后租约过期, 由其他副本接管
+func Schedule(svc Service, name string, interval time.Duration, fn func(ctx context.Context)) {
+	ttl := 2 * interval
+	tk := time.NewTicker(interval)
+	defer tk.Stop()
+
+	for range tk.C {
+		if _, err := svc.Acquire(context.Background(), NewAcquireRequest(name, ttl)); err != nil {
+			if !exception.IsConflictError(err) {
+				zap.L().Named(AppName).Errorf("acquire schedule lease %s error, %s", name, err)
+			}
+			continue
+		}
+		Hold(context.Background(), svc, name, ttl, fn)
+	}
+}
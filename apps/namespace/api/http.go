@@ -1 +1,170 @@
 package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/mcenter/apps/namespace"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service namespace.Service
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(namespace.AppName)
+	h.service = app.GetInternalApp(namespace.AppName).(namespace.Service)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return namespace.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"空间管理"}
+
+	ws.Route(ws.POST("/").To(h.CreateNamespace).
+		Doc("创建空间, 指定parent_id时创建子空间").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(namespace.CreateNamespaceRequest{}).
+		Writes(namespace.Namespace{}))
+
+	ws.Route(ws.GET("/").To(h.QueryNamespace).
+		Doc("查询空间列表").
+		Param(ws.QueryParameter("domain", "domain of the namespace").DataType("string")).
+		Param(ws.QueryParameter("parent_id", "查询该空间的子空间").DataType("string")).
+		Param(ws.QueryParameter("with_sub", "指定parent_id时, 是否查询所有后代空间").DataType("boolean")).
+		Param(ws.QueryParameter("with_archived", "是否包含已归档和删除中的空间").DataType("boolean")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", namespace.NamespaceSet{}))
+
+	ws.Route(ws.GET("/{name}").To(h.DescribeNamespace).
+		Doc("查询空间详情").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Param(ws.QueryParameter("domain", "domain of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", namespace.Namespace{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.PUT("/{name}/parent").To(h.MoveNamespace).
+		Doc("移动空间, 子空间随之移动").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(namespace.MoveNamespaceRequest{}).
+		Returns(200, "OK", namespace.Namespace{}))
+
+	ws.Route(ws.PUT("/{name}/inheritance").To(h.SetInheritance).
+		Doc("设置空间是否阻断上级空间的策略继承").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(namespace.SetInheritanceRequest{}).
+		Returns(200, "OK", namespace.Namespace{}))
+
+	ws.Route(ws.GET("/{name}/inherited").To(h.QueryInheritedNamespace).
+		Doc("查询空间继承策略的上级空间").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Param(ws.QueryParameter("domain", "domain of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", namespace.NamespaceSet{}))
+
+	ws.Route(ws.GET("/{name}/members").To(h.QueryMember).
+		Doc("查询空间成员及其角色").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Param(ws.QueryParameter("domain", "domain of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", namespace.MemberSet{}))
+
+	ws.Route(ws.POST("/{name}/members").To(h.AddMember).
+		Doc("添加空间成员, 可以同时添加多个用户和用户组").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(namespace.AddMemberRequest{}).
+		Returns(200, "OK", namespace.MemberSet{}))
+
+	ws.Route(ws.DELETE("/{name}/members").To(h.RemoveMember).
+		Doc("移除空间成员, 负责人不允许移除").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(namespace.RemoveMemberRequest{}).
+		Returns(200, "OK", namespace.MemberSet{}))
+
+	ws.Route(ws.PUT("/{name}/owner").To(h.TransferOwner).
+		Doc("转移空间负责人, 原负责人保留管理员角色").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(namespace.TransferOwnerRequest{}).
+		Returns(200, "OK", namespace.Namespace{}))
+
+	ws.Route(ws.POST("/{name}/leave").To(h.LeaveNamespace).
+		Doc("离开空间, 负责人需要先转移负责人").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(namespace.LeaveNamespaceRequest{}).
+		Returns(200, "OK", namespace.MemberSet{}))
+
+	ws.Route(ws.GET("/{name}/delete_check").To(h.CheckDeleteNamespace).
+		Doc("删除空间前检查依赖, 列出阻止删除的资源").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Param(ws.QueryParameter("domain", "domain of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", namespace.DeleteCheckResult{}))
+
+	ws.Route(ws.DELETE("/{name}").To(h.DeleteNamespace).
+		Doc("删除空间, 还有资源时不允许删除; force=true时级联删除, 删除在后台执行, 返回删除任务").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Param(ws.QueryParameter("domain", "domain of the namespace").DataType("string")).
+		Param(ws.QueryParameter("force", "级联删除空间下的资源").DataType("boolean")).
+		Param(ws.QueryParameter("operator", "操作人").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", namespace.Namespace{}))
+
+	ws.Route(ws.POST("/{name}/archive").To(h.ArchiveNamespace).
+		Doc("归档空间, 归档后只读并且默认不在列表中显示").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(namespace.ArchiveNamespaceRequest{}).
+		Returns(200, "OK", namespace.Namespace{}))
+
+	ws.Route(ws.POST("/{name}/restore").To(h.RestoreNamespace).
+		Doc("恢复已归档的空间").
+		Param(ws.PathParameter("name", "name of the namespace").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(namespace.RestoreNamespaceRequest{}).
+		Returns(200, "OK", namespace.Namespace{}))
+
+	ws.Route(ws.GET("/delete_jobs").To(h.QueryDeleteJob).
+		Doc("查询空间删除任务").
+		Param(ws.QueryParameter("domain", "domain of the namespace").DataType("string")).
+		Param(ws.QueryParameter("namespace", "空间名称").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", namespace.DeleteJobSet{}))
+
+	ws.Route(ws.GET("/delete_jobs/{id}").To(h.DescribeDeleteJob).
+		Doc("查询空间删除任务详情").
+		Param(ws.PathParameter("id", "任务Id").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", namespace.DeleteJob{}))
+
+	ws.Route(ws.POST("/delete_jobs/{id}/resume").To(h.ResumeDeleteJob).
+		Doc("继续执行失败的删除任务, 已经完成的步骤不再执行").
+		Param(ws.PathParameter("id", "任务Id").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", namespace.DeleteJob{}))
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/http/restful/response"
+
+	"github.com/infraboard/mcenter/apps/namespace"
+)
+
+func (h *handler) CheckDeleteNamespace(r *restful.Request, w *restful.Response) {
+	req := namespace.NewDescriptNamespaceRequest(r.QueryParameter("domain"), r.PathParameter("name"))
+	ins, err := h.service.CheckDeleteNamespace(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) DeleteNamespace(r *restful.Request, w *restful.Response) {
+	req := namespace.NewDeleteNamespaceRequest(r.PathParameter("name"))
+	req.Domain = r.QueryParameter("domain")
+	req.Operator = r.QueryParameter("operator")
+
+	if r.QueryParameter("force") == "true" {
+		job, err := h.service.CascadeDeleteNamespace(r.Request.Context(), req)
+		if err != nil {
+			response.Failed(w, err)
+			return
+		}
+		response.Success(w, job)
+		return
+	}
+
+	ins, err := h.service.DeleteNamespace(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) ArchiveNamespace(r *restful.Request, w *restful.Response) {
+	req := namespace.NewArchiveNamespaceRequest("", "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Name = r.PathParameter("name")
+
+	ins, err := h.service.ArchiveNamespace(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) RestoreNamespace(r *restful.Request, w *restful.Response) {
+	req := namespace.NewRestoreNamespaceRequest("", "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Name = r.PathParameter("name")
+
+	ins, err := h.service.RestoreNamespace(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) QueryDeleteJob(r *restful.Request, w *restful.Response) {
+	req := namespace.NewQueryDeleteJobRequestFromHTTP(r.Request)
+	set, err := h.service.QueryDeleteJob(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *handler) DescribeDeleteJob(r *restful.Request, w *restful.Response) {
+	req := namespace.NewDescribeDeleteJobRequest(r.PathParameter("id"))
+	ins, err := h.service.DescribeDeleteJob(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) ResumeDeleteJob(r *restful.Request, w *restful.Response) {
+	req := namespace.NewDescribeDeleteJobRequest(r.PathParameter("id"))
+	ins, err := h.service.ResumeDeleteJob(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
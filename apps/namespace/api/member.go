@@ -0,0 +1,82 @@
+package api
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/http/restful/response"
+
+	"github.com/infraboard/mcenter/apps/namespace"
+)
+
+func (h *handler) QueryMember(r *restful.Request, w *restful.Response) {
+	req := namespace.NewQueryMemberRequest(r.QueryParameter("domain"), r.PathParameter("name"))
+	set, err := h.service.QueryMember(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *handler) AddMember(r *restful.Request, w *restful.Response) {
+	req := namespace.NewAddMemberRequest("", "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Namespace = r.PathParameter("name")
+
+	set, err := h.service.AddMember(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *handler) RemoveMember(r *restful.Request, w *restful.Response) {
+	req := namespace.NewRemoveMemberRequest("", "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Namespace = r.PathParameter("name")
+
+	set, err := h.service.RemoveMember(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *handler) TransferOwner(r *restful.Request, w *restful.Response) {
+	req := namespace.NewTransferOwnerRequest("", "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Namespace = r.PathParameter("name")
+
+	ins, err := h.service.TransferOwner(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) LeaveNamespace(r *restful.Request, w *restful.Response) {
+	req := namespace.NewLeaveNamespaceRequest("", "", "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Namespace = r.PathParameter("name")
+
+	set, err := h.service.LeaveNamespace(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/http/restful/response"
+
+	"github.com/infraboard/mcenter/apps/namespace"
+)
+
+func (h *handler) CreateNamespace(r *restful.Request, w *restful.Response) {
+	req := namespace.NewCreateNamespaceRequest()
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	ins, err := h.service.CreateNamespace(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) QueryNamespace(r *restful.Request, w *restful.Response) {
+	req := namespace.NewQueryNamespaceRequestFromHTTP(r.Request)
+	set, err := h.service.QueryNamespace(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *handler) DescribeNamespace(r *restful.Request, w *restful.Response) {
+	req := namespace.NewDescriptNamespaceRequest(r.QueryParameter("domain"), r.PathParameter("name"))
+	ins, err := h.service.DescribeNamespace(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) MoveNamespace(r *restful.Request, w *restful.Response) {
+	req := namespace.NewMoveNamespaceRequest("", "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Name = r.PathParameter("name")
+
+	ins, err := h.service.MoveNamespace(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) SetInheritance(r *restful.Request, w *restful.Response) {
+	req := namespace.NewSetInheritanceRequest("", "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Name = r.PathParameter("name")
+
+	ins, err := h.service.SetInheritance(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) QueryInheritedNamespace(r *restful.Request, w *restful.Response) {
+	req := namespace.NewDescriptNamespaceRequest(r.QueryParameter("domain"), r.PathParameter("name"))
+	set, err := h.service.QueryInheritedNamespace(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
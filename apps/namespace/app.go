@@ -21,6 +21,8 @@ const (
 
 const (
 	DEFAULT_NAMESPACE = "default"
+	// 空间最大的嵌套层级
+	MAX_NAMESPACE_DEPTH = 10
 )
 
 // NewDefaultNamespace todo
@@ -32,6 +34,21 @@ func NewDefaultNamespace() *Namespace {
 	}
 }
 
+// ChildAncestors 子空间的祖先空间Id
+func (n *Namespace) ChildAncestors() []string {
+	return append(append([]string{}, n.Ancestors...), n.Id)
+}
+
+// IsDescendantOf 是否是指定空间的后代空间
+func (n *Namespace) IsDescendantOf(id string) bool {
+	for _, a := range n.Ancestors {
+		if a == id {
+			return true
+		}
+	}
+	return false
+}
+
 // NewCreateNamespaceRequest todo
 func NewCreateNamespaceRequest() *CreateNamespaceRequest {
 	return &CreateNamespaceRequest{}
@@ -59,6 +76,14 @@ func (s *NamespaceSet) Add(item *Namespace) {
 	s.Items = append(s.Items, item)
 }
 
+// Names 空间名称列表
+func (s *NamespaceSet) Names() (names []string) {
+	for i := range s.Items {
+		names = append(names, s.Items[i].Spec.Name)
+	}
+	return
+}
+
 // NewDescriptNamespaceRequest new实例
 func NewDescriptNamespaceRequest(domain, name string) *DescriptNamespaceRequest {
 	return &DescriptNamespaceRequest{
@@ -71,8 +96,13 @@ func NewDescriptNamespaceRequest(domain, name string) *DescriptNamespaceRequest
 func NewQueryNamespaceRequestFromHTTP(r *http.Request) *QueryNamespaceRequest {
 	qs := r.URL.Query()
 	return &QueryNamespaceRequest{
-		Page: request.NewPageRequestFromHTTP(r),
-		Name: []string{qs.Get("name")},
+		Page:     request.NewPageRequestFromHTTP(r),
+		Domain:   qs.Get("domain"),
+		Name:     []string{qs.Get("name")},
+		ParentId: qs.Get("parent_id"),
+		WithSub:  qs.Get("with_sub") == "true",
+		// 默认不显示已归档的空间
+		WithArchived: qs.Get("with_archived") == "true",
 	}
 }
 
@@ -112,3 +142,37 @@ func (req *DeleteNamespaceRequest) Validate() error {
 
 	return nil
 }
+
+// NewMoveNamespaceRequest todo
+func NewMoveNamespaceRequest(domain, name string) *MoveNamespaceRequest {
+	return &MoveNamespaceRequest{
+		Domain: domain,
+		Name:   name,
+	}
+}
+
+// Validate todo
+func (req *MoveNamespaceRequest) Validate() error {
+	if req.Name == "" {
+		return fmt.Errorf("name required")
+	}
+
+	return nil
+}
+
+// NewSetInheritanceRequest todo
+func NewSetInheritanceRequest(domain, name string) *SetInheritanceRequest {
+	return &SetInheritanceRequest{
+		Domain: domain,
+		Name:   name,
+	}
+}
+
+// Validate todo
+func (req *SetInheritanceRequest) Validate() error {
+	if req.Name == "" {
+		return fmt.Errorf("name required")
+	}
+
+	return nil
+}
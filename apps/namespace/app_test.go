@@ -0,0 +1,70 @@
+package namespace_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/namespace"
+)
+
+func TestNamespaceTree(t *testing.T) {
+	should := assert.New(t)
+
+	// 公司 / 部门 / 团队
+	company := namespace.NewDefaultNamespace()
+	company.Id = "company"
+	company.Spec.Name = "company"
+
+	dept := namespace.NewDefaultNamespace()
+	dept.Id = "dept"
+	dept.Spec.Name = "dept"
+	dept.Ancestors = company.ChildAncestors()
+
+	team := namespace.NewDefaultNamespace()
+	team.Id = "team"
+	team.Spec.Name = "team"
+	team.Ancestors = dept.ChildAncestors()
+
+	should.Equal([]string{"company", "dept"}, team.Ancestors)
+	should.Equal([]string{"company"}, dept.Ancestors)
+	should.True(team.IsDescendantOf("company"))
+	should.True(team.IsDescendantOf("dept"))
+	should.False(dept.IsDescendantOf("team"))
+	should.False(company.IsDescendantOf("company"))
+
+	set := namespace.NewNamespaceSet()
+	set.Add(dept)
+	set.Add(company)
+	should.Equal([]string{"dept", "company"}, set.Names())
+}
+
+func TestMemberSet(t *testing.T) {
+	should := assert.New(t)
+
+	set := namespace.NewMemberSet()
+	m := set.GetOrAdd("alice", "")
+	m.IsOwner = true
+	set.GetOrAdd("", "dev")
+	should.True(set.GetOrAdd("alice", "").IsOwner)
+	should.Equal(int64(2), set.Total)
+
+	// 用户和用户组必须指定其中一个
+	req := namespace.NewRemoveMemberRequest("default", "team01")
+	should.Error(req.Validate())
+	req.Username = "alice"
+	should.NoError(req.Validate())
+	req.Group = "dev"
+	should.Error(req.Validate())
+
+	// 不指定角色时移除所有角色
+	should.True(req.HasRole("r1"))
+	req.RoleIds = []string{"r2"}
+	should.False(req.HasRole("r1"))
+
+	add := namespace.NewAddMemberRequest("default", "team01")
+	add.RoleIds = []string{"r1"}
+	should.Error(add.Validate())
+	add.Groups = []string{"dev"}
+	should.NoError(add.Validate())
+}
@@ -1,7 +1,12 @@
 package impl
 
 import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx"
 
 	"github.com/infraboard/mcube/app"
 	"github.com/infraboard/mcube/logger"
@@ -9,9 +14,15 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/infraboard/mcenter/apps/counter"
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/instance"
+	"github.com/infraboard/mcenter/apps/lease"
 	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/policy"
 	"github.com/infraboard/mcenter/apps/role"
+	meta "github.com/infraboard/mcenter/apps/service"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
 	"github.com/infraboard/mcenter/conf"
 )
 
@@ -22,12 +33,23 @@ var (
 
 type impl struct {
 	col *mongo.Collection
+	job *mongo.Collection
 	log logger.Logger
 	namespace.UnimplementedRPCServer
 
 	counter counter.Service
 	role    role.Service
 	policy  policy.Service
+	domain  domain.Service
+	user    user.Service
+	runner  *lease.JobRunner
+	// 删除空间前检查, 级联删除时按照顺序删除的资源
+	resources []*resource
+}
+
+type resource struct {
+	name string
+	namespace.Resource
 }
 
 func (i *impl) Config() error {
@@ -38,9 +60,42 @@ func (i *impl) Config() error {
 	i.col = db.Collection(i.Name())
 	i.log = zap.L().Named(i.Name())
 
+	jc := db.Collection("namespace_delete_job")
+	jobIndexs := []mongo.IndexModel{
+		{
+			Keys: bsonx.Doc{{Key: "create_at", Value: bsonx.Int32(-1)}},
+		},
+		{
+			Keys: bsonx.Doc{{Key: "namespace_id", Value: bsonx.Int32(-1)}},
+		},
+		{
+			// 同一个空间同时只能有一个未完成的删除任务
+			Keys: bsonx.Doc{{Key: "namespace_id", Value: bsonx.Int32(1)}},
+			Options: options.Index().SetName("uniq_active_namespace_job").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": bson.M{"$lte": namespace.DELETE_JOB_STATUS_RUNNING}}),
+		},
+	}
+	if _, err := jc.Indexes().CreateMany(context.Background(), jobIndexs); err != nil {
+		return err
+	}
+	i.job = jc
+
 	i.role = app.GetInternalApp(role.AppName).(role.Service)
 	i.policy = app.GetInternalApp(policy.AppName).(policy.Service)
 	i.counter = app.GetInternalApp(counter.AppName).(counter.Service)
+	i.domain = app.GetInternalApp(domain.AppName).(domain.Service)
+	i.user = app.GetInternalApp(user.AppName).(user.Service)
+	// 先让令牌退出空间, 最后删除策略
+	i.resources = []*resource{
+		{"token", app.GetInternalApp(token.AppName).(namespace.Resource)},
+		{"instance", app.GetInternalApp(instance.AppName).(instance.Service)},
+		{"service", app.GetInternalApp(meta.AppName).(meta.MetaService)},
+		{"policy", i.policy},
+	}
+
+	// 执行删除任务的副本退出后, 未完成的任务标记为失败
+	i.runner = lease.NewJobRunner(app.GetInternalApp(lease.AppName).(lease.Service), "namespace_delete_job")
+	go i.runner.Recover(i.activeJobs, i.interruptJob)
 	return nil
 }
 
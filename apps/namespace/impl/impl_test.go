@@ -46,6 +46,15 @@ func TestDescribeNamespace(t *testing.T) {
 	t.Log(r)
 }
 
+func TestQueryInheritedNamespace(t *testing.T) {
+	req := namespace.NewDescriptNamespaceRequest(domain.DEFAULT_DOMAIN, namespace.DEFAULT_NAMESPACE)
+	r, err := impl.QueryInheritedNamespace(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
 func init() {
 	tools.DevelopmentSetup()
 	impl = app.GetInternalApp(namespace.AppName).(namespace.Service)
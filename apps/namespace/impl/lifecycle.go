@@ -0,0 +1,409 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/types/ftime"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/infraboard/mcenter/apps/namespace"
+)
+
+// 归档空间, 归档后只读
+func (s *impl) ArchiveNamespace(ctx context.Context, req *namespace.ArchiveNamespaceRequest) (
+	*namespace.Namespace, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+	if req.Name == namespace.DEFAULT_NAMESPACE {
+		return nil, exception.NewBadRequest("default namespace can not be archived")
+	}
+
+	ins, err := s.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(req.Domain, req.Name))
+	if err != nil {
+		return nil, err
+	}
+	if err := ins.CheckWritable(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins.ChangeState(namespace.NAMESPACE_STATE_ARCHIVED, req.Operator, req.Reason)
+	if err := s.updateStatus(ctx, ins); err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
+
+// 恢复已归档的空间, 删除中的空间不能恢复
+func (s *impl) RestoreNamespace(ctx context.Context, req *namespace.RestoreNamespaceRequest) (
+	*namespace.Namespace, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(req.Domain, req.Name))
+	if err != nil {
+		return nil, err
+	}
+	if !ins.State().Equal(namespace.NAMESPACE_STATE_ARCHIVED) {
+		return nil, exception.NewBadRequest("namespace %s is not archived", ins.Spec.Name)
+	}
+
+	ins.ChangeState(namespace.NAMESPACE_STATE_ACTIVE, req.Operator, "restore")
+	if err := s.updateStatus(ctx, ins); err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
+
+func (s *impl) CheckDeleteNamespace(ctx context.Context, req *namespace.DescriptNamespaceRequest) (
+	*namespace.DeleteCheckResult, error) {
+	ins, err := s.DescribeNamespace(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return s.checkDelete(ctx, ins)
+}
+
+// 子空间和空间下的资源都会阻止删除, 负责人的内置策略除外
+func (s *impl) checkDelete(ctx context.Context, ins *namespace.Namespace) (*namespace.DeleteCheckResult, error) {
+	result := namespace.NewDeleteCheckResult(ins.Spec.Name)
+
+	subs, err := s.countSubNamespace(ctx, ins)
+	if err != nil {
+		return nil, err
+	}
+	result.AddBlocker("namespace", subs)
+
+	for _, r := range s.resources {
+		resp, err := r.CountNamespaceResource(ctx, namespace.NewCountNamespaceResourceRequest(ins.Spec.Domain, ins.Spec.Name))
+		if err != nil {
+			return nil, err
+		}
+		result.AddBlocker(r.name, resp.Count)
+	}
+	return result, nil
+}
+
+func (s *impl) countSubNamespace(ctx context.Context, ins *namespace.Namespace) (int64, error) {
+	count, err := s.col.CountDocuments(ctx, bson.M{"spec.parent_id": ins.Id})
+	if err != nil {
+		return 0, exception.NewInternalServerError("count namespace %s sub namespace error, %s", ins.Spec.Name, err)
+	}
+	return count, nil
+}
+
+// 删除空间, 空间下还有资源时不允许删除
+func (s *impl) DeleteNamespace(ctx context.Context, req *namespace.DeleteNamespaceRequest) (*namespace.Namespace, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+	if req.Name == namespace.DEFAULT_NAMESPACE {
+		return nil, exception.NewBadRequest("default namespace can not be deleted")
+	}
+
+	ins, err := s.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(req.Domain, req.Name))
+	if err != nil {
+		return nil, err
+	}
+	if ins.State().Equal(namespace.NAMESPACE_STATE_DELETING) {
+		return nil, exception.NewBadRequest("namespace %s is deleting", ins.Spec.Name)
+	}
+
+	result, err := s.checkDelete(ctx, ins)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Deletable {
+		return nil, exception.NewBadRequest("namespace %s still has %s, remove them first or delete it with force",
+			ins.Spec.Name, result.BlockerMessage())
+	}
+
+	// 只剩负责人的内置策略, 先删除策略再删除空间, 避免留下无主的策略
+	if _, err := s.policy.DeleteNamespaceResource(ctx,
+		namespace.NewDeleteNamespaceResourceRequest(ins.Spec.Domain, ins.Spec.Name)); err != nil {
+		return nil, err
+	}
+	if _, err := s.col.DeleteOne(ctx, bson.M{"_id": ins.Id}); err != nil {
+		return nil, exception.NewInternalServerError("delete namespace(%s) error, %s", ins.Spec.Name, err)
+	}
+
+	return ins, nil
+}
+
+// 级联删除空间, 空间进入删除中状态, 资源在后台按照顺序删除
+func (s *impl) CascadeDeleteNamespace(ctx context.Context, req *namespace.DeleteNamespaceRequest) (
+	*namespace.DeleteJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+	if req.Name == namespace.DEFAULT_NAMESPACE {
+		return nil, exception.NewBadRequest("default namespace can not be deleted")
+	}
+
+	ins, err := s.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(req.Domain, req.Name))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNoRunningJob(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	// 子空间需要先删除或者移走, 不随父空间级联删除
+	subs, err := s.countSubNamespace(ctx, ins)
+	if err != nil {
+		return nil, err
+	}
+	if subs > 0 {
+		return nil, exception.NewBadRequest("namespace %s still has %d sub namespace, delete or move them first",
+			ins.Spec.Name, subs)
+	}
+
+	resources := make([]string, 0, len(s.resources))
+	for _, r := range s.resources {
+		resources = append(resources, r.name)
+	}
+	job := namespace.NewDeleteJob(ins, req.Operator, resources)
+	err = s.runner.Start(ctx, job.Id, func(ctx context.Context) error {
+		if _, err := s.job.InsertOne(ctx, job); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return exception.NewConflict("namespace %s is deleting", ins.Spec.Name)
+			}
+			return exception.NewInternalServerError("insert namespace delete job error, %s", err)
+		}
+		// 任务保存成功后空间才进入删除中状态, 更新失败时删除任务, 空间保持原来的状态
+		ins.ChangeState(namespace.NAMESPACE_STATE_DELETING, req.Operator, "cascade delete")
+		if err := s.updateStatus(ctx, ins); err != nil {
+			if _, derr := s.job.DeleteOne(ctx, bson.M{"_id": job.Id}); derr != nil {
+				s.log.Errorf("delete namespace delete job %s error, %s", job.Id, derr)
+			}
+			return err
+		}
+		return nil
+	}, func(ctx context.Context) {
+		s.runDeleteJob(ctx, job)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (s *impl) QueryDeleteJob(ctx context.Context, req *namespace.QueryDeleteJobRequest) (
+	*namespace.DeleteJobSet, error) {
+	filter := bson.M{}
+	if req.Domain != "" {
+		filter["domain"] = req.Domain
+	}
+	if req.Namespace != "" {
+		filter["namespace"] = req.Namespace
+	}
+
+	pageSize := int64(req.Page.PageSize)
+	skip := req.Page.ComputeOffset()
+	opt := &options.FindOptions{
+		Sort:  bson.D{{Key: "create_at", Value: -1}},
+		Limit: &pageSize,
+		Skip:  &skip,
+	}
+	resp, err := s.job.Find(ctx, filter, opt)
+	if err != nil {
+		return nil, exception.NewInternalServerError("find namespace delete job error, %s", err)
+	}
+
+	set := namespace.NewDeleteJobSet()
+	for resp.Next(ctx) {
+		ins := &namespace.DeleteJob{}
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode namespace delete job error, %s", err)
+		}
+		set.Add(ins)
+	}
+
+	count, err := s.job.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, exception.NewInternalServerError("get namespace delete job count error, %s", err)
+	}
+	set.Total = count
+	return set, nil
+}
+
+func (s *impl) DescribeDeleteJob(ctx context.Context, req *namespace.DescribeDeleteJobRequest) (
+	*namespace.DeleteJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins := &namespace.DeleteJob{}
+	if err := s.job.FindOne(ctx, bson.M{"_id": req.Id}).Decode(ins); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, exception.NewNotFound("namespace delete job %s not found", req.Id)
+		}
+		return nil, exception.NewInternalServerError("find namespace delete job %s error, %s", req.Id, err)
+	}
+	return ins, nil
+}
+
+// 继续执行失败的删除任务, 每类资源的删除都是幂等的
+func (s *impl) ResumeDeleteJob(ctx context.Context, req *namespace.DescribeDeleteJobRequest) (
+	*namespace.DeleteJob, error) {
+	job, err := s.DescribeDeleteJob(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if !job.Status.Equal(namespace.DELETE_JOB_STATUS_FAILED) {
+		return nil, exception.NewBadRequest("namespace delete job %s is not failed", job.Id)
+	}
+
+	// 任务的租约被占用时说明已经在其他请求中继续执行
+	job.Resume()
+	err = s.runner.Start(ctx, job.Id, func(ctx context.Context) error {
+		rs, err := s.job.ReplaceOne(ctx, bson.M{"_id": job.Id, "status": namespace.DELETE_JOB_STATUS_FAILED}, job)
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return exception.NewConflict("namespace %s is deleting", job.Namespace)
+			}
+			return exception.NewInternalServerError("update namespace delete job %s error, %s", job.Id, err)
+		}
+		if rs.MatchedCount == 0 {
+			return exception.NewConflict("namespace delete job %s is not failed", job.Id)
+		}
+		return nil
+	}, func(ctx context.Context) {
+		s.runDeleteJob(ctx, job)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// 按照顺序删除空间下的资源, 已经完成的步骤跳过, 每完成一类资源更新一次进度, 全部完成后删除空间
+// 租约续约失败时ctx被取消
+func (s *impl) runDeleteJob(ctx context.Context, job *namespace.DeleteJob) {
+	job.Status = namespace.DELETE_JOB_STATUS_RUNNING
+	if err := s.updateJob(ctx, job); err != nil {
+		s.log.Errorf("update namespace delete job %s error, %s", job.Id, err)
+		return
+	}
+
+	for _, step := range job.Steps {
+		if step.Status.Equal(namespace.DELETE_JOB_STATUS_SUCCEEDED) {
+			continue
+		}
+
+		r := s.getResource(step.Resource)
+		if r == nil {
+			job.Failed("unknown resource %s", step.Resource)
+			break
+		}
+
+		step.Status = namespace.DELETE_JOB_STATUS_RUNNING
+		if err := s.updateJob(ctx, job); err != nil {
+			s.log.Errorf("update namespace delete job %s error, %s", job.Id, err)
+		}
+
+		resp, err := r.DeleteNamespaceResource(ctx, namespace.NewDeleteNamespaceResourceRequest(job.Domain, job.Namespace))
+		if err != nil {
+			step.Failed(err)
+			job.Failed("delete %s error, %s", r.name, err)
+			s.log.Errorf("delete namespace %s %s error, %s", job.Namespace, r.name, err)
+			break
+		}
+		step.Succeed(resp.Deleted)
+	}
+
+	if !job.IsFinished() {
+		if _, err := s.col.DeleteOne(ctx, bson.M{"_id": job.NamespaceId}); err != nil {
+			job.Failed("delete namespace error, %s", err)
+		} else {
+			job.Succeed()
+			s.log.Infof("namespace %s deleted by %s", job.Namespace, job.Operator)
+		}
+	}
+
+	if err := s.updateJob(ctx, job); err != nil {
+		s.log.Errorf("update namespace delete job %s error, %s", job.Id, err)
+	}
+}
+
+func (s *impl) getResource(name string) *resource {
+	for _, r := range s.resources {
+		if r.name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+func (s *impl) updateJob(ctx context.Context, job *namespace.DeleteJob) error {
+	if _, err := s.job.ReplaceOne(ctx, bson.M{"_id": job.Id}, job); err != nil {
+		return exception.NewInternalServerError("update namespace delete job %s error, %s", job.Id, err)
+	}
+	return nil
+}
+
+// 未完成的删除任务
+func (s *impl) activeJobs(ctx context.Context) ([]string, error) {
+	resp, err := s.job.Find(ctx, bson.M{"status": bson.M{"$lte": namespace.DELETE_JOB_STATUS_RUNNING}})
+	if err != nil {
+		return nil, exception.NewInternalServerError("find active namespace delete job error, %s", err)
+	}
+
+	ids := []string{}
+	for resp.Next(ctx) {
+		job := &namespace.DeleteJob{}
+		if err := resp.Decode(job); err != nil {
+			return nil, exception.NewInternalServerError("decode namespace delete job error, %s", err)
+		}
+		ids = append(ids, job.Id)
+	}
+	return ids, nil
+}
+
+// 执行删除任务的副本已经退出, 任务标记为失败, 通过继续执行接口从中断的步骤继续
+func (s *impl) interruptJob(ctx context.Context, id string) error {
+	_, err := s.job.UpdateOne(ctx,
+		bson.M{"_id": id, "status": bson.M{"$lte": namespace.DELETE_JOB_STATUS_RUNNING}},
+		bson.M{"$set": bson.M{
+			"status":      namespace.DELETE_JOB_STATUS_FAILED,
+			"finished_at": time.Now().UnixMilli(),
+			"message":     "interrupted, the replica running it exited",
+		}},
+	)
+	if err != nil {
+		return exception.NewInternalServerError("interrupt namespace delete job %s error, %s", id, err)
+	}
+	s.log.Warnf("namespace delete job %s interrupted", id)
+	return nil
+}
+
+func (s *impl) checkNoRunningJob(ctx context.Context, ins *namespace.Namespace) error {
+	running, err := s.job.CountDocuments(ctx, bson.M{
+		"namespace_id": ins.Id,
+		"status":       bson.M{"$in": []namespace.DELETE_JOB_STATUS{namespace.DELETE_JOB_STATUS_PENDING, namespace.DELETE_JOB_STATUS_RUNNING}},
+	})
+	if err != nil {
+		return exception.NewInternalServerError("count namespace delete job error, %s", err)
+	}
+	if running > 0 {
+		return exception.NewConflict("namespace %s is deleting", ins.Spec.Name)
+	}
+	return nil
+}
+
+func (s *impl) updateStatus(ctx context.Context, ins *namespace.Namespace) error {
+	ins.UpdateAt = ftime.Now().Timestamp()
+	_, err := s.col.UpdateOne(ctx, bson.M{"_id": ins.Id}, bson.M{"$set": bson.M{
+		"status":    ins.Status,
+		"update_at": ins.UpdateAt,
+	}})
+	if err != nil {
+		return exception.NewInternalServerError("update namespace(%s) status error, %s", ins.Spec.Name, err)
+	}
+	return nil
+}
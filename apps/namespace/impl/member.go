@@ -0,0 +1,269 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/http/request"
+	"github.com/infraboard/mcube/types/ftime"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/infraboard/mcenter/apps/namespace"
+	"github.com/infraboard/mcenter/apps/policy"
+	"github.com/infraboard/mcenter/apps/role"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+func (s *impl) QueryMember(ctx context.Context, req *namespace.QueryMemberRequest) (
+	*namespace.MemberSet, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ns, err := s.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(req.Domain, req.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	query := policy.NewQueryPolicyRequest()
+	query.Page = request.NewPageRequest(namespace.MAX_MEMBER_POLICY, 1)
+	query.Domain = req.Domain
+	query.Namespace = req.Namespace
+	query.WithRole = true
+	ps, err := s.policy.QueryPolicy(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMemberSet(ns, ps), nil
+}
+
+func (s *impl) AddMember(ctx context.Context, req *namespace.AddMemberRequest) (
+	*namespace.MemberSet, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ns, err := s.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(req.Domain, req.Namespace))
+	if err != nil {
+		return nil, err
+	}
+	if err := ns.CheckWritable(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ps := policy.NewPolicySet()
+	for _, roleId := range req.RoleIds {
+		for _, username := range req.Usernames {
+			p, err := s.addMemberPolicy(ctx, req, username, "", roleId)
+			if err != nil {
+				return nil, err
+			}
+			ps.Add(p)
+		}
+		for _, group := range req.Groups {
+			p, err := s.addMemberPolicy(ctx, req, "", group, roleId)
+			if err != nil {
+				return nil, err
+			}
+			ps.Add(p)
+		}
+	}
+
+	return newMemberSet(ns, ps), nil
+}
+
+// 成员已经有该角色时直接返回已有的策略
+func (s *impl) addMemberPolicy(ctx context.Context, req *namespace.AddMemberRequest, username, group, roleId string) (
+	*policy.Policy, error) {
+	pReq := policy.NewCreatePolicyRequest()
+	pReq.CreateBy = req.CreateBy
+	pReq.Domain = req.Domain
+	pReq.Namespace = req.Namespace
+	pReq.Username = username
+	pReq.Group = group
+	pReq.RoleId = roleId
+	pReq.ExpiredTime = req.ExpiredTime
+	pReq.Type = policy.PolicyType_CUSTOM
+
+	p, err := policy.New(pReq)
+	if err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+	exist, err := s.policy.DescribePolicy(ctx, &policy.DescribePolicyRequest{Id: p.Id})
+	if err == nil {
+		return exist, nil
+	}
+	if !exception.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	return s.policy.CreatePolicy(ctx, pReq)
+}
+
+func (s *impl) RemoveMember(ctx context.Context, req *namespace.RemoveMemberRequest) (
+	*namespace.MemberSet, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ns, err := s.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(req.Domain, req.Namespace))
+	if err != nil {
+		return nil, err
+	}
+	if err := ns.CheckWritable(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	query := policy.NewQueryPolicyRequest()
+	query.Page = request.NewPageRequest(namespace.MAX_MEMBER_POLICY, 1)
+	query.Domain = req.Domain
+	query.Namespace = req.Namespace
+	query.Username = req.Username
+	query.Group = req.Group
+	ps, err := s.policy.QueryPolicy(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := policy.NewPolicySet()
+	for _, p := range ps.Items {
+		// 按用户移除时不包含用户组的策略
+		if p.Spec.Username != req.Username || p.Spec.Group != req.Group || !req.HasRole(p.Spec.RoleId) {
+			continue
+		}
+		// 空间必须保留负责人
+		if p.Spec.Type == policy.PolicyType_BUILD_IN {
+			return nil, exception.NewBadRequest("%s is the owner of namespace %s, transfer the owner first",
+				p.Spec.Username, ns.Spec.Name)
+		}
+		removed.Add(p)
+	}
+
+	for _, p := range removed.Items {
+		if _, err := s.policy.DeletePolicy(ctx, policy.NewDeletePolicyRequestWithID(p.Id)); err != nil {
+			return nil, err
+		}
+	}
+
+	return newMemberSet(ns, removed), nil
+}
+
+func (s *impl) TransferOwner(ctx context.Context, req *namespace.TransferOwnerRequest) (
+	*namespace.Namespace, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ns, err := s.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(req.Domain, req.Namespace))
+	if err != nil {
+		return nil, err
+	}
+	if err := ns.CheckWritable(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+	if ns.Spec.Owner == req.Owner {
+		return ns, nil
+	}
+
+	// 新负责人必须是该域的用户, 或者是从其他域加入该域的成员
+	_, err = s.user.DescribeUser(ctx, user.NewDescriptDomainMemberRequest(ns.Spec.Domain, req.Owner))
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := s.role.DescribeRole(ctx, role.NewDescribeRoleRequestWithName(role.ADMIN_ROLE_NAME))
+	if err != nil {
+		return nil, err
+	}
+
+	// 先授予新负责人内置的管理员策略, 再修改负责人, 保证空间始终有负责人
+	if err := s.setOwnerPolicy(ctx, ns, req.Owner, admin.Id, policy.PolicyType_BUILD_IN, req.Operator); err != nil {
+		return nil, err
+	}
+
+	oldOwner := ns.Spec.Owner
+	ns.Spec.Owner = req.Owner
+	ns.UpdateAt = ftime.Now().Timestamp()
+	if _, err := s.col.UpdateOne(ctx, bson.M{"_id": ns.Id}, bson.M{"$set": bson.M{
+		"spec.owner": ns.Spec.Owner,
+		"update_at":  ns.UpdateAt,
+	}}); err != nil {
+		return nil, exception.NewInternalServerError("update namespace(%s) owner error, %s", ns.Spec.Name, err)
+	}
+
+	// 原负责人保留管理员角色, 作为普通成员可以被移除或者主动离开
+	if oldOwner != "" {
+		if err := s.setOwnerPolicy(ctx, ns, oldOwner, admin.Id, policy.PolicyType_CUSTOM, req.Operator); err != nil {
+			return nil, err
+		}
+	}
+
+	return ns, nil
+}
+
+// 设置用户管理员策略的类型, 策略Id由空间, 用户和角色决定, 类型变化时重新创建
+func (s *impl) setOwnerPolicy(ctx context.Context, ns *namespace.Namespace, username, roleId string,
+	t policy.PolicyType, operator string) error {
+	pReq := policy.NewCreatePolicyRequest()
+	pReq.CreateBy = operator
+	pReq.Domain = ns.Spec.Domain
+	pReq.Namespace = ns.Spec.Name
+	pReq.Username = username
+	pReq.RoleId = roleId
+	pReq.Type = t
+
+	p, err := policy.New(pReq)
+	if err != nil {
+		return exception.NewBadRequest(err.Error())
+	}
+	exist, err := s.policy.DescribePolicy(ctx, &policy.DescribePolicyRequest{Id: p.Id})
+	switch {
+	case err == nil:
+		if exist.Spec.Type == t {
+			return nil
+		}
+		if _, err := s.policy.DeletePolicy(ctx, policy.NewDeletePolicyRequestWithID(exist.Id)); err != nil {
+			return err
+		}
+	case !exception.IsNotFoundError(err):
+		return err
+	}
+
+	_, err = s.policy.CreatePolicy(ctx, pReq)
+	return err
+}
+
+func (s *impl) LeaveNamespace(ctx context.Context, req *namespace.LeaveNamespaceRequest) (
+	*namespace.MemberSet, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	// 通过用户组获得的权限需要从用户组中移除
+	rReq := namespace.NewRemoveMemberRequest(req.Domain, req.Namespace)
+	rReq.Username = req.Username
+	return s.RemoveMember(ctx, rReq)
+}
+
+func newMemberSet(ns *namespace.Namespace, ps *policy.PolicySet) *namespace.MemberSet {
+	set := namespace.NewMemberSet()
+	for _, p := range ps.Items {
+		m := set.GetOrAdd(p.Spec.Username, p.Spec.Group)
+		if p.Spec.Username != "" && p.Spec.Username == ns.Spec.Owner {
+			m.IsOwner = true
+		}
+
+		mr := &namespace.MemberRole{
+			PolicyId:    p.Id,
+			RoleId:      p.Spec.RoleId,
+			ExpiredTime: p.Spec.ExpiredTime,
+			BuildIn:     p.Spec.Type == policy.PolicyType_BUILD_IN,
+		}
+		if p.Role != nil && p.Role.Spec != nil {
+			mr.RoleName = p.Role.Spec.Name
+		}
+		m.Roles = append(m.Roles, mr)
+	}
+	return set
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/infraboard/mcube/http/request"
 	"github.com/infraboard/mcube/types/ftime"
 	"github.com/rs/xid"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 
+	"github.com/infraboard/mcenter/apps/domain"
 	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/policy"
 	"github.com/infraboard/mcenter/apps/role"
@@ -22,6 +24,16 @@ func (s *impl) CreateNamespace(ctx context.Context, req *namespace.CreateNamespa
 		return nil, err
 	}
 
+	// 检查域的空间配额
+	if err := s.checkQuota(ctx, ins.Spec.Domain); err != nil {
+		return nil, err
+	}
+
+	// 子空间记录祖先空间, 用于查询子树和策略继承
+	if err := s.setAncestors(ctx, ins); err != nil {
+		return nil, err
+	}
+
 	if req.ParentId != "" {
 		c, err := s.counter.GetNextSequenceValue(req.ParentId)
 		if err != nil {
@@ -49,7 +61,8 @@ func (s *impl) updateNamespacePolicy(ctx context.Context, ns *namespace.Namespac
 		return err
 	}
 	pReq := policy.NewCreatePolicyRequest()
-	pReq.Namespace = ns.Id
+	pReq.Domain = ns.Spec.Domain
+	pReq.Namespace = ns.Spec.Name
 	pReq.RoleId = r.Id
 	pReq.Username = ns.Spec.Owner
 	pReq.Type = policy.PolicyType_BUILD_IN
@@ -70,6 +83,7 @@ func (s *impl) QueryNamespace(ctx context.Context, req *namespace.QueryNamespace
 		qp.Page = request.NewPageRequest(policy.MAX_USER_POLICY, 1)
 		qp.Domain = req.Domain
 		qp.Username = req.Username
+		qp.WithGroup = true
 		ps, err := s.policy.QueryPolicy(ctx, qp)
 		if err != nil {
 			return nil, err
@@ -126,43 +140,59 @@ func (s *impl) DescribeNamespace(ctx context.Context, req *namespace.DescriptNam
 	return ins, nil
 }
 
-func (s *impl) DeleteNamespace(ctx context.Context, req *namespace.DeleteNamespaceRequest) (*namespace.Namespace, error) {
-	ns, err := s.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(req.Domain, req.Name))
-	if err != nil {
-		return nil, err
+// NewNamespace todo
+func (s *impl) newNamespace(ctx context.Context, req *namespace.CreateNamespaceRequest) (*namespace.Namespace, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
 	}
 
-	r, err := newDeleteRequest(req)
-	if err != nil {
-		return nil, err
+	ins := &namespace.Namespace{
+		Id:       xid.New().String(),
+		CreateAt: ftime.Now().Timestamp(),
+		UpdateAt: ftime.Now().Timestamp(),
+		Spec:     req,
 	}
 
-	_, err = s.col.DeleteOne(ctx, r.FindFilter())
-	if err != nil {
-		return nil, exception.NewInternalServerError("delete namespace(%s) error, %s", req.Name, err)
+	return ins, nil
+}
+
+// 删除域下所有的空间, 空间关联的策略由策略模块按域清除
+func (s *impl) DeleteDomainResource(ctx context.Context, req *domain.DeleteDomainResourceRequest) (
+	*domain.DeleteDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
 	}
 
-	// 清除空间管理的所有策略
-	_, err = s.policy.DeletePolicy(ctx, policy.NewDeletePolicyRequestWithNamespace(req.Domain, req.Name))
+	rs, err := s.col.DeleteMany(ctx, bson.M{"spec.domain": req.Domain})
 	if err != nil {
-		s.log.Errorf("delete namespace policy error, %s", err)
+		return nil, exception.NewInternalServerError("delete domain %s namespace error, %s", req.Domain, err)
 	}
-
-	return ns, nil
+	return domain.NewDeleteDomainResourceResponse(rs.DeletedCount), nil
 }
 
-// NewNamespace todo
-func (s *impl) newNamespace(ctx context.Context, req *namespace.CreateNamespaceRequest) (*namespace.Namespace, error) {
+// 统计域下的空间数量, 用于配额检查
+func (s *impl) CountDomainResource(ctx context.Context, req *domain.CountDomainResourceRequest) (
+	*domain.CountDomainResourceResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, exception.NewBadRequest(err.Error())
 	}
 
-	ins := &namespace.Namespace{
-		Id:       xid.New().String(),
-		CreateAt: ftime.Now().Timestamp(),
-		UpdateAt: ftime.Now().Timestamp(),
-		Spec:     req,
+	count, err := s.col.CountDocuments(ctx, bson.M{"spec.domain": req.Domain})
+	if err != nil {
+		return nil, exception.NewInternalServerError("count domain %s namespace error, %s", req.Domain, err)
 	}
+	return domain.NewCountDomainResourceResponse(count), nil
+}
 
-	return ins, nil
+func (s *impl) checkQuota(ctx context.Context, domainName string) error {
+	if domainName == "" {
+		return nil
+	}
+
+	resp, err := s.CountDomainResource(ctx, domain.NewCountDomainResourceRequest(domainName))
+	if err != nil {
+		return err
+	}
+	_, err = s.domain.CheckQuota(ctx, domain.NewCheckQuotaRequest(domainName, domain.QUOTA_RESOURCE_NAMESPACE, resp.Count))
+	return err
 }
@@ -46,9 +46,28 @@ func (r *queryNamespaceRequest) FindOptions() *options.FindOptions {
 func (r *queryNamespaceRequest) FindFilter() bson.M {
 	filter := bson.M{}
 
+	if r.Domain != "" {
+		filter["spec.domain"] = r.Domain
+	}
 	if len(r.namespaces) > 0 {
 		filter["name"] = bson.M{"$in": r.namespaces}
 	}
+	if len(r.Ids) > 0 {
+		filter["_id"] = bson.M{"$in": r.Ids}
+	}
+	if !r.WithArchived {
+		filter["status.state"] = bson.M{"$nin": []namespace.NAMESPACE_STATE{
+			namespace.NAMESPACE_STATE_ARCHIVED,
+			namespace.NAMESPACE_STATE_DELETING,
+		}}
+	}
+	if r.ParentId != "" {
+		if r.WithSub {
+			filter["ancestors"] = r.ParentId
+		} else {
+			filter["spec.parent_id"] = r.ParentId
+		}
+	}
 
 	return filter
 }
@@ -75,26 +94,3 @@ func (r *describeNamespaceRequest) FindFilter() bson.M {
 
 	return filter
 }
-
-func newDeleteRequest(req *namespace.DeleteNamespaceRequest) (*deleteNamespaceRequest, error) {
-	if err := req.Validate(); err != nil {
-		return nil, exception.NewBadRequest(err.Error())
-	}
-
-	return &deleteNamespaceRequest{
-		DeleteNamespaceRequest: req,
-	}, nil
-}
-
-type deleteNamespaceRequest struct {
-	*namespace.DeleteNamespaceRequest
-}
-
-func (r *deleteNamespaceRequest) FindFilter() bson.M {
-	filter := bson.M{
-		"domain": r.Domain,
-		"_id":    r.Name,
-	}
-
-	return filter
-}
@@ -0,0 +1,195 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/http/request"
+	"github.com/infraboard/mcube/types/ftime"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/infraboard/mcenter/apps/namespace"
+)
+
+func (s *impl) MoveNamespace(ctx context.Context, req *namespace.MoveNamespaceRequest) (
+	*namespace.Namespace, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(req.Domain, req.Name))
+	if err != nil {
+		return nil, err
+	}
+	if err := ins.CheckWritable(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	subs, err := s.queryDescendants(ctx, ins)
+	if err != nil {
+		return nil, err
+	}
+
+	oldPrefix := ins.ChildAncestors()
+	ins.Spec.ParentId = req.ParentId
+	if err := s.setAncestors(ctx, ins); err != nil {
+		return nil, err
+	}
+	newPrefix := ins.ChildAncestors()
+
+	// 移动后子树的嵌套层级也不能超过限制
+	for _, sub := range subs {
+		if len(newPrefix)+len(sub.Ancestors)-len(oldPrefix) >= namespace.MAX_NAMESPACE_DEPTH {
+			return nil, exception.NewBadRequest("namespace nested depth large than %d", namespace.MAX_NAMESPACE_DEPTH)
+		}
+	}
+
+	ins.UpdateAt = ftime.Now().Timestamp()
+	if _, err := s.col.UpdateOne(ctx, bson.M{"_id": ins.Id}, bson.M{"$set": bson.M{
+		"spec.parent_id": ins.Spec.ParentId,
+		"ancestors":      ins.Ancestors,
+		"update_at":      ins.UpdateAt,
+	}}); err != nil {
+		return nil, exception.NewInternalServerError("move namespace(%s) error, %s", ins.Spec.Name, err)
+	}
+
+	// 子空间的祖先替换为新的路径
+	for _, sub := range subs {
+		ancestors := append(append([]string{}, newPrefix...), sub.Ancestors[len(oldPrefix):]...)
+		if _, err := s.col.UpdateOne(ctx, bson.M{"_id": sub.Id}, bson.M{"$set": bson.M{
+			"ancestors": ancestors,
+		}}); err != nil {
+			return nil, exception.NewInternalServerError("move namespace(%s) error, %s", sub.Spec.Name, err)
+		}
+	}
+
+	return ins, nil
+}
+
+func (s *impl) SetInheritance(ctx context.Context, req *namespace.SetInheritanceRequest) (
+	*namespace.Namespace, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(req.Domain, req.Name))
+	if err != nil {
+		return nil, err
+	}
+	if err := ins.CheckWritable(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins.Spec.BlockInheritance = req.BlockInheritance
+	ins.UpdateAt = ftime.Now().Timestamp()
+	if _, err := s.col.UpdateOne(ctx, bson.M{"_id": ins.Id}, bson.M{"$set": bson.M{
+		"spec.block_inheritance": ins.Spec.BlockInheritance,
+		"update_at":              ins.UpdateAt,
+	}}); err != nil {
+		return nil, exception.NewInternalServerError("update namespace(%s) error, %s", ins.Spec.Name, err)
+	}
+
+	return ins, nil
+}
+
+func (s *impl) QueryInheritedNamespace(ctx context.Context, req *namespace.DescriptNamespaceRequest) (
+	*namespace.NamespaceSet, error) {
+	ins, err := s.DescribeNamespace(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	set := namespace.NewNamespaceSet()
+	if ins.Spec.BlockInheritance || len(ins.Ancestors) == 0 {
+		return set, nil
+	}
+
+	query := namespace.NewQueryNamespaceRequest()
+	query.Page = request.NewPageRequest(uint(len(ins.Ancestors)), 1)
+	query.Domain = ins.Spec.Domain
+	query.Ids = ins.Ancestors
+	query.WithArchived = true
+	ancestors, err := s.QueryNamespace(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	nmap := map[string]*namespace.Namespace{}
+	for i := range ancestors.Items {
+		nmap[ancestors.Items[i].Id] = ancestors.Items[i]
+	}
+
+	// 从父空间逐层向上, 遇到阻断继承的空间后停止
+	for i := len(ins.Ancestors) - 1; i >= 0; i-- {
+		p, ok := nmap[ins.Ancestors[i]]
+		if !ok {
+			break
+		}
+		set.Add(p)
+		if p.Spec.BlockInheritance {
+			break
+		}
+	}
+
+	set.Total = int64(len(set.Items))
+	return set, nil
+}
+
+// 父空间必须是同一个域下的空间, 不能形成环, 并且嵌套层级不能超过限制
+func (s *impl) setAncestors(ctx context.Context, ins *namespace.Namespace) error {
+	ins.Ancestors = []string{}
+	if ins.Spec.ParentId == "" {
+		return nil
+	}
+
+	parent, err := s.describeNamespaceById(ctx, ins.Spec.Domain, ins.Spec.ParentId)
+	if err != nil {
+		if exception.IsNotFoundError(err) {
+			return exception.NewBadRequest("parent namespace %s not found in domain %s", ins.Spec.ParentId, ins.Spec.Domain)
+		}
+		return err
+	}
+	if err := parent.CheckWritable(); err != nil {
+		return exception.NewBadRequest(err.Error())
+	}
+	if parent.Id == ins.Id || parent.IsDescendantOf(ins.Id) {
+		return exception.NewBadRequest("namespace %s can't be nested in itself or its descendant", ins.Spec.Name)
+	}
+	if len(parent.Ancestors)+1 >= namespace.MAX_NAMESPACE_DEPTH {
+		return exception.NewBadRequest("namespace nested depth large than %d", namespace.MAX_NAMESPACE_DEPTH)
+	}
+
+	ins.Ancestors = parent.ChildAncestors()
+	return nil
+}
+
+func (s *impl) describeNamespaceById(ctx context.Context, domain, id string) (*namespace.Namespace, error) {
+	ins := namespace.NewDefaultNamespace()
+	if err := s.col.FindOne(ctx, bson.M{"_id": id, "spec.domain": domain}).Decode(ins); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, exception.NewNotFound("namespace %s not found", id)
+		}
+
+		return nil, exception.NewInternalServerError("find namespace %s error, %s", id, err)
+	}
+
+	return ins, nil
+}
+
+// 查询空间的所有后代空间
+func (s *impl) queryDescendants(ctx context.Context, ins *namespace.Namespace) ([]*namespace.Namespace, error) {
+	resp, err := s.col.Find(ctx, bson.M{"ancestors": ins.Id})
+	if err != nil {
+		return nil, exception.NewInternalServerError("find namespace %s descendants error, %s", ins.Spec.Name, err)
+	}
+
+	items := []*namespace.Namespace{}
+	for resp.Next(ctx) {
+		sub := namespace.NewDefaultNamespace()
+		if err := resp.Decode(sub); err != nil {
+			return nil, exception.NewInternalServerError("decode namespace error, error is %s", err)
+		}
+		items = append(items, sub)
+	}
+	return items, nil
+}
@@ -1,9 +1,63 @@
 package namespace
 
-import context "context"
+import (
+	context "context"
+
+	"github.com/infraboard/mcenter/apps/domain"
+)
 
 type Service interface {
 	CreateNamespace(context.Context, *CreateNamespaceRequest) (*Namespace, error)
 	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*Namespace, error)
+	// 移动空间, 子空间随之移动
+	MoveNamespace(context.Context, *MoveNamespaceRequest) (*Namespace, error)
+	// 设置空间是否阻断上级空间的策略继承
+	SetInheritance(context.Context, *SetInheritanceRequest) (*Namespace, error)
+	// 查询空间继承策略的上级空间, 从父空间向上直到阻断继承的空间为止
+	QueryInheritedNamespace(context.Context, *DescriptNamespaceRequest) (*NamespaceSet, error)
+	// 查询空间成员及其角色
+	QueryMember(context.Context, *QueryMemberRequest) (*MemberSet, error)
+	// 添加空间成员(用户或者用户组)
+	AddMember(context.Context, *AddMemberRequest) (*MemberSet, error)
+	// 移除空间成员的角色, 负责人不允许移除
+	RemoveMember(context.Context, *RemoveMemberRequest) (*MemberSet, error)
+	// 转移空间负责人, 原负责人保留管理员角色
+	TransferOwner(context.Context, *TransferOwnerRequest) (*Namespace, error)
+	// 用户主动离开空间
+	LeaveNamespace(context.Context, *LeaveNamespaceRequest) (*MemberSet, error)
+	// 归档空间, 归档后只读并且默认不在列表中显示
+	ArchiveNamespace(context.Context, *ArchiveNamespaceRequest) (*Namespace, error)
+	// 恢复已归档的空间
+	RestoreNamespace(context.Context, *RestoreNamespaceRequest) (*Namespace, error)
+	// 删除空间前检查依赖, 列出阻止删除的资源
+	CheckDeleteNamespace(context.Context, *DescriptNamespaceRequest) (*DeleteCheckResult, error)
+	// 级联删除空间和空间下的资源, 删除在后台执行, 通过删除任务查看进度
+	CascadeDeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteJob, error)
+	// 查询空间删除任务
+	QueryDeleteJob(context.Context, *QueryDeleteJobRequest) (*DeleteJobSet, error)
+	// 查询空间删除任务详情
+	DescribeDeleteJob(context.Context, *DescribeDeleteJobRequest) (*DeleteJob, error)
+	// 继续执行失败的删除任务, 已经完成的步骤不再执行
+	ResumeDeleteJob(context.Context, *DescribeDeleteJobRequest) (*DeleteJob, error)
+	// 删除域下所有的空间, 清除域时调用
+	DeleteDomainResource(context.Context, *domain.DeleteDomainResourceRequest) (*domain.DeleteDomainResourceResponse, error)
+	// 统计域下的空间数量, 用于配额检查
+	CountDomainResource(context.Context, *domain.CountDomainResourceRequest) (*domain.CountDomainResourceResponse, error)
 	RPCServer
 }
+
+// ResourceCounter 统计空间下某类资源的数量, 用于删除空间前的依赖检查, 由各个模块实现
+type ResourceCounter interface {
+	CountNamespaceResource(context.Context, *CountNamespaceResourceRequest) (*CountNamespaceResourceResponse, error)
+}
+
+// ResourceCleaner 级联删除空间时删除空间下的某类资源, 由各个模块实现
+type ResourceCleaner interface {
+	DeleteNamespaceResource(context.Context, *DeleteNamespaceResourceRequest) (*DeleteNamespaceResourceResponse, error)
+}
+
+// Resource 空间下的资源
+type Resource interface {
+	ResourceCounter
+	ResourceCleaner
+}
@@ -0,0 +1,227 @@
+package namespace
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/infraboard/mcube/http/request"
+	"github.com/rs/xid"
+)
+
+func NewNamespaceStatus() *NamespaceStatus {
+	return &NamespaceStatus{
+		State: NAMESPACE_STATE_ACTIVE,
+	}
+}
+
+// State 空间当前的状态, 老数据没有状态时视为正常
+func (n *Namespace) State() NAMESPACE_STATE {
+	if n.Status == nil {
+		return NAMESPACE_STATE_ACTIVE
+	}
+	return n.Status.State
+}
+
+// CheckWritable 已归档和删除中的空间是只读的
+func (n *Namespace) CheckWritable() error {
+	switch n.State() {
+	case NAMESPACE_STATE_ARCHIVED:
+		return fmt.Errorf("namespace %s is archived, restore it first", n.Spec.Name)
+	case NAMESPACE_STATE_DELETING:
+		return fmt.Errorf("namespace %s is deleting", n.Spec.Name)
+	}
+	return nil
+}
+
+// ChangeState 修改空间状态
+func (n *Namespace) ChangeState(state NAMESPACE_STATE, operator, reason string) {
+	if n.Status == nil {
+		n.Status = NewNamespaceStatus()
+	}
+	n.Status.State = state
+	n.Status.ChangeAt = time.Now().UnixMilli()
+	n.Status.Operator = operator
+	n.Status.Reason = reason
+}
+
+func NewArchiveNamespaceRequest(domain, name string) *ArchiveNamespaceRequest {
+	return &ArchiveNamespaceRequest{
+		Domain: domain,
+		Name:   name,
+	}
+}
+
+func (req *ArchiveNamespaceRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewRestoreNamespaceRequest(domain, name string) *RestoreNamespaceRequest {
+	return &RestoreNamespaceRequest{
+		Domain: domain,
+		Name:   name,
+	}
+}
+
+func (req *RestoreNamespaceRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDeleteCheckResult(namespace string) *DeleteCheckResult {
+	return &DeleteCheckResult{
+		Namespace: namespace,
+		Deletable: true,
+		Blockers:  []*ResourceBlocker{},
+	}
+}
+
+// AddBlocker 资源数量大于0时阻止删除
+func (r *DeleteCheckResult) AddBlocker(resource string, count int64) {
+	if count <= 0 {
+		return
+	}
+	r.Deletable = false
+	r.Blockers = append(r.Blockers, &ResourceBlocker{
+		Resource: resource,
+		Count:    count,
+	})
+}
+
+// BlockerMessage 阻止删除的资源说明
+func (r *DeleteCheckResult) BlockerMessage() string {
+	msg := ""
+	for i, b := range r.Blockers {
+		if i > 0 {
+			msg += ", "
+		}
+		msg += fmt.Sprintf("%d %s", b.Count, b.Resource)
+	}
+	return msg
+}
+
+func NewCountNamespaceResourceRequest(domain, namespace string) *CountNamespaceResourceRequest {
+	return &CountNamespaceResourceRequest{
+		Domain:    domain,
+		Namespace: namespace,
+	}
+}
+
+func (req *CountNamespaceResourceRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewCountNamespaceResourceResponse(count int64) *CountNamespaceResourceResponse {
+	return &CountNamespaceResourceResponse{
+		Count: count,
+	}
+}
+
+func NewDeleteNamespaceResourceRequest(domain, namespace string) *DeleteNamespaceResourceRequest {
+	return &DeleteNamespaceResourceRequest{
+		Domain:    domain,
+		Namespace: namespace,
+	}
+}
+
+func (req *DeleteNamespaceResourceRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDeleteNamespaceResourceResponse(deleted int64) *DeleteNamespaceResourceResponse {
+	return &DeleteNamespaceResourceResponse{
+		Deleted: deleted,
+	}
+}
+
+// NewDeleteJob 级联删除任务, resources为需要删除的资源, 按照顺序执行
+func NewDeleteJob(n *Namespace, operator string, resources []string) *DeleteJob {
+	job := &DeleteJob{
+		Id:          xid.New().String(),
+		CreateAt:    time.Now().UnixMilli(),
+		Domain:      n.Spec.Domain,
+		NamespaceId: n.Id,
+		Namespace:   n.Spec.Name,
+		Operator:    operator,
+		Status:      DELETE_JOB_STATUS_PENDING,
+		Steps:       []*DeleteStep{},
+	}
+	for _, r := range resources {
+		job.Steps = append(job.Steps, &DeleteStep{Resource: r})
+	}
+	return job
+}
+
+// IsFinished 任务是否已经结束
+func (j *DeleteJob) IsFinished() bool {
+	return j.Status.Equal(DELETE_JOB_STATUS_SUCCEEDED) || j.Status.Equal(DELETE_JOB_STATUS_FAILED)
+}
+
+// Succeed 任务执行成功
+func (j *DeleteJob) Succeed() {
+	j.Status = DELETE_JOB_STATUS_SUCCEEDED
+	j.FinishedAt = time.Now().UnixMilli()
+	j.Message = ""
+}
+
+// Failed 任务执行失败
+func (j *DeleteJob) Failed(format string, a ...interface{}) {
+	j.Status = DELETE_JOB_STATUS_FAILED
+	j.FinishedAt = time.Now().UnixMilli()
+	j.Message = fmt.Sprintf(format, a...)
+}
+
+// Resume 继续执行失败或者中断的任务, 已经完成的步骤不再执行
+func (j *DeleteJob) Resume() {
+	j.Status = DELETE_JOB_STATUS_PENDING
+	j.FinishedAt = 0
+	j.Message = ""
+}
+
+// Succeed 该类资源删除完成
+func (s *DeleteStep) Succeed(deleted int64) {
+	s.Status = DELETE_JOB_STATUS_SUCCEEDED
+	s.Deleted = deleted
+	s.FinishedAt = time.Now().UnixMilli()
+}
+
+// Failed 该类资源删除失败
+func (s *DeleteStep) Failed(err error) {
+	s.Status = DELETE_JOB_STATUS_FAILED
+	s.FinishedAt = time.Now().UnixMilli()
+	s.Message = err.Error()
+}
+
+func NewDeleteJobSet() *DeleteJobSet {
+	return &DeleteJobSet{
+		Items: []*DeleteJob{},
+	}
+}
+
+func (s *DeleteJobSet) Add(item *DeleteJob) {
+	s.Items = append(s.Items, item)
+}
+
+func NewQueryDeleteJobRequest() *QueryDeleteJobRequest {
+	return &QueryDeleteJobRequest{
+		Page: request.NewPageRequest(20, 1),
+	}
+}
+
+func NewQueryDeleteJobRequestFromHTTP(r *http.Request) *QueryDeleteJobRequest {
+	qs := r.URL.Query()
+	req := NewQueryDeleteJobRequest()
+	req.Page = request.NewPageRequestFromHTTP(r)
+	req.Domain = qs.Get("domain")
+	req.Namespace = qs.Get("namespace")
+	return req
+}
+
+func NewDescribeDeleteJobRequest(id string) *DescribeDeleteJobRequest {
+	return &DescribeDeleteJobRequest{
+		Id: id,
+	}
+}
+
+func (req *DescribeDeleteJobRequest) Validate() error {
+	return validate.Struct(req)
+}
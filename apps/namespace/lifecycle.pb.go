@@ -0,0 +1,1498 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: apps/namespace/pb/lifecycle.proto
+
+package namespace
+
+import (
+	request "github.com/infraboard/mcube/http/request"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 空间的生命周期状态
+type NAMESPACE_STATE int32
+
+const (
+	// 正常
+	NAMESPACE_STATE_ACTIVE NAMESPACE_STATE = 0
+	// 已归档, 只读并且默认不在列表中显示, 可以恢复
+	NAMESPACE_STATE_ARCHIVED NAMESPACE_STATE = 1
+	// 级联删除中, 删除任务完成后空间被删除
+	NAMESPACE_STATE_DELETING NAMESPACE_STATE = 2
+)
+
+// Enum value maps for NAMESPACE_STATE.
+var (
+	NAMESPACE_STATE_name = map[int32]string{
+		0: "ACTIVE",
+		1: "ARCHIVED",
+		2: "DELETING",
+	}
+	NAMESPACE_STATE_value = map[string]int32{
+		"ACTIVE":   0,
+		"ARCHIVED": 1,
+		"DELETING": 2,
+	}
+)
+
+func (x NAMESPACE_STATE) Enum() *NAMESPACE_STATE {
+	p := new(NAMESPACE_STATE)
+	*p = x
+	return p
+}
+
+func (x NAMESPACE_STATE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NAMESPACE_STATE) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_namespace_pb_lifecycle_proto_enumTypes[0].Descriptor()
+}
+
+func (NAMESPACE_STATE) Type() protoreflect.EnumType {
+	return &file_apps_namespace_pb_lifecycle_proto_enumTypes[0]
+}
+
+func (x NAMESPACE_STATE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NAMESPACE_STATE.Descriptor instead.
+func (NAMESPACE_STATE) EnumDescriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{0}
+}
+
+// 空间删除任务以及每个步骤的状态
+type DELETE_JOB_STATUS int32
+
+const (
+	// 等待执行
+	DELETE_JOB_STATUS_PENDING DELETE_JOB_STATUS = 0
+	// 执行中
+	DELETE_JOB_STATUS_RUNNING DELETE_JOB_STATUS = 1
+	// 执行成功
+	DELETE_JOB_STATUS_SUCCEEDED DELETE_JOB_STATUS = 2
+	// 执行失败
+	DELETE_JOB_STATUS_FAILED DELETE_JOB_STATUS = 3
+)
+
+// Enum value maps for DELETE_JOB_STATUS.
+var (
+	DELETE_JOB_STATUS_name = map[int32]string{
+		0: "PENDING",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	DELETE_JOB_STATUS_value = map[string]int32{
+		"PENDING":   0,
+		"RUNNING":   1,
+		"SUCCEEDED": 2,
+		"FAILED":    3,
+	}
+)
+
+func (x DELETE_JOB_STATUS) Enum() *DELETE_JOB_STATUS {
+	p := new(DELETE_JOB_STATUS)
+	*p = x
+	return p
+}
+
+func (x DELETE_JOB_STATUS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DELETE_JOB_STATUS) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_namespace_pb_lifecycle_proto_enumTypes[1].Descriptor()
+}
+
+func (DELETE_JOB_STATUS) Type() protoreflect.EnumType {
+	return &file_apps_namespace_pb_lifecycle_proto_enumTypes[1]
+}
+
+func (x DELETE_JOB_STATUS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DELETE_JOB_STATUS.Descriptor instead.
+func (DELETE_JOB_STATUS) EnumDescriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{1}
+}
+
+// NamespaceStatus 空间状态
+type NamespaceStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 当前状态
+	// @gotags: bson:"state" json:"state"
+	State NAMESPACE_STATE `protobuf:"varint,1,opt,name=state,proto3,enum=infraboard.mcenter.namespace.NAMESPACE_STATE" json:"state" bson:"state"`
+	// 状态变更时间
+	// @gotags: bson:"change_at" json:"change_at"
+	ChangeAt int64 `protobuf:"varint,2,opt,name=change_at,json=changeAt,proto3" json:"change_at" bson:"change_at"`
+	// 操作人
+	// @gotags: bson:"operator" json:"operator"
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator" bson:"operator"`
+	// 变更原因
+	// @gotags: bson:"reason" json:"reason"
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason" bson:"reason"`
+}
+
+func (x *NamespaceStatus) Reset() {
+	*x = NamespaceStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceStatus) ProtoMessage() {}
+
+func (x *NamespaceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceStatus.ProtoReflect.Descriptor instead.
+func (*NamespaceStatus) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NamespaceStatus) GetState() NAMESPACE_STATE {
+	if x != nil {
+		return x.State
+	}
+	return NAMESPACE_STATE_ACTIVE
+}
+
+func (x *NamespaceStatus) GetChangeAt() int64 {
+	if x != nil {
+		return x.ChangeAt
+	}
+	return 0
+}
+
+func (x *NamespaceStatus) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *NamespaceStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ArchiveNamespaceRequest 归档空间
+type ArchiveNamespaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	// 空间名称
+	// @gotags: json:"name" validate:"required"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" validate:"required"`
+	// 操作人
+	// @gotags: json:"operator"
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator"`
+	// 归档原因
+	// @gotags: json:"reason" validate:"lte=200"
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason" validate:"lte=200"`
+}
+
+func (x *ArchiveNamespaceRequest) Reset() {
+	*x = ArchiveNamespaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveNamespaceRequest) ProtoMessage() {}
+
+func (x *ArchiveNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArchiveNamespaceRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ArchiveNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArchiveNamespaceRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ArchiveNamespaceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// RestoreNamespaceRequest 恢复已归档的空间
+type RestoreNamespaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	// 空间名称
+	// @gotags: json:"name" validate:"required"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" validate:"required"`
+	// 操作人
+	// @gotags: json:"operator"
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator"`
+}
+
+func (x *RestoreNamespaceRequest) Reset() {
+	*x = RestoreNamespaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNamespaceRequest) ProtoMessage() {}
+
+func (x *RestoreNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*RestoreNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RestoreNamespaceRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *RestoreNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RestoreNamespaceRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// ResourceBlocker 阻止空间删除的一类资源
+type ResourceBlocker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源名称
+	// @gotags: json:"resource"
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource"`
+	// 资源数量
+	// @gotags: json:"count"
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+}
+
+func (x *ResourceBlocker) Reset() {
+	*x = ResourceBlocker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceBlocker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceBlocker) ProtoMessage() {}
+
+func (x *ResourceBlocker) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceBlocker.ProtoReflect.Descriptor instead.
+func (*ResourceBlocker) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResourceBlocker) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ResourceBlocker) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// DeleteCheckResult 删除空间前的依赖检查结果
+type DeleteCheckResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 空间名称
+	// @gotags: json:"namespace"
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace"`
+	// 是否可以直接删除
+	// @gotags: json:"deletable"
+	Deletable bool `protobuf:"varint,2,opt,name=deletable,proto3" json:"deletable"`
+	// 阻止删除的资源
+	// @gotags: json:"blockers"
+	Blockers []*ResourceBlocker `protobuf:"bytes,3,rep,name=blockers,proto3" json:"blockers"`
+}
+
+func (x *DeleteCheckResult) Reset() {
+	*x = DeleteCheckResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCheckResult) ProtoMessage() {}
+
+func (x *DeleteCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCheckResult.ProtoReflect.Descriptor instead.
+func (*DeleteCheckResult) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteCheckResult) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteCheckResult) GetDeletable() bool {
+	if x != nil {
+		return x.Deletable
+	}
+	return false
+}
+
+func (x *DeleteCheckResult) GetBlockers() []*ResourceBlocker {
+	if x != nil {
+		return x.Blockers
+	}
+	return nil
+}
+
+// CountNamespaceResourceRequest 统计空间下的某类资源
+type CountNamespaceResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	// 空间名称
+	// @gotags: json:"namespace" validate:"required"
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace" validate:"required"`
+}
+
+func (x *CountNamespaceResourceRequest) Reset() {
+	*x = CountNamespaceResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountNamespaceResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountNamespaceResourceRequest) ProtoMessage() {}
+
+func (x *CountNamespaceResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountNamespaceResourceRequest.ProtoReflect.Descriptor instead.
+func (*CountNamespaceResourceRequest) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CountNamespaceResourceRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CountNamespaceResourceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type CountNamespaceResourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源数量
+	// @gotags: json:"count"
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *CountNamespaceResourceResponse) Reset() {
+	*x = CountNamespaceResourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountNamespaceResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountNamespaceResourceResponse) ProtoMessage() {}
+
+func (x *CountNamespaceResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountNamespaceResourceResponse.ProtoReflect.Descriptor instead.
+func (*CountNamespaceResourceResponse) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CountNamespaceResourceResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// DeleteNamespaceResourceRequest 删除空间下的某类资源
+type DeleteNamespaceResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	// 空间名称
+	// @gotags: json:"namespace" validate:"required"
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace" validate:"required"`
+}
+
+func (x *DeleteNamespaceResourceRequest) Reset() {
+	*x = DeleteNamespaceResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNamespaceResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNamespaceResourceRequest) ProtoMessage() {}
+
+func (x *DeleteNamespaceResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNamespaceResourceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceResourceRequest) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteNamespaceResourceRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *DeleteNamespaceResourceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type DeleteNamespaceResourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 删除的数量
+	// @gotags: json:"deleted"
+	Deleted int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted"`
+}
+
+func (x *DeleteNamespaceResourceResponse) Reset() {
+	*x = DeleteNamespaceResourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNamespaceResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNamespaceResourceResponse) ProtoMessage() {}
+
+func (x *DeleteNamespaceResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNamespaceResourceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceResourceResponse) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteNamespaceResourceResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+// DeleteStep 删除空间下某类资源的进度
+type DeleteStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资源名称
+	// @gotags: bson:"resource" json:"resource"
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource" bson:"resource"`
+	// 状态
+	// @gotags: bson:"status" json:"status"
+	Status DELETE_JOB_STATUS `protobuf:"varint,2,opt,name=status,proto3,enum=infraboard.mcenter.namespace.DELETE_JOB_STATUS" json:"status" bson:"status"`
+	// 删除的数量
+	// @gotags: bson:"deleted" json:"deleted"
+	Deleted int64 `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted" bson:"deleted"`
+	// 完成时间
+	// @gotags: bson:"finished_at" json:"finished_at"
+	FinishedAt int64 `protobuf:"varint,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at" bson:"finished_at"`
+	// 失败原因
+	// @gotags: bson:"message" json:"message"
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message" bson:"message"`
+}
+
+func (x *DeleteStep) Reset() {
+	*x = DeleteStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStep) ProtoMessage() {}
+
+func (x *DeleteStep) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStep.ProtoReflect.Descriptor instead.
+func (*DeleteStep) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteStep) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *DeleteStep) GetStatus() DELETE_JOB_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return DELETE_JOB_STATUS_PENDING
+}
+
+func (x *DeleteStep) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *DeleteStep) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *DeleteStep) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// DeleteJob 空间级联删除任务, 中断后可以继续执行
+type DeleteJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 任务Id
+	// @gotags: bson:"_id" json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id"`
+	// 创建时间
+	// @gotags: bson:"create_at" json:"create_at"
+	CreateAt int64 `protobuf:"varint,2,opt,name=create_at,json=createAt,proto3" json:"create_at" bson:"create_at"`
+	// 域
+	// @gotags: bson:"domain" json:"domain"
+	Domain string `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain" bson:"domain"`
+	// 空间Id
+	// @gotags: bson:"namespace_id" json:"namespace_id"
+	NamespaceId string `protobuf:"bytes,4,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id" bson:"namespace_id"`
+	// 空间名称
+	// @gotags: bson:"namespace" json:"namespace"
+	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace" bson:"namespace"`
+	// 操作人
+	// @gotags: bson:"operator" json:"operator"
+	Operator string `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator" bson:"operator"`
+	// 任务状态
+	// @gotags: bson:"status" json:"status"
+	Status DELETE_JOB_STATUS `protobuf:"varint,7,opt,name=status,proto3,enum=infraboard.mcenter.namespace.DELETE_JOB_STATUS" json:"status" bson:"status"`
+	// 各类资源的删除进度, 按照执行顺序排列
+	// @gotags: bson:"steps" json:"steps"
+	Steps []*DeleteStep `protobuf:"bytes,8,rep,name=steps,proto3" json:"steps" bson:"steps"`
+	// 完成时间
+	// @gotags: bson:"finished_at" json:"finished_at"
+	FinishedAt int64 `protobuf:"varint,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at" bson:"finished_at"`
+	// 失败原因
+	// @gotags: bson:"message" json:"message"
+	Message string `protobuf:"bytes,10,opt,name=message,proto3" json:"message" bson:"message"`
+}
+
+func (x *DeleteJob) Reset() {
+	*x = DeleteJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJob) ProtoMessage() {}
+
+func (x *DeleteJob) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJob.ProtoReflect.Descriptor instead.
+func (*DeleteJob) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteJob) GetCreateAt() int64 {
+	if x != nil {
+		return x.CreateAt
+	}
+	return 0
+}
+
+func (x *DeleteJob) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *DeleteJob) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *DeleteJob) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteJob) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *DeleteJob) GetStatus() DELETE_JOB_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return DELETE_JOB_STATUS_PENDING
+}
+
+func (x *DeleteJob) GetSteps() []*DeleteStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *DeleteJob) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *DeleteJob) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteJobSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 总数量
+	// @gotags: bson:"total" json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total" bson:"total"`
+	// 数据项
+	// @gotags: bson:"items" json:"items"
+	Items []*DeleteJob `protobuf:"bytes,2,rep,name=items,proto3" json:"items" bson:"items"`
+}
+
+func (x *DeleteJobSet) Reset() {
+	*x = DeleteJobSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJobSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobSet) ProtoMessage() {}
+
+func (x *DeleteJobSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobSet.ProtoReflect.Descriptor instead.
+func (*DeleteJobSet) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteJobSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DeleteJobSet) GetItems() []*DeleteJob {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// QueryDeleteJobRequest 查询删除任务
+type QueryDeleteJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分页参数
+	// @gotags: json:"page"
+	Page *request.PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page"`
+	// 域
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+	// 空间名称
+	// @gotags: json:"namespace"
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace"`
+}
+
+func (x *QueryDeleteJobRequest) Reset() {
+	*x = QueryDeleteJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDeleteJobRequest) ProtoMessage() {}
+
+func (x *QueryDeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryDeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*QueryDeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryDeleteJobRequest) GetPage() *request.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *QueryDeleteJobRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *QueryDeleteJobRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// DescribeDeleteJobRequest 查询删除任务详情
+type DescribeDeleteJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 任务Id
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required"`
+}
+
+func (x *DescribeDeleteJobRequest) Reset() {
+	*x = DescribeDeleteJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeDeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeDeleteJobRequest) ProtoMessage() {}
+
+func (x *DescribeDeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_namespace_pb_lifecycle_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeDeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*DescribeDeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_apps_namespace_pb_lifecycle_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DescribeDeleteJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_apps_namespace_pb_lifecycle_proto protoreflect.FileDescriptor
+
+var file_apps_namespace_pb_lifecycle_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2f, 0x70, 0x62, 0x2f, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x75, 0x62, 0x65, 0x2f, 0x70,
+	0x62, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xa7, 0x01, 0x0a, 0x0f, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x17, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x43, 0x0a, 0x0f, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9a,
+	0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x49, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x1d, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x22, 0x36, 0x0a, 0x1e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x1e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x22, 0x3b, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22,
+	0xc6, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x66,
+	0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf1, 0x02, 0x0a, 0x09, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x4a,
+	0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x63, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x72,
+	0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x70, 0x61, 0x67,
+	0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x2a, 0x0a, 0x18, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x39, 0x0a, 0x0f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41,
+	0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02,
+	0x2a, 0x48, 0x0a, 0x11, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a,
+	0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73,
+	0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_apps_namespace_pb_lifecycle_proto_rawDescOnce sync.Once
+	file_apps_namespace_pb_lifecycle_proto_rawDescData = file_apps_namespace_pb_lifecycle_proto_rawDesc
+)
+
+func file_apps_namespace_pb_lifecycle_proto_rawDescGZIP() []byte {
+	file_apps_namespace_pb_lifecycle_proto_rawDescOnce.Do(func() {
+		file_apps_namespace_pb_lifecycle_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_namespace_pb_lifecycle_proto_rawDescData)
+	})
+	return file_apps_namespace_pb_lifecycle_proto_rawDescData
+}
+
+var file_apps_namespace_pb_lifecycle_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_apps_namespace_pb_lifecycle_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_apps_namespace_pb_lifecycle_proto_goTypes = []interface{}{
+	(NAMESPACE_STATE)(0),                    // 0: infraboard.mcenter.namespace.NAMESPACE_STATE
+	(DELETE_JOB_STATUS)(0),                  // 1: infraboard.mcenter.namespace.DELETE_JOB_STATUS
+	(*NamespaceStatus)(nil),                 // 2: infraboard.mcenter.namespace.NamespaceStatus
+	(*ArchiveNamespaceRequest)(nil),         // 3: infraboard.mcenter.namespace.ArchiveNamespaceRequest
+	(*RestoreNamespaceRequest)(nil),         // 4: infraboard.mcenter.namespace.RestoreNamespaceRequest
+	(*ResourceBlocker)(nil),                 // 5: infraboard.mcenter.namespace.ResourceBlocker
+	(*DeleteCheckResult)(nil),               // 6: infraboard.mcenter.namespace.DeleteCheckResult
+	(*CountNamespaceResourceRequest)(nil),   // 7: infraboard.mcenter.namespace.CountNamespaceResourceRequest
+	(*CountNamespaceResourceResponse)(nil),  // 8: infraboard.mcenter.namespace.CountNamespaceResourceResponse
+	(*DeleteNamespaceResourceRequest)(nil),  // 9: infraboard.mcenter.namespace.DeleteNamespaceResourceRequest
+	(*DeleteNamespaceResourceResponse)(nil), // 10: infraboard.mcenter.namespace.DeleteNamespaceResourceResponse
+	(*DeleteStep)(nil),                      // 11: infraboard.mcenter.namespace.DeleteStep
+	(*DeleteJob)(nil),                       // 12: infraboard.mcenter.namespace.DeleteJob
+	(*DeleteJobSet)(nil),                    // 13: infraboard.mcenter.namespace.DeleteJobSet
+	(*QueryDeleteJobRequest)(nil),           // 14: infraboard.mcenter.namespace.QueryDeleteJobRequest
+	(*DescribeDeleteJobRequest)(nil),        // 15: infraboard.mcenter.namespace.DescribeDeleteJobRequest
+	(*request.PageRequest)(nil),             // 16: infraboard.mcube.page.PageRequest
+}
+var file_apps_namespace_pb_lifecycle_proto_depIdxs = []int32{
+	0,  // 0: infraboard.mcenter.namespace.NamespaceStatus.state:type_name -> infraboard.mcenter.namespace.NAMESPACE_STATE
+	5,  // 1: infraboard.mcenter.namespace.DeleteCheckResult.blockers:type_name -> infraboard.mcenter.namespace.ResourceBlocker
+	1,  // 2: infraboard.mcenter.namespace.DeleteStep.status:type_name -> infraboard.mcenter.namespace.DELETE_JOB_STATUS
+	1,  // 3: infraboard.mcenter.namespace.DeleteJob.status:type_name -> infraboard.mcenter.namespace.DELETE_JOB_STATUS
+	11, // 4: infraboard.mcenter.namespace.DeleteJob.steps:type_name -> infraboard.mcenter.namespace.DeleteStep
+	12, // 5: infraboard.mcenter.namespace.DeleteJobSet.items:type_name -> infraboard.mcenter.namespace.DeleteJob
+	16, // 6: infraboard.mcenter.namespace.QueryDeleteJobRequest.page:type_name -> infraboard.mcube.page.PageRequest
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_apps_namespace_pb_lifecycle_proto_init() }
+func file_apps_namespace_pb_lifecycle_proto_init() {
+	if File_apps_namespace_pb_lifecycle_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveNamespaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreNamespaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceBlocker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCheckResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountNamespaceResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountNamespaceResourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNamespaceResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNamespaceResourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDeleteJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_namespace_pb_lifecycle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeDeleteJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_namespace_pb_lifecycle_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apps_namespace_pb_lifecycle_proto_goTypes,
+		DependencyIndexes: file_apps_namespace_pb_lifecycle_proto_depIdxs,
+		EnumInfos:         file_apps_namespace_pb_lifecycle_proto_enumTypes,
+		MessageInfos:      file_apps_namespace_pb_lifecycle_proto_msgTypes,
+	}.Build()
+	File_apps_namespace_pb_lifecycle_proto = out.File
+	file_apps_namespace_pb_lifecycle_proto_rawDesc = nil
+	file_apps_namespace_pb_lifecycle_proto_goTypes = nil
+	file_apps_namespace_pb_lifecycle_proto_depIdxs = nil
+}
@@ -0,0 +1,100 @@
+// Code generated by github.com/infraboard/mcube
+// DO NOT EDIT
+
+package namespace
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseNAMESPACE_STATEFromString Parse NAMESPACE_STATE from string
+func ParseNAMESPACE_STATEFromString(str string) (NAMESPACE_STATE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := NAMESPACE_STATE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown NAMESPACE_STATE: %s", str)
+	}
+
+	return NAMESPACE_STATE(v), nil
+}
+
+// Equal type compare
+func (t NAMESPACE_STATE) Equal(target NAMESPACE_STATE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t NAMESPACE_STATE) IsIn(targets ...NAMESPACE_STATE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t NAMESPACE_STATE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *NAMESPACE_STATE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseNAMESPACE_STATEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseDELETE_JOB_STATUSFromString Parse DELETE_JOB_STATUS from string
+func ParseDELETE_JOB_STATUSFromString(str string) (DELETE_JOB_STATUS, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := DELETE_JOB_STATUS_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown DELETE_JOB_STATUS: %s", str)
+	}
+
+	return DELETE_JOB_STATUS(v), nil
+}
+
+// Equal type compare
+func (t DELETE_JOB_STATUS) Equal(target DELETE_JOB_STATUS) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t DELETE_JOB_STATUS) IsIn(targets ...DELETE_JOB_STATUS) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t DELETE_JOB_STATUS) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *DELETE_JOB_STATUS) UnmarshalJSON(b []byte) error {
+	ins, err := ParseDELETE_JOB_STATUSFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
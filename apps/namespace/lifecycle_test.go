@@ -0,0 +1,59 @@
+package namespace_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/namespace"
+)
+
+func TestNamespaceState(t *testing.T) {
+	should := assert.New(t)
+
+	// 老数据没有状态时视为正常
+	ns := namespace.NewDefaultNamespace()
+	ns.Spec.Name = "team01"
+	should.Equal(namespace.NAMESPACE_STATE_ACTIVE, ns.State())
+	should.NoError(ns.CheckWritable())
+
+	ns.ChangeState(namespace.NAMESPACE_STATE_ARCHIVED, "admin", "project finished")
+	should.Error(ns.CheckWritable())
+	ns.ChangeState(namespace.NAMESPACE_STATE_DELETING, "admin", "")
+	should.Error(ns.CheckWritable())
+}
+
+func TestDeleteCheckResult(t *testing.T) {
+	should := assert.New(t)
+
+	r := namespace.NewDeleteCheckResult("team01")
+	r.AddBlocker("service", 0)
+	should.True(r.Deletable)
+
+	r.AddBlocker("service", 2)
+	r.AddBlocker("token", 1)
+	should.False(r.Deletable)
+	should.Len(r.Blockers, 2)
+	should.Equal("2 service, 1 token", r.BlockerMessage())
+}
+
+func TestDeleteJob(t *testing.T) {
+	should := assert.New(t)
+
+	ns := namespace.NewDefaultNamespace()
+	ns.Id = "ns01"
+	ns.Spec.Name = "team01"
+	job := namespace.NewDeleteJob(ns, "admin", []string{"token", "policy"})
+	should.Len(job.Steps, 2)
+	should.False(job.IsFinished())
+
+	job.Steps[0].Succeed(3)
+	job.Failed("delete policy error")
+	should.True(job.IsFinished())
+
+	// 继续执行时保留已经完成的步骤
+	job.Resume()
+	should.False(job.IsFinished())
+	should.Equal(namespace.DELETE_JOB_STATUS_SUCCEEDED, job.Steps[0].Status)
+	should.Empty(job.Message)
+}
@@ -0,0 +1,124 @@
+package namespace
+
+import (
+	"fmt"
+)
+
+const (
+	// 单个空间最多查询的成员策略数量
+	MAX_MEMBER_POLICY = 2048
+)
+
+// NewMemberSet 实例化
+func NewMemberSet() *MemberSet {
+	return &MemberSet{
+		Items: []*Member{},
+	}
+}
+
+// GetOrAdd 获取成员, 不存在时添加
+func (s *MemberSet) GetOrAdd(username, group string) *Member {
+	for i := range s.Items {
+		if s.Items[i].Username == username && s.Items[i].Group == group {
+			return s.Items[i]
+		}
+	}
+
+	m := &Member{
+		Username: username,
+		Group:    group,
+		Roles:    []*MemberRole{},
+	}
+	s.Items = append(s.Items, m)
+	s.Total = int64(len(s.Items))
+	return m
+}
+
+// NewQueryMemberRequest todo
+func NewQueryMemberRequest(domain, namespace string) *QueryMemberRequest {
+	return &QueryMemberRequest{
+		Domain:    domain,
+		Namespace: namespace,
+	}
+}
+
+// Validate todo
+func (req *QueryMemberRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// NewAddMemberRequest todo
+func NewAddMemberRequest(domain, namespace string) *AddMemberRequest {
+	return &AddMemberRequest{
+		Domain:    domain,
+		Namespace: namespace,
+		Usernames: []string{},
+		Groups:    []string{},
+		RoleIds:   []string{},
+	}
+}
+
+// Validate todo
+func (req *AddMemberRequest) Validate() error {
+	if len(req.Usernames) == 0 && len(req.Groups) == 0 {
+		return fmt.Errorf("usernames or groups required")
+	}
+	return validate.Struct(req)
+}
+
+// NewRemoveMemberRequest todo
+func NewRemoveMemberRequest(domain, namespace string) *RemoveMemberRequest {
+	return &RemoveMemberRequest{
+		Domain:    domain,
+		Namespace: namespace,
+		RoleIds:   []string{},
+	}
+}
+
+// Validate todo
+func (req *RemoveMemberRequest) Validate() error {
+	if (req.Username == "") == (req.Group == "") {
+		return fmt.Errorf("one of username or group required")
+	}
+	return validate.Struct(req)
+}
+
+// HasRole 是否移除该角色
+func (req *RemoveMemberRequest) HasRole(roleId string) bool {
+	if len(req.RoleIds) == 0 {
+		return true
+	}
+	for _, id := range req.RoleIds {
+		if id == roleId {
+			return true
+		}
+	}
+	return false
+}
+
+// NewTransferOwnerRequest todo
+func NewTransferOwnerRequest(domain, namespace string) *TransferOwnerRequest {
+	return &TransferOwnerRequest{
+		Domain:    domain,
+		Namespace: namespace,
+	}
+}
+
+// Validate todo
+func (req *TransferOwnerRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// NewLeaveNamespaceRequest todo
+func NewLeaveNamespaceRequest(domain, namespace, username string) *LeaveNamespaceRequest {
+	return &LeaveNamespaceRequest{
+		Domain:    domain,
+		Namespace: namespace,
+		Username:  username,
+	}
+}
+
+// Validate todo
+func (req *LeaveNamespaceRequest) Validate() error {
+	return validate.Struct(req)
+}
@@ -2,9 +2,11 @@ package impl
 
 import (
 	"github.com/infraboard/mcenter/apps/endpoint"
+	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/permission"
 	"github.com/infraboard/mcenter/apps/policy"
 	"github.com/infraboard/mcenter/apps/role"
+	"github.com/infraboard/mcenter/apps/user"
 	"github.com/infraboard/mcube/app"
 	"github.com/infraboard/mcube/logger"
 	"github.com/infraboard/mcube/logger/zap"
@@ -19,16 +21,20 @@ var (
 type service struct {
 	permission.UnimplementedRPCServer
 
-	log      logger.Logger
-	policy   policy.Service
-	role     role.Service
-	endpoint endpoint.Service
+	log       logger.Logger
+	policy    policy.Service
+	role      role.Service
+	endpoint  endpoint.Service
+	user      user.Service
+	namespace namespace.Service
 }
 
 func (s *service) Config() error {
 	s.policy = app.GetInternalApp(policy.AppName).(policy.Service)
 	s.role = app.GetInternalApp(role.AppName).(role.Service)
 	s.endpoint = app.GetInternalApp(endpoint.AppName).(endpoint.Service)
+	s.user = app.GetInternalApp(user.AppName).(user.Service)
+	s.namespace = app.GetInternalApp(namespace.AppName).(namespace.Service)
 	s.log = zap.L().Named(s.Name())
 	return nil
 }
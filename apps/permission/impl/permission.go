@@ -7,9 +7,12 @@ import (
 	"github.com/infraboard/mcube/http/request"
 
 	"github.com/infraboard/mcenter/apps/endpoint"
+	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/permission"
 	"github.com/infraboard/mcenter/apps/policy"
 	"github.com/infraboard/mcenter/apps/role"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
 )
 
 func (s *service) QueryPermission(ctx context.Context, req *permission.QueryPermissionRequest) (
@@ -25,11 +28,23 @@ func (s *service) QueryPermission(ctx context.Context, req *permission.QueryPerm
 	preq.Domain = req.Domain
 	preq.Username = req.Username
 	preq.Namespace = req.Namespace
+	preq.WithGroup = true
+
+	// 上级空间的策略对子空间同样生效
+	inherited, err := s.inheritedNamespaces(ctx, req.Domain, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	preq.InheritedNamespaces = inherited
 
 	policySet, err := s.policy.QueryPolicy(ctx, preq)
 	if err != nil {
 		return nil, err
 	}
+	policySet, err = s.filterByConditions(ctx, req.Domain, req.Username, policySet)
+	if err != nil {
+		return nil, err
+	}
 
 	// 获取用户的角色列表
 	rset, err := policySet.GetRoles(ctx, s.role, true)
@@ -52,11 +67,23 @@ func (s *service) QueryRole(ctx context.Context, req *permission.QueryRoleReques
 	preq.Username = req.Username
 	preq.Domain = req.Domain
 	preq.Namespace = req.Namespace
+	preq.WithGroup = true
+
+	// 上级空间的策略对子空间同样生效
+	inherited, err := s.inheritedNamespaces(ctx, req.Domain, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	preq.InheritedNamespaces = inherited
 
 	policySet, err := s.policy.QueryPolicy(ctx, preq)
 	if err != nil {
 		return nil, err
 	}
+	policySet, err = s.filterByConditions(ctx, req.Domain, req.Username, policySet)
+	if err != nil {
+		return nil, err
+	}
 
 	return policySet.GetRoles(ctx, s.role, req.WithPermission)
 }
@@ -67,6 +94,11 @@ func (s *service) CheckPermission(ctx context.Context, req *permission.CheckPerm
 		return nil, exception.NewBadRequest("validate param error, %s", err)
 	}
 
+	scope, err := token.ParseScope(req.Scope)
+	if err != nil {
+		return nil, exception.NewBadRequest("parse scope error, %s", err)
+	}
+
 	roleReq := permission.NewQueryRoleRequest(req.Namespace)
 	roleReq.WithPermission = true
 	roleReq.Username = req.Username
@@ -86,6 +118,11 @@ func (s *service) CheckPermission(ctx context.Context, req *permission.CheckPerm
 	}
 	s.log.Debugf("check roles %s has permission access endpoint [%s]", roleSet.RoleNames(), ep.Entry)
 
+	// 令牌范围限制, 先于鉴权开关判断, 超出范围的资源即使不需要鉴权也不允许访问
+	if !scope.MatchLabel(ep.Entry.Labels) {
+		return nil, exception.NewPermissionDeny("token scope [%s] not allow access endpoint: %s", scope, ep.Entry.Path)
+	}
+
 	// 不需要鉴权
 	if !ep.Entry.PermissionEnable {
 		return role.NewSkipPermission("endpoint not enable permission check, allow all access"), nil
@@ -106,3 +143,35 @@ func (s *service) CheckPermission(ctx context.Context, req *permission.CheckPerm
 
 	return p, nil
 }
+
+// filterByConditions 过滤掉用户属性不满足生效条件的策略
+func (s *service) filterByConditions(ctx context.Context, domain, username string, set *policy.PolicySet) (
+	*policy.PolicySet, error) {
+	if !set.HasCondition() {
+		return set, nil
+	}
+
+	u, err := s.user.DescribeUser(ctx, user.NewDescriptDomainMemberRequest(domain, username))
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := map[string]string{}
+	if u.Profile != nil && u.Profile.Attributes != nil {
+		attrs = u.Profile.Attributes
+	}
+	return set.FilterByAttributes(attrs), nil
+}
+
+// inheritedNamespaces 空间继承策略的上级空间
+func (s *service) inheritedNamespaces(ctx context.Context, domain, ns string) ([]string, error) {
+	if ns == "" || ns == "*" {
+		return nil, nil
+	}
+
+	set, err := s.namespace.QueryInheritedNamespace(ctx, namespace.NewDescriptNamespaceRequest(domain, ns))
+	if err != nil {
+		return nil, err
+	}
+	return set.Names(), nil
+}
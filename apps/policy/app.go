@@ -52,6 +52,14 @@ func (p *Policy) genID() {
 	p.Id = fmt.Sprintf("%x", h.Sum32())
 }
 
+// MoveNamespace 修改策略所在的域和空间, 策略Id由空间决定, 随之重新生成
+func (p *Policy) MoveNamespace(domain, namespace string) {
+	p.Spec.Domain = domain
+	p.Spec.Namespace = namespace
+	p.UpdateAt = time.Now().UnixMilli()
+	p.genID()
+}
+
 // IsAllNamespace 是否是对账所有namespace的测试
 func (p *Policy) IsAllNamespace() bool {
 	return p.Spec.Namespace == "*"
@@ -64,7 +72,25 @@ func NewCreatePolicyRequest() *CreatePolicyRequest {
 
 // Validate 校验请求合法
 func (req *CreatePolicyRequest) Validate() error {
-	return validate.Struct(req)
+	if err := validate.Struct(req); err != nil {
+		return err
+	}
+
+	// 策略只能授权给用户或者用户组中的一个
+	if (req.Username == "") == (req.Group == "") {
+		return fmt.Errorf("one of username or group required")
+	}
+	for _, c := range req.Conditions {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsGroupPolicy 是否是授权给用户组的策略
+func (p *Policy) IsGroupPolicy() bool {
+	return p.Spec.Group != ""
 }
 
 // NewPolicySet todo
@@ -78,6 +104,9 @@ func NewPolicySet() *PolicySet {
 func (s *PolicySet) Users() []string {
 	users := map[string]struct{}{}
 	for i := range s.Items {
+		if s.Items[i].Spec.Username == "" {
+			continue
+		}
 		users[s.Items[i].Spec.Username] = struct{}{}
 	}
 
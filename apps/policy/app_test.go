@@ -0,0 +1,35 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/policy"
+)
+
+func TestMoveNamespace(t *testing.T) {
+	should := assert.New(t)
+
+	req := policy.NewCreatePolicyRequest()
+	req.Namespace = "cb0a1d8f0c3c5a2d9b8e"
+	req.Username = "admin"
+	req.RoleId = "admin-role"
+	p, err := policy.New(req)
+	should.NoError(err)
+
+	oldId := p.Id
+	p.MoveNamespace("default", "team01")
+	should.Equal("default", p.Spec.Domain)
+	should.Equal("team01", p.Spec.Namespace)
+	should.NotEqual(oldId, p.Id)
+
+	req = policy.NewCreatePolicyRequest()
+	req.Domain = "default"
+	req.Namespace = "team01"
+	req.Username = "admin"
+	req.RoleId = "admin-role"
+	np, err := policy.New(req)
+	should.NoError(err)
+	should.Equal(np.Id, p.Id)
+}
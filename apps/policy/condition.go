@@ -0,0 +1,78 @@
+package policy
+
+import (
+	"fmt"
+)
+
+// NewCondition 策略生效条件
+func NewCondition(attribute string, op OPERATOR, values ...string) *Condition {
+	return &Condition{
+		Attribute: attribute,
+		Operator:  op,
+		Values:    values,
+	}
+}
+
+// Validate 校验条件
+func (c *Condition) Validate() error {
+	if len(c.Values) == 0 {
+		return fmt.Errorf("condition %s values required", c.Attribute)
+	}
+	return nil
+}
+
+// Match 用户属性是否满足条件, 用户没有该属性时值为空
+func (c *Condition) Match(attrs map[string]string) bool {
+	v := attrs[c.Attribute]
+	in := false
+	for _, item := range c.Values {
+		if item == v {
+			in = true
+			break
+		}
+	}
+
+	switch c.Operator {
+	case OPERATOR_NOT_IN:
+		return !in
+	default:
+		return in
+	}
+}
+
+// HasCondition 策略是否有生效条件
+func (p *Policy) HasCondition() bool {
+	return len(p.Spec.Conditions) > 0
+}
+
+// MatchAttributes 用户属性是否满足策略的所有生效条件
+func (p *Policy) MatchAttributes(attrs map[string]string) bool {
+	for _, c := range p.Spec.Conditions {
+		if !c.Match(attrs) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasCondition 是否有带生效条件的策略
+func (s *PolicySet) HasCondition() bool {
+	for i := range s.Items {
+		if s.Items[i].HasCondition() {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterByAttributes 只保留用户属性满足生效条件的策略
+func (s *PolicySet) FilterByAttributes(attrs map[string]string) *PolicySet {
+	set := NewPolicySet()
+	for i := range s.Items {
+		if s.Items[i].MatchAttributes(attrs) {
+			set.Add(s.Items[i])
+		}
+	}
+	set.Total = int64(set.Length())
+	return set
+}
@@ -0,0 +1,39 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/policy"
+)
+
+func TestFilterByAttributes(t *testing.T) {
+	should := assert.New(t)
+
+	ops := policy.NewDefaultPolicy()
+	ops.Spec = policy.NewCreatePolicyRequest()
+	ops.Spec.Conditions = []*policy.Condition{
+		policy.NewCondition("department", policy.OPERATOR_IN, "ops", "sre"),
+	}
+	notIntern := policy.NewDefaultPolicy()
+	notIntern.Spec = policy.NewCreatePolicyRequest()
+	notIntern.Spec.Conditions = []*policy.Condition{
+		policy.NewCondition("level", policy.OPERATOR_NOT_IN, "intern"),
+	}
+	all := policy.NewDefaultPolicy()
+	all.Spec = policy.NewCreatePolicyRequest()
+
+	set := policy.NewPolicySet()
+	set.Add(ops)
+	set.Add(notIntern)
+	set.Add(all)
+	should.True(set.HasCondition())
+
+	should.Equal([]*policy.Policy{ops, notIntern, all},
+		set.FilterByAttributes(map[string]string{"department": "sre"}).Items)
+	should.Equal([]*policy.Policy{all},
+		set.FilterByAttributes(map[string]string{"department": "dev", "level": "intern"}).Items)
+	should.Equal([]*policy.Policy{notIntern, all},
+		set.FilterByAttributes(map[string]string{}).Items)
+}
@@ -1,6 +1,8 @@
 package impl
 
 import (
+	"context"
+
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/infraboard/mcube/app"
@@ -8,6 +10,7 @@ import (
 	"github.com/infraboard/mcube/logger/zap"
 	"google.golang.org/grpc"
 
+	"github.com/infraboard/mcenter/apps/group"
 	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/policy"
 	"github.com/infraboard/mcenter/apps/role"
@@ -28,6 +31,7 @@ type impl struct {
 	user      user.Service
 	role      role.Service
 	namespace namespace.Service
+	group     group.Service
 }
 
 func (i *impl) Config() error {
@@ -37,10 +41,14 @@ func (i *impl) Config() error {
 	}
 	i.col = db.Collection(i.Name())
 	i.log = zap.L().Named(i.Name())
+	if err := i.migrateNamespaceKey(context.Background(), db.Collection(namespace.AppName)); err != nil {
+		return err
+	}
 
 	i.user = app.GetInternalApp(user.AppName).(user.Service)
 	i.role = app.GetInternalApp(role.AppName).(role.Service)
 	i.namespace = app.GetInternalApp(namespace.AppName).(namespace.Service)
+	i.group = app.GetInternalApp(group.AppName).(group.Service)
 	return nil
 }
 
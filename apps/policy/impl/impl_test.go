@@ -30,6 +30,32 @@ func TestCreatePolicy(t *testing.T) {
 	t.Log(r)
 }
 
+func TestCreateGroupPolicy(t *testing.T) {
+	req := policy.NewCreatePolicyRequest()
+	req.Group = "cdu7n4ea0brlnbcmbj2g"
+	req.RoleId = "cdahojmv9mc70r5h74gg"
+	req.Domain = domain.DEFAULT_DOMAIN
+	req.Namespace = namespace.DEFAULT_NAMESPACE
+	req.CreateBy = "admin"
+	r, err := impl.CreatePolicy(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
+func TestQueryUserPolicyWithGroup(t *testing.T) {
+	req := policy.NewQueryPolicyRequest()
+	req.Domain = domain.DEFAULT_DOMAIN
+	req.Username = "test"
+	req.WithGroup = true
+	r, err := impl.QueryPolicy(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
 func TestQueryPolicy(t *testing.T) {
 	req := policy.NewQueryPolicyRequest()
 	req.WithRole = true
@@ -0,0 +1,66 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/infraboard/mcenter/apps/namespace"
+	"github.com/infraboard/mcenter/apps/policy"
+)
+
+// migrateNamespaceKey 早期空间负责人的内置策略使用空间Id标识空间, 改为使用域和空间名称
+// 空间由空间模块管理, 这里只按照Id读取空间的域和名称, 各模块初始化的顺序不固定, 不能使用空间服务
+func (i *impl) migrateNamespaceKey(ctx context.Context, nsCol *mongo.Collection) error {
+	resp, err := nsCol.Find(ctx, bson.M{})
+	if err != nil {
+		return exception.NewInternalServerError("find namespace error, %s", err)
+	}
+	defer resp.Close(ctx)
+
+	for resp.Next(ctx) {
+		ns := namespace.NewDefaultNamespace()
+		if err := resp.Decode(ns); err != nil {
+			return exception.NewInternalServerError("decode namespace error, %s", err)
+		}
+		if ns.Id == ns.Spec.Name {
+			continue
+		}
+		if err := i.migratePolicyNamespace(ctx, ns); err != nil {
+			return err
+		}
+	}
+	return resp.Err()
+}
+
+// 策略Id由空间决定, 按照新的空间标识重新插入, 再删除旧的策略
+func (i *impl) migratePolicyNamespace(ctx context.Context, ns *namespace.Namespace) error {
+	resp, err := i.col.Find(ctx, bson.M{
+		"spec.namespace": ns.Id,
+		"spec.domain":    bson.M{"$in": bson.A{nil, "", ns.Spec.Domain}},
+	})
+	if err != nil {
+		return exception.NewInternalServerError("find namespace %s policy error, %s", ns.Id, err)
+	}
+	defer resp.Close(ctx)
+
+	for resp.Next(ctx) {
+		p := policy.NewDefaultPolicy()
+		if err := resp.Decode(p); err != nil {
+			return exception.NewInternalServerError("decode policy error, %s", err)
+		}
+
+		oldId := p.Id
+		p.MoveNamespace(ns.Spec.Domain, ns.Spec.Name)
+		if _, err := i.col.InsertOne(ctx, p); err != nil && !mongo.IsDuplicateKeyError(err) {
+			return exception.NewInternalServerError("insert policy(%s) error, %s", p.Id, err)
+		}
+		if _, err := i.col.DeleteOne(ctx, bson.M{"_id": oldId}); err != nil {
+			return exception.NewInternalServerError("delete policy(%s) error, %s", oldId, err)
+		}
+		i.log.Infof("policy %s moved to namespace %s/%s as %s", oldId, ns.Spec.Domain, ns.Spec.Name, p.Id)
+	}
+	return resp.Err()
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 
 	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/group"
 	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/policy"
 	"github.com/infraboard/mcenter/apps/role"
@@ -20,11 +23,9 @@ func (s *impl) CreatePolicy(ctx context.Context, req *policy.CreatePolicyRequest
 		return nil, exception.NewBadRequest(err.Error())
 	}
 
-	u, err := s.CheckDependence(ctx, ins)
-	if err != nil {
+	if err := s.CheckDependence(ctx, ins); err != nil {
 		return nil, err
 	}
-	s.log.Debugf("user: %s", u.Spec.Username)
 
 	if _, err := s.col.InsertOne(ctx, ins); err != nil {
 		return nil, exception.NewInternalServerError("inserted policy(%s) document error, %s",
@@ -35,25 +36,56 @@ func (s *impl) CreatePolicy(ctx context.Context, req *policy.CreatePolicyRequest
 }
 
 // CheckDependence todo
-func (i *impl) CheckDependence(ctx context.Context, p *policy.Policy) (*user.User, error) {
-	account, err := i.user.DescribeUser(ctx, user.NewDescriptUserRequestWithName(p.Spec.Username))
-	if err != nil {
-		return nil, fmt.Errorf("check user error, %s", err)
+func (i *impl) CheckDependence(ctx context.Context, p *policy.Policy) error {
+	if p.IsGroupPolicy() {
+		g, err := i.group.DescribeGroup(ctx, group.NewDescribeGroupRequest(p.Spec.Group))
+		if err != nil {
+			return fmt.Errorf("check group error, %s", err)
+		}
+		if g.Spec.Domain != p.Spec.Domain {
+			return fmt.Errorf("check group error, group %s not in domain %s", p.Spec.Group, p.Spec.Domain)
+		}
+	} else {
+		_, err := i.user.DescribeUser(ctx, user.NewDescriptUserRequestWithName(p.Spec.Username))
+		if err != nil {
+			return fmt.Errorf("check user error, %s", err)
+		}
 	}
 
-	_, err = i.role.DescribeRole(ctx, role.NewDescribeRoleRequestWithID(p.Spec.RoleId))
+	_, err := i.role.DescribeRole(ctx, role.NewDescribeRoleRequestWithID(p.Spec.RoleId))
 	if err != nil {
-		return nil, fmt.Errorf("check role error, %s", err)
+		return fmt.Errorf("check role error, %s", err)
 	}
 
 	if !p.IsAllNamespace() {
-		_, err = i.namespace.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(p.Spec.Domain, p.Spec.Namespace))
+		ns, err := i.namespace.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(p.Spec.Domain, p.Spec.Namespace))
 		if err != nil {
-			return nil, fmt.Errorf("check namespace error, %s", err)
+			return fmt.Errorf("check namespace error, %s", err)
+		}
+		// 已归档和删除中的空间不允许再授权
+		if err := ns.CheckWritable(); err != nil {
+			return exception.NewBadRequest(err.Error())
+		}
+	}
+
+	return nil
+}
+
+// userGroupIds 用户在该域中所在的用户组, 包含父组, 用户可以是从其他域加入的成员
+func (i *impl) userGroupIds(ctx context.Context, domain, username string) ([]string, error) {
+	u, err := i.user.DescribeUser(ctx, user.NewDescriptDomainMemberRequest(domain, username))
+	if err != nil {
+		if exception.IsNotFoundError(err) {
+			return nil, nil
 		}
+		return nil, err
 	}
 
-	return account, nil
+	set, err := i.group.QueryUserGroup(ctx, group.NewQueryUserGroupRequest(domain, u.Id))
+	if err != nil {
+		return nil, err
+	}
+	return set.GroupIds(), nil
 }
 
 func (s *impl) QueryPolicy(ctx context.Context, req *policy.QueryPolicyRequest) (
@@ -70,6 +102,14 @@ func (s *impl) QueryPolicy(ctx context.Context, req *policy.QueryPolicyRequest)
 		}
 	}
 
+	// 补充用户组绑定的策略
+	if req.WithGroup && req.Username != "" {
+		r.groupIds, err = s.userGroupIds(ctx, req.Domain, req.Username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	s.log.Debugf("query policy filter: %s", r.FindFilter())
 	resp, err := s.col.Find(ctx, r.FindFilter(), r.FindOptions())
 	if err != nil {
@@ -146,3 +186,49 @@ func (s *impl) DeletePolicy(ctx context.Context, req *policy.DeletePolicyRequest
 
 	return p, nil
 }
+
+// 删除域下所有的策略
+func (s *impl) DeleteDomainResource(ctx context.Context, req *domain.DeleteDomainResourceRequest) (
+	*domain.DeleteDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	rs, err := s.col.DeleteMany(ctx, bson.M{"spec.domain": req.Domain})
+	if err != nil {
+		return nil, exception.NewInternalServerError("delete domain %s policy error, %s", req.Domain, err)
+	}
+	return domain.NewDeleteDomainResourceResponse(rs.DeletedCount), nil
+}
+
+// 统计空间下负责人以外的策略数量, 负责人的内置策略随空间一起删除
+func (s *impl) CountNamespaceResource(ctx context.Context, req *namespace.CountNamespaceResourceRequest) (
+	*namespace.CountNamespaceResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	count, err := s.col.CountDocuments(ctx, bson.M{
+		"spec.domain":    req.Domain,
+		"spec.namespace": req.Namespace,
+		"spec.type":      bson.M{"$ne": policy.PolicyType_BUILD_IN},
+	})
+	if err != nil {
+		return nil, exception.NewInternalServerError("count namespace %s policy error, %s", req.Namespace, err)
+	}
+	return namespace.NewCountNamespaceResourceResponse(count), nil
+}
+
+// 删除空间下所有的策略
+func (s *impl) DeleteNamespaceResource(ctx context.Context, req *namespace.DeleteNamespaceResourceRequest) (
+	*namespace.DeleteNamespaceResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	rs, err := s.col.DeleteMany(ctx, bson.M{"spec.domain": req.Domain, "spec.namespace": req.Namespace})
+	if err != nil {
+		return nil, exception.NewInternalServerError("delete namespace %s policy error, %s", req.Namespace, err)
+	}
+	return namespace.NewDeleteNamespaceResourceResponse(rs.DeletedCount), nil
+}
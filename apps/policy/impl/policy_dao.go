@@ -45,6 +45,8 @@ func newQueryPolicyRequest(req *policy.QueryPolicyRequest) (*queryPolicyRequest,
 
 type queryPolicyRequest struct {
 	*policy.QueryPolicyRequest
+	// 用户所在的用户组
+	groupIds []string
 }
 
 func (r *queryPolicyRequest) FindOptions() *options.FindOptions {
@@ -67,13 +69,28 @@ func (r *queryPolicyRequest) FindFilter() bson.M {
 	}
 
 	if r.Namespace != "" {
-		filter["spec.namespace"] = r.Namespace
+		if len(r.InheritedNamespaces) > 0 {
+			nss := append([]string{r.Namespace}, r.InheritedNamespaces...)
+			filter["spec.namespace"] = bson.M{"$in": nss}
+		} else {
+			filter["spec.namespace"] = r.Namespace
+		}
 	}
 	if r.RoleId != "" {
 		filter["spec.role_id"] = r.RoleId
 	}
+	if r.Group != "" {
+		filter["spec.group"] = r.Group
+	}
 	if r.Username != "" {
-		filter["spec.username"] = r.Username
+		if len(r.groupIds) > 0 {
+			filter["$or"] = bson.A{
+				bson.M{"spec.username": r.Username},
+				bson.M{"spec.group": bson.M{"$in": r.groupIds}},
+			}
+		} else {
+			filter["spec.username"] = r.Username
+		}
 	}
 	if r.Type != nil {
 		filter["spec.type"] = r.Type
@@ -94,22 +111,24 @@ type deletePolicyRequest struct {
 
 func (r *deletePolicyRequest) FindFilter() bson.M {
 	filter := bson.M{}
-	filter["domain"] = r.Domain
+	if r.Domain != "" {
+		filter["spec.domain"] = r.Domain
+	}
 
 	if r.Id != "" {
 		filter["_id"] = r.Id
 	}
 	if r.Username != "" {
-		filter["username"] = r.Username
+		filter["spec.username"] = r.Username
 	}
 	if r.RoleId != "" {
-		filter["role_id"] = r.RoleId
+		filter["spec.role_id"] = r.RoleId
 	}
 	if r.Namespace != "" {
-		filter["namespace"] = r.Namespace
+		filter["spec.namespace"] = r.Namespace
 	}
 	if r.Type != nil {
-		filter["type"] = r.Type
+		filter["spec.type"] = r.Type
 	}
 
 	return filter
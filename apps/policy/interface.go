@@ -1,5 +1,18 @@
 package policy
 
+import (
+	context "context"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/namespace"
+)
+
 type Service interface {
+	// 删除域下所有的策略, 清除域时调用
+	DeleteDomainResource(context.Context, *domain.DeleteDomainResourceRequest) (*domain.DeleteDomainResourceResponse, error)
+	// 统计空间下负责人以外的策略数量, 删除空间前检查依赖
+	CountNamespaceResource(context.Context, *namespace.CountNamespaceResourceRequest) (*namespace.CountNamespaceResourceResponse, error)
+	// 删除空间下所有的策略, 删除空间时调用
+	DeleteNamespaceResource(context.Context, *namespace.DeleteNamespaceResourceRequest) (*namespace.DeleteNamespaceResourceResponse, error)
 	RPCServer
 }
@@ -69,6 +69,55 @@ func (PolicyType) EnumDescriptor() ([]byte, []int) {
 	return file_apps_policy_pb_policy_proto_rawDescGZIP(), []int{0}
 }
 
+// 条件运算符
+type OPERATOR int32
+
+const (
+	// 属性值在列表中
+	OPERATOR_IN OPERATOR = 0
+	// 属性值不在列表中
+	OPERATOR_NOT_IN OPERATOR = 1
+)
+
+// Enum value maps for OPERATOR.
+var (
+	OPERATOR_name = map[int32]string{
+		0: "IN",
+		1: "NOT_IN",
+	}
+	OPERATOR_value = map[string]int32{
+		"IN":     0,
+		"NOT_IN": 1,
+	}
+)
+
+func (x OPERATOR) Enum() *OPERATOR {
+	p := new(OPERATOR)
+	*p = x
+	return p
+}
+
+func (x OPERATOR) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OPERATOR) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_policy_pb_policy_proto_enumTypes[1].Descriptor()
+}
+
+func (OPERATOR) Type() protoreflect.EnumType {
+	return &file_apps_policy_pb_policy_proto_enumTypes[1]
+}
+
+func (x OPERATOR) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OPERATOR.Descriptor instead.
+func (OPERATOR) EnumDescriptor() ([]byte, []int) {
+	return file_apps_policy_pb_policy_proto_rawDescGZIP(), []int{1}
+}
+
 // Policy 权限策略
 type Policy struct {
 	state         protoimpl.MessageState
@@ -174,15 +223,15 @@ type CreatePolicyRequest struct {
 	// 范围
 	// @gotags: bson:"namespace" json:"namespace" validate:"lte=120"
 	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace" bson:"namespace" validate:"lte=120"`
-	// 角色名称
-	// @gotags: bson:"group" json:"group"
-	Group string `protobuf:"bytes,4,opt,name=group,proto3" json:"group" bson:"group"`
+	// 用户组Id, 策略绑定到用户组时, 组内的所有成员都拥有该策略
+	// @gotags: bson:"group" json:"group" validate:"lte=40"
+	Group string `protobuf:"bytes,4,opt,name=group,proto3" json:"group" bson:"group" validate:"lte=40"`
 	// 范围控制
 	// @gotags: bson:"scope" json:"scope"
 	Scope string `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope" bson:"scope"`
-	// 用户
-	// @gotags: bson:"username" json:"username" validate:"required,lte=120"
-	Username string `protobuf:"bytes,6,opt,name=username,proto3" json:"username" bson:"username" validate:"required,lte=120"`
+	// 用户, 用户和用户组必须指定其中一个
+	// @gotags: bson:"username" json:"username" validate:"lte=120"
+	Username string `protobuf:"bytes,6,opt,name=username,proto3" json:"username" bson:"username" validate:"lte=120"`
 	// 角色名称
 	// @gotags: bson:"role_id" json:"role_id" validate:"required,lte=40"
 	RoleId string `protobuf:"bytes,7,opt,name=role_id,json=roleId,proto3" json:"role_id" bson:"role_id" validate:"required,lte=40"`
@@ -192,6 +241,9 @@ type CreatePolicyRequest struct {
 	// 策略的类型
 	// @gotags: bson:"type" json:"type"
 	Type PolicyType `protobuf:"varint,9,opt,name=type,proto3,enum=infraboard.mcenter.policy.PolicyType" json:"type" bson:"type"`
+	// 生效条件, 基于用户的自定义属性, 所有条件都满足时策略才生效
+	// @gotags: bson:"conditions" json:"conditions" validate:"dive"
+	Conditions []*Condition `protobuf:"bytes,10,rep,name=conditions,proto3" json:"conditions" bson:"conditions" validate:"dive"`
 }
 
 func (x *CreatePolicyRequest) Reset() {
@@ -289,6 +341,83 @@ func (x *CreatePolicyRequest) GetType() PolicyType {
 	return PolicyType_CUSTOM
 }
 
+func (x *CreatePolicyRequest) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// Condition 策略生效条件
+type Condition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 用户自定义属性名称
+	// @gotags: bson:"attribute" json:"attribute" validate:"required,lte=40"
+	Attribute string `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute" bson:"attribute" validate:"required,lte=40"`
+	// 运算符
+	// @gotags: bson:"operator" json:"operator"
+	Operator OPERATOR `protobuf:"varint,2,opt,name=operator,proto3,enum=infraboard.mcenter.policy.OPERATOR" json:"operator" bson:"operator"`
+	// 属性值列表
+	// @gotags: bson:"values" json:"values"
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values" bson:"values"`
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_policy_pb_policy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_policy_pb_policy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_apps_policy_pb_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Condition) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *Condition) GetOperator() OPERATOR {
+	if x != nil {
+		return x.Operator
+	}
+	return OPERATOR_IN
+}
+
+func (x *Condition) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type PolicySet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -303,7 +432,7 @@ type PolicySet struct {
 func (x *PolicySet) Reset() {
 	*x = PolicySet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_policy_pb_policy_proto_msgTypes[2]
+		mi := &file_apps_policy_pb_policy_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -316,7 +445,7 @@ func (x *PolicySet) String() string {
 func (*PolicySet) ProtoMessage() {}
 
 func (x *PolicySet) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_policy_pb_policy_proto_msgTypes[2]
+	mi := &file_apps_policy_pb_policy_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +458,7 @@ func (x *PolicySet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicySet.ProtoReflect.Descriptor instead.
 func (*PolicySet) Descriptor() ([]byte, []int) {
-	return file_apps_policy_pb_policy_proto_rawDescGZIP(), []int{2}
+	return file_apps_policy_pb_policy_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PolicySet) GetTotal() int64 {
@@ -366,7 +495,7 @@ var file_apps_policy_pb_policy_proto_rawDesc = []byte{
 	0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f,
 	0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61,
 	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x72, 0x6f,
-	0x6c, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xa7, 0x02,
+	0x6c, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xed, 0x02,
 	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
 	0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
@@ -385,18 +514,33 @@ var file_apps_policy_pb_policy_proto_rawDesc = []byte{
 	0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x66,
 	0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
 	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5a, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x72,
-	0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x2a, 0x26, 0x0a, 0x0a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x0c, 0x0a,
-	0x08, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x49, 0x4e, 0x10, 0x01, 0x42, 0x2b, 0x5a, 0x29, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70,
-	0x73, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x82, 0x01,
+	0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x22, 0x5a, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2a, 0x26,
+	0x0a, 0x0a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06,
+	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x55, 0x49, 0x4c,
+	0x44, 0x5f, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x1e, 0x0a, 0x08, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x4f, 0x52, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f,
+	0x54, 0x5f, 0x49, 0x4e, 0x10, 0x01, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f,
+	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -411,25 +555,29 @@ func file_apps_policy_pb_policy_proto_rawDescGZIP() []byte {
 	return file_apps_policy_pb_policy_proto_rawDescData
 }
 
-var file_apps_policy_pb_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_apps_policy_pb_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_apps_policy_pb_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_apps_policy_pb_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_apps_policy_pb_policy_proto_goTypes = []interface{}{
 	(PolicyType)(0),             // 0: infraboard.mcenter.policy.PolicyType
-	(*Policy)(nil),              // 1: infraboard.mcenter.policy.Policy
-	(*CreatePolicyRequest)(nil), // 2: infraboard.mcenter.policy.CreatePolicyRequest
-	(*PolicySet)(nil),           // 3: infraboard.mcenter.policy.PolicySet
-	(*role.Role)(nil),           // 4: infraboard.mcenter.role.Role
+	(OPERATOR)(0),               // 1: infraboard.mcenter.policy.OPERATOR
+	(*Policy)(nil),              // 2: infraboard.mcenter.policy.Policy
+	(*CreatePolicyRequest)(nil), // 3: infraboard.mcenter.policy.CreatePolicyRequest
+	(*Condition)(nil),           // 4: infraboard.mcenter.policy.Condition
+	(*PolicySet)(nil),           // 5: infraboard.mcenter.policy.PolicySet
+	(*role.Role)(nil),           // 6: infraboard.mcenter.role.Role
 }
 var file_apps_policy_pb_policy_proto_depIdxs = []int32{
-	2, // 0: infraboard.mcenter.policy.Policy.spec:type_name -> infraboard.mcenter.policy.CreatePolicyRequest
-	4, // 1: infraboard.mcenter.policy.Policy.role:type_name -> infraboard.mcenter.role.Role
+	3, // 0: infraboard.mcenter.policy.Policy.spec:type_name -> infraboard.mcenter.policy.CreatePolicyRequest
+	6, // 1: infraboard.mcenter.policy.Policy.role:type_name -> infraboard.mcenter.role.Role
 	0, // 2: infraboard.mcenter.policy.CreatePolicyRequest.type:type_name -> infraboard.mcenter.policy.PolicyType
-	1, // 3: infraboard.mcenter.policy.PolicySet.items:type_name -> infraboard.mcenter.policy.Policy
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 3: infraboard.mcenter.policy.CreatePolicyRequest.conditions:type_name -> infraboard.mcenter.policy.Condition
+	1, // 4: infraboard.mcenter.policy.Condition.operator:type_name -> infraboard.mcenter.policy.OPERATOR
+	2, // 5: infraboard.mcenter.policy.PolicySet.items:type_name -> infraboard.mcenter.policy.Policy
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_apps_policy_pb_policy_proto_init() }
@@ -463,6 +611,18 @@ func file_apps_policy_pb_policy_proto_init() {
 			}
 		}
 		file_apps_policy_pb_policy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Condition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_policy_pb_policy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PolicySet); i {
 			case 0:
 				return &v.state
@@ -480,8 +640,8 @@ func file_apps_policy_pb_policy_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apps_policy_pb_policy_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/http/restful/response"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/mcenter/apps/privacy"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service privacy.Service
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(privacy.AppName)
+	h.service = app.GetInternalApp(privacy.AppName).(privacy.Service)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return privacy.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"用户数据导出和注销"}
+
+	ws.Route(ws.POST("/").To(h.CreateJob).
+		Doc("创建用户数据导出或者注销任务, 任务在后台执行").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(privacy.CreateJobRequest{}).
+		Returns(200, "OK", privacy.Job{}))
+
+	ws.Route(ws.GET("/").To(h.QueryJob).
+		Doc("查询任务列表").
+		Param(ws.QueryParameter("domain", "用户所属域").DataType("string")).
+		Param(ws.QueryParameter("user_id", "用户Id").DataType("string")).
+		Param(ws.QueryParameter("type", "EXPORT或者ERASURE").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", privacy.JobSet{}))
+
+	ws.Route(ws.GET("/{id}").To(h.DescribeJob).
+		Doc("查询任务详情").
+		Param(ws.PathParameter("id", "任务Id").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", privacy.Job{}))
+
+	ws.Route(ws.GET("/{id}/archive").To(h.DownloadArchive).
+		Doc("下载导出任务的zip归档文件").
+		Param(ws.PathParameter("id", "任务Id").DataType("string")).
+		Produces("application/zip").
+		Metadata(restfulspec.KeyOpenAPITags, tags))
+}
+
+func (h *handler) CreateJob(r *restful.Request, w *restful.Response) {
+	req := privacy.NewCreateJobRequest(privacy.JOB_TYPE_EXPORT, "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	ins, err := h.service.CreateJob(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) QueryJob(r *restful.Request, w *restful.Response) {
+	req, err := privacy.NewQueryJobRequestFromHTTP(r.Request)
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest(err.Error()))
+		return
+	}
+
+	set, err := h.service.QueryJob(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *handler) DescribeJob(r *restful.Request, w *restful.Response) {
+	req := privacy.NewDescribeJobRequest(r.PathParameter("id"))
+	ins, err := h.service.DescribeJob(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) DownloadArchive(r *restful.Request, w *restful.Response) {
+	// 先写入缓冲区, 下载失败时仍然可以返回错误信息
+	buf := bytes.NewBuffer(nil)
+	req := privacy.NewDownloadArchiveRequest(r.PathParameter("id"), buf)
+	job, err := h.service.DownloadArchive(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, job.ArchiveName()))
+	if _, err := io.Copy(w, buf); err != nil {
+		h.log.Errorf("write archive error, %s", err)
+	}
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
@@ -0,0 +1,155 @@
+package privacy
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infraboard/mcube/http/request"
+	"github.com/rs/xid"
+)
+
+const (
+	AppName = "privacy"
+)
+
+const (
+	// 导出的归档文件保存的bucket
+	ARCHIVE_BUCKET = "privacy_export"
+	// 归档文件保存的时间
+	ARCHIVE_RETAIN = 7 * 24 * time.Hour
+	// 注销用户的匿名标识前缀
+	PSEUDONYM_PREFIX = "erased-"
+)
+
+var (
+	validate = validator.New()
+)
+
+// Pseudonym 注销用户的匿名标识, 审计记录中的用户名替换为该标识
+func Pseudonym(userId string) string {
+	return PSEUDONYM_PREFIX + userId
+}
+
+func NewCreateJobRequest(t JOB_TYPE, userId string) *CreateJobRequest {
+	return &CreateJobRequest{
+		Type:   t,
+		UserId: userId,
+	}
+}
+
+func (req *CreateJobRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewJob(req *CreateJobRequest) *Job {
+	return &Job{
+		Id:       xid.New().String(),
+		CreateAt: time.Now().UnixMilli(),
+		Spec:     req,
+		Status:   JOB_STATUS_PENDING,
+		Summary:  map[string]int64{},
+	}
+}
+
+// IsFinished 任务是否已经结束
+func (j *Job) IsFinished() bool {
+	return j.Status.Equal(JOB_STATUS_SUCCEEDED) || j.Status.Equal(JOB_STATUS_FAILED)
+}
+
+// Succeed 任务执行成功
+func (j *Job) Succeed() {
+	j.Status = JOB_STATUS_SUCCEEDED
+	j.FinishedAt = time.Now().UnixMilli()
+}
+
+// Failed 任务执行失败
+func (j *Job) Failed(format string, a ...interface{}) {
+	j.Status = JOB_STATUS_FAILED
+	j.FinishedAt = time.Now().UnixMilli()
+	j.Message = fmt.Sprintf(format, a...)
+}
+
+// ArchiveName 归档文件下载时的文件名
+func (j *Job) ArchiveName() string {
+	return fmt.Sprintf("%s-%s.zip", j.Username, j.Id)
+}
+
+// HasArchive 归档文件是否可以下载
+func (j *Job) HasArchive() bool {
+	return j.ArchiveId != "" && time.Now().UnixMilli() < j.ArchiveExpireAt
+}
+
+func NewJobSet() *JobSet {
+	return &JobSet{
+		Items: []*Job{},
+	}
+}
+
+func (s *JobSet) Add(item *Job) {
+	s.Items = append(s.Items, item)
+}
+
+func NewQueryJobRequest() *QueryJobRequest {
+	return &QueryJobRequest{
+		Page: request.NewPageRequest(20, 1),
+	}
+}
+
+func NewQueryJobRequestFromHTTP(r *http.Request) (*QueryJobRequest, error) {
+	qs := r.URL.Query()
+	req := NewQueryJobRequest()
+	req.Page = request.NewPageRequestFromHTTP(r)
+	req.Domain = qs.Get("domain")
+	req.UserId = qs.Get("user_id")
+	if v := qs.Get("type"); v != "" {
+		t, err := ParseJOB_TYPEFromString(v)
+		if err != nil {
+			return nil, err
+		}
+		req.Type = &t
+	}
+	return req, nil
+}
+
+func NewDescribeJobRequest(id string) *DescribeJobRequest {
+	return &DescribeJobRequest{
+		Id: id,
+	}
+}
+
+func (req *DescribeJobRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// NewDownloadArchiveRequest 下载导出的归档文件
+func NewDownloadArchiveRequest(jobId string, writer io.Writer) *DownloadArchiveRequest {
+	return &DownloadArchiveRequest{
+		JobId:  jobId,
+		writer: writer,
+	}
+}
+
+// DownloadArchiveRequest 下载归档文件请求
+type DownloadArchiveRequest struct {
+	JobId string
+
+	writer io.Writer
+}
+
+func (req *DownloadArchiveRequest) Validate() error {
+	if req.JobId == "" {
+		return fmt.Errorf("job id required")
+	}
+	if req.writer == nil {
+		return fmt.Errorf("archive writer is nil")
+	}
+	return nil
+}
+
+// Writer todo
+func (req *DownloadArchiveRequest) Writer() io.Writer {
+	return req.writer
+}
@@ -0,0 +1,57 @@
+package privacy
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// NewArchive 导出数据的zip归档, 每类数据保存为一个JSON文件
+func NewArchive(w io.Writer) *Archive {
+	return &Archive{
+		zw:    zip.NewWriter(w),
+		files: map[string]int64{},
+	}
+}
+
+// Archive 导出数据的归档
+type Archive struct {
+	zw    *zip.Writer
+	files map[string]int64
+}
+
+// Add 写入一类数据, count为数据的条数, 用于生成导出摘要
+func (a *Archive) Add(name string, v interface{}, count int64) error {
+	if _, ok := a.files[name]; ok {
+		return fmt.Errorf("archive file %s duplicate", name)
+	}
+
+	f, err := a.zw.CreateHeader(&zip.FileHeader{
+		Name:     name + ".json",
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("encode %s error, %s", name, err)
+	}
+
+	a.files[name] = count
+	return nil
+}
+
+// Summary 每类数据的条数
+func (a *Archive) Summary() map[string]int64 {
+	return a.files
+}
+
+// Close 写入zip目录
+func (a *Archive) Close() error {
+	return a.zw.Close()
+}
@@ -0,0 +1,46 @@
+package privacy_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/infraboard/mcenter/apps/privacy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchive(t *testing.T) {
+	should := assert.New(t)
+
+	buf := bytes.NewBuffer(nil)
+	a := privacy.NewArchive(buf)
+	should.NoError(a.Add("user", map[string]string{"username": "alice"}, 1))
+	should.NoError(a.Add("tokens", []string{}, 0))
+	should.Error(a.Add("user", nil, 0))
+	should.NoError(a.Close())
+	should.Equal(map[string]int64{"user": 1, "tokens": 0}, a.Summary())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if should.NoError(err) && should.Len(zr.File, 2) {
+		should.Equal("user.json", zr.File[0].Name)
+		f, err := zr.File[0].Open()
+		if should.NoError(err) {
+			u := map[string]string{}
+			should.NoError(json.NewDecoder(f).Decode(&u))
+			should.Equal("alice", u["username"])
+		}
+	}
+}
+
+func TestJobStatus(t *testing.T) {
+	should := assert.New(t)
+
+	job := privacy.NewJob(privacy.NewCreateJobRequest(privacy.JOB_TYPE_ERASURE, "alice-id"))
+	should.False(job.IsFinished())
+	should.False(job.HasArchive())
+	job.Failed("user %s not found", "alice")
+	should.True(job.IsFinished())
+	should.Equal("user alice not found", job.Message)
+	should.Equal("erased-alice-id", privacy.Pseudonym("alice-id"))
+}
@@ -0,0 +1,120 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/infraboard/mcenter/apps/code"
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/policy"
+	"github.com/infraboard/mcenter/apps/privacy"
+	"github.com/infraboard/mcenter/apps/retrylock"
+	"github.com/infraboard/mcenter/apps/storage"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// 注销用户, 删除授权, 令牌, 验证码和组成员关系, 审计记录中的用户名替换为匿名标识
+// 用户最后删除, 中途失败时可以重新提交任务
+func (s *service) erase(ctx context.Context, job *privacy.Job, u *user.User) error {
+	pseudonym := privacy.Pseudonym(u.Id)
+
+	policies, err := s.userPolicies(ctx, u)
+	if err != nil {
+		return err
+	}
+	for _, p := range policies {
+		if _, err := s.policy.DeletePolicy(ctx, policy.NewDeletePolicyRequestWithID(p.Id)); err != nil {
+			return fmt.Errorf("delete policy %s error, %s", p.Id, err)
+		}
+	}
+	job.Summary["policies"] = int64(len(policies))
+
+	// 只移除直接加入的组, 通过子组间接加入的父组不需要处理
+	gq := group.NewQueryUserGroupRequest(u.Spec.Domain, u.Id)
+	gq.WithParent = false
+	groups, err := s.group.QueryUserGroup(ctx, gq)
+	if err != nil {
+		return err
+	}
+	for _, g := range groups.Items {
+		if _, err := s.group.RemoveUserFromGroup(ctx, group.NewRemoveUserFromGroupRequest(g.Id, u.Id)); err != nil {
+			return fmt.Errorf("remove user from group %s error, %s", g.Spec.Name, err)
+		}
+	}
+	job.Summary["groups"] = int64(len(groups.Items))
+
+	tokens, err := s.token.DeleteUserToken(ctx, token.NewDeleteUserTokenRequest(u.Id))
+	if err != nil {
+		return err
+	}
+	job.Summary["tokens"] = tokens.Count
+
+	codes, err := s.code.DeleteUserCode(ctx, code.NewDeleteUserCodeRequest(u.Id))
+	if err != nil {
+		return err
+	}
+	job.Summary["verify_codes"] = codes.Total
+
+	attempts, err := s.retrylock.EraseFailedAttempt(ctx, retrylock.NewEraseFailedAttemptRequest(u.Spec.Domain, u.Spec.Username, pseudonym))
+	if err != nil {
+		return err
+	}
+	job.Summary["failed_attempts"] = attempts.Count
+
+	// 之前导出的归档文件包含个人数据, 一起删除
+	if err := s.eraseJobs(ctx, u, pseudonym); err != nil {
+		return err
+	}
+
+	query := user.NewQueryStatusEventRequest()
+	query.UserId = u.Id
+	events, err := s.user.QueryStatusEvent(ctx, query)
+	if err != nil {
+		return err
+	}
+	if _, err := s.user.EraseUser(ctx, user.NewEraseUserRequest(u.Id, pseudonym)); err != nil {
+		return err
+	}
+	job.Summary["status_events"] = events.Total
+	job.Summary["user"] = 1
+
+	// 任务记录中也不再保留用户名
+	job.Username = pseudonym
+	return nil
+}
+
+// 删除用户之前任务的归档文件, 任务记录中的用户名替换为匿名标识
+func (s *service) eraseJobs(ctx context.Context, u *user.User, pseudonym string) error {
+	resp, err := s.col.Find(ctx, bson.M{"spec.user_id": u.Id})
+	if err != nil {
+		return err
+	}
+
+	jobs := []*privacy.Job{}
+	for resp.Next(ctx) {
+		job := &privacy.Job{}
+		if err := resp.Decode(job); err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+	}
+
+	for _, job := range jobs {
+		if job.ArchiveId != "" {
+			err := s.storage.DeleteFile(storage.NewDeleteFileRequest(privacy.ARCHIVE_BUCKET, job.ArchiveId))
+			if err != nil && !exception.IsNotFoundError(err) {
+				return fmt.Errorf("delete archive %s error, %s", job.ArchiveId, err)
+			}
+			job.ArchiveId = ""
+		}
+		job.Username = pseudonym
+		if err := s.update(ctx, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
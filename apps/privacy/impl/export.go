@@ -0,0 +1,179 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/infraboard/mcenter/apps/code"
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/policy"
+	"github.com/infraboard/mcenter/apps/privacy"
+	"github.com/infraboard/mcenter/apps/retrylock"
+	"github.com/infraboard/mcenter/apps/storage"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// 导出用户的所有数据, 打包为zip保存到storage
+func (s *service) export(ctx context.Context, job *privacy.Job, u *user.User) error {
+	buf := bytes.NewBuffer(nil)
+	archive := privacy.NewArchive(buf)
+
+	u.Desensitize()
+	if err := archive.Add("user", u, 1); err != nil {
+		return err
+	}
+
+	tokens, err := s.userTokens(ctx, u)
+	if err != nil {
+		return err
+	}
+	for _, tk := range tokens {
+		// 只导出登录记录, 不导出令牌本身
+		tk.Desensitize()
+		tk.AccessToken, tk.RefreshToken = "", ""
+	}
+	if err := archive.Add("tokens", tokens, int64(len(tokens))); err != nil {
+		return err
+	}
+
+	policies, err := s.userPolicies(ctx, u)
+	if err != nil {
+		return err
+	}
+	if err := archive.Add("policies", policies, int64(len(policies))); err != nil {
+		return err
+	}
+
+	groups, err := s.group.QueryUserGroup(ctx, group.NewQueryUserGroupRequest(u.Spec.Domain, u.Id))
+	if err != nil {
+		return err
+	}
+	if err := archive.Add("groups", groups.Items, int64(len(groups.Items))); err != nil {
+		return err
+	}
+
+	codes, err := s.code.QueryCode(ctx, code.NewQueryCodeRequest(u.Id))
+	if err != nil {
+		return err
+	}
+	if err := archive.Add("verify_codes", codes.Items, int64(len(codes.Items))); err != nil {
+		return err
+	}
+
+	events, err := s.userStatusEvents(ctx, u)
+	if err != nil {
+		return err
+	}
+	if err := archive.Add("status_events", events, int64(len(events))); err != nil {
+		return err
+	}
+
+	attempts, err := s.userFailedAttempts(ctx, u)
+	if err != nil {
+		return err
+	}
+	if err := archive.Add("failed_attempts", attempts, int64(len(attempts))); err != nil {
+		return err
+	}
+
+	if err := archive.Close(); err != nil {
+		return err
+	}
+
+	size := int64(buf.Len())
+	upload := storage.NewUploadFileRequest(privacy.ARCHIVE_BUCKET, job.Id, io.NopCloser(buf))
+	upload.Meta()["user_id"] = u.Id
+	if err := s.storage.UploadFile(upload); err != nil {
+		return err
+	}
+
+	job.Summary = archive.Summary()
+	job.ArchiveId = job.Id
+	job.ArchiveSize = size
+	job.ArchiveExpireAt = time.Now().Add(privacy.ARCHIVE_RETAIN).UnixMilli()
+	return nil
+}
+
+// 用户的所有令牌, 包括登录记录和私有令牌
+func (s *service) userTokens(ctx context.Context, u *user.User) ([]*token.Token, error) {
+	items := []*token.Token{}
+	req := token.NewQueryTokenRequest()
+	req.UserId = u.Id
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := s.token.QueryToken(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, set.Items...)
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return items, nil
+		}
+		req.Page.PageNumber++
+	}
+}
+
+// 直接授权给用户的策略, 不包含用户组的策略
+func (s *service) userPolicies(ctx context.Context, u *user.User) ([]*policy.Policy, error) {
+	items := []*policy.Policy{}
+	req := policy.NewQueryPolicyRequest()
+	req.Domain = u.Spec.Domain
+	req.Domain = u.Spec.Domain
+	req.Username = u.Spec.Username
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := s.policy.QueryPolicy(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range set.Items {
+			if !p.IsGroupPolicy() {
+				items = append(items, p)
+			}
+		}
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return items, nil
+		}
+		req.Page.PageNumber++
+	}
+}
+
+func (s *service) userStatusEvents(ctx context.Context, u *user.User) ([]*user.StatusEvent, error) {
+	items := []*user.StatusEvent{}
+	req := user.NewQueryStatusEventRequest()
+	req.UserId = u.Id
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := s.user.QueryStatusEvent(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, set.Items...)
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return items, nil
+		}
+		req.Page.PageNumber++
+	}
+}
+
+func (s *service) userFailedAttempts(ctx context.Context, u *user.User) ([]*retrylock.FailedAttempt, error) {
+	items := []*retrylock.FailedAttempt{}
+	req := retrylock.NewQueryFailedAttemptRequest()
+	req.Domain = u.Spec.Domain
+	req.Username = u.Spec.Username
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := s.retrylock.QueryFailedAttempt(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, set.Items...)
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return items, nil
+		}
+		req.Page.PageNumber++
+	}
+}
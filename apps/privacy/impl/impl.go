@@ -0,0 +1,95 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+
+	"github.com/infraboard/mcenter/apps/code"
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/lease"
+	"github.com/infraboard/mcenter/apps/policy"
+	"github.com/infraboard/mcenter/apps/privacy"
+	"github.com/infraboard/mcenter/apps/retrylock"
+	"github.com/infraboard/mcenter/apps/storage"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
+	"github.com/infraboard/mcenter/conf"
+)
+
+var (
+	// Service 服务实例
+	svr = &service{}
+)
+
+type service struct {
+	col *mongo.Collection
+	log logger.Logger
+
+	user      user.Service
+	token     token.Service
+	policy    policy.Service
+	group     group.Service
+	code      code.Service
+	retrylock retrylock.Service
+	storage   storage.Service
+	runner    *lease.JobRunner
+}
+
+func (s *service) Config() error {
+	db, err := conf.C().Mongo.GetDB()
+	if err != nil {
+		return err
+	}
+
+	dc := db.Collection("privacy_job")
+	indexs := []mongo.IndexModel{
+		{
+			Keys: bsonx.Doc{{Key: "create_at", Value: bsonx.Int32(-1)}},
+		},
+		{
+			Keys: bsonx.Doc{{Key: "spec.user_id", Value: bsonx.Int32(-1)}},
+		},
+		{
+			// 同一个用户同时只能有一个未完成的任务
+			Keys: bsonx.Doc{{Key: "spec.user_id", Value: bsonx.Int32(1)}},
+			Options: options.Index().SetName("uniq_active_user_job").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": bson.M{"$lte": privacy.JOB_STATUS_RUNNING}}),
+		},
+	}
+	if _, err := dc.Indexes().CreateMany(context.Background(), indexs); err != nil {
+		return err
+	}
+	s.col = dc
+
+	s.log = zap.L().Named(privacy.AppName)
+	s.user = app.GetInternalApp(user.AppName).(user.Service)
+	s.token = app.GetInternalApp(token.AppName).(token.Service)
+	s.policy = app.GetInternalApp(policy.AppName).(policy.Service)
+	s.group = app.GetInternalApp(group.AppName).(group.Service)
+	s.code = app.GetInternalApp(code.AppName).(code.Service)
+	s.retrylock = app.GetInternalApp(retrylock.AppName).(retrylock.Service)
+	s.storage = app.GetInternalApp(storage.AppName).(storage.Service)
+	s.runner = lease.NewJobRunner(app.GetInternalApp(lease.AppName).(lease.Service), "privacy_job")
+
+	// 执行任务的副本退出后, 未完成的任务标记为失败
+	go s.runner.Recover(s.activeJobs, s.interruptJob)
+
+	// 后台删除过期的归档文件
+	go s.runArchiveCleaner()
+	return nil
+}
+
+func (s *service) Name() string {
+	return privacy.AppName
+}
+
+func init() {
+	app.RegistryInternalApp(svr)
+}
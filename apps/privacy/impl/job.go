@@ -0,0 +1,244 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/infraboard/mcenter/apps/privacy"
+	"github.com/infraboard/mcenter/apps/storage"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+const (
+	// 检查过期归档文件的间隔
+	ARCHIVE_CLEAN_INTERVAL = time.Hour
+	// 扫描数据时的分页大小
+	SCAN_PAGE_SIZE = 200
+)
+
+// 创建导出或者注销任务, 任务在后台执行
+func (s *service) CreateJob(ctx context.Context, req *privacy.CreateJobRequest) (*privacy.Job, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	u, err := s.user.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	if req.Type.Equal(privacy.JOB_TYPE_ERASURE) && u.Spec.Type.Equal(user.TYPE_SUPPER) {
+		return nil, exception.NewBadRequest("supper user %s can not be erased", u.Spec.Username)
+	}
+
+	job := privacy.NewJob(req)
+	job.Domain = u.Spec.Domain
+	job.Username = u.Spec.Username
+
+	// 同一个用户同时只能有一个未完成的任务, 由唯一索引保证
+	err = s.runner.Start(ctx, job.Id, func(ctx context.Context) error {
+		if _, err := s.col.InsertOne(ctx, job); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return exception.NewConflict("user %s has unfinished job", u.Spec.Username)
+			}
+			return exception.NewInternalServerError("insert privacy job error, %s", err)
+		}
+		return nil
+	}, func(ctx context.Context) {
+		s.run(ctx, job, u)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (s *service) QueryJob(ctx context.Context, req *privacy.QueryJobRequest) (*privacy.JobSet, error) {
+	filter := bson.M{}
+	if req.Domain != "" {
+		filter["domain"] = req.Domain
+	}
+	if req.UserId != "" {
+		filter["spec.user_id"] = req.UserId
+	}
+	if req.Type != nil {
+		filter["spec.type"] = *req.Type
+	}
+
+	pageSize := int64(req.Page.PageSize)
+	skip := req.Page.ComputeOffset()
+	opt := &options.FindOptions{
+		Sort:  bson.D{{Key: "create_at", Value: -1}},
+		Limit: &pageSize,
+		Skip:  &skip,
+	}
+	resp, err := s.col.Find(ctx, filter, opt)
+	if err != nil {
+		return nil, exception.NewInternalServerError("find privacy job error, %s", err)
+	}
+
+	set := privacy.NewJobSet()
+	for resp.Next(ctx) {
+		ins := &privacy.Job{}
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode privacy job error, %s", err)
+		}
+		set.Add(ins)
+	}
+
+	count, err := s.col.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, exception.NewInternalServerError("get privacy job count error, %s", err)
+	}
+	set.Total = count
+	return set, nil
+}
+
+func (s *service) DescribeJob(ctx context.Context, req *privacy.DescribeJobRequest) (*privacy.Job, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins := &privacy.Job{}
+	if err := s.col.FindOne(ctx, bson.M{"_id": req.Id}).Decode(ins); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, exception.NewNotFound("privacy job %s not found", req.Id)
+		}
+		return nil, exception.NewInternalServerError("find privacy job %s error, %s", req.Id, err)
+	}
+	return ins, nil
+}
+
+// 下载导出任务的归档文件
+func (s *service) DownloadArchive(ctx context.Context, req *privacy.DownloadArchiveRequest) (*privacy.Job, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	job, err := s.DescribeJob(ctx, privacy.NewDescribeJobRequest(req.JobId))
+	if err != nil {
+		return nil, err
+	}
+	if !job.HasArchive() {
+		return nil, exception.NewNotFound("job %s archive not found or expired", job.Id)
+	}
+
+	err = s.storage.Download(storage.NewDownloadFileRequest(privacy.ARCHIVE_BUCKET, job.ArchiveId, req.Writer()))
+	if err != nil {
+		return nil, exception.NewInternalServerError("download archive error, %s", err)
+	}
+	return job, nil
+}
+
+// 执行任务, 结果保存到任务中, 租约续约失败时ctx被取消
+func (s *service) run(ctx context.Context, job *privacy.Job, u *user.User) {
+	job.Status = privacy.JOB_STATUS_RUNNING
+	if err := s.update(ctx, job); err != nil {
+		s.log.Errorf("update privacy job %s error, %s", job.Id, err)
+		return
+	}
+
+	var err error
+	switch job.Spec.Type {
+	case privacy.JOB_TYPE_EXPORT:
+		err = s.export(ctx, job, u)
+	case privacy.JOB_TYPE_ERASURE:
+		err = s.erase(ctx, job, u)
+	}
+	if err != nil {
+		s.log.Errorf("privacy job %s %s user %s failed, %s", job.Id, job.Spec.Type, job.Spec.UserId, err)
+		job.Failed("%s", err)
+	} else {
+		job.Succeed()
+	}
+
+	if err := s.update(ctx, job); err != nil {
+		s.log.Errorf("update privacy job %s error, %s", job.Id, err)
+	}
+}
+
+// 未完成的任务
+func (s *service) activeJobs(ctx context.Context) ([]string, error) {
+	resp, err := s.col.Find(ctx, bson.M{"status": bson.M{"$lte": privacy.JOB_STATUS_RUNNING}})
+	if err != nil {
+		return nil, exception.NewInternalServerError("find active privacy job error, %s", err)
+	}
+
+	ids := []string{}
+	for resp.Next(ctx) {
+		job := &privacy.Job{}
+		if err := resp.Decode(job); err != nil {
+			return nil, exception.NewInternalServerError("decode privacy job error, %s", err)
+		}
+		ids = append(ids, job.Id)
+	}
+	return ids, nil
+}
+
+// 执行任务的副本已经退出, 任务不会再执行, 标记为失败
+func (s *service) interruptJob(ctx context.Context, id string) error {
+	_, err := s.col.UpdateOne(ctx,
+		bson.M{"_id": id, "status": bson.M{"$lte": privacy.JOB_STATUS_RUNNING}},
+		bson.M{"$set": bson.M{
+			"status":      privacy.JOB_STATUS_FAILED,
+			"finished_at": time.Now().UnixMilli(),
+			"message":     "interrupted, the replica running it exited",
+		}},
+	)
+	if err != nil {
+		return exception.NewInternalServerError("interrupt privacy job %s error, %s", id, err)
+	}
+	s.log.Warnf("privacy job %s interrupted", id)
+	return nil
+}
+
+func (s *service) update(ctx context.Context, job *privacy.Job) error {
+	if _, err := s.col.ReplaceOne(ctx, bson.M{"_id": job.Id}, job); err != nil {
+		return exception.NewInternalServerError("update privacy job %s error, %s", job.Id, err)
+	}
+	return nil
+}
+
+// 删除过期的归档文件
+func (s *service) runArchiveCleaner() {
+	tk := time.NewTicker(ARCHIVE_CLEAN_INTERVAL)
+	defer tk.Stop()
+
+	for range tk.C {
+		if err := s.cleanArchive(context.Background()); err != nil {
+			s.log.Errorf("clean expired archive error, %s", err)
+		}
+	}
+}
+
+func (s *service) cleanArchive(ctx context.Context) error {
+	filter := bson.M{
+		"archive_id":        bson.M{"$ne": ""},
+		"archive_expire_at": bson.M{"$lte": time.Now().UnixMilli()},
+	}
+	resp, err := s.col.Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	for resp.Next(ctx) {
+		job := &privacy.Job{}
+		if err := resp.Decode(job); err != nil {
+			return err
+		}
+		err := s.storage.DeleteFile(storage.NewDeleteFileRequest(privacy.ARCHIVE_BUCKET, job.ArchiveId))
+		if err != nil && !exception.IsNotFoundError(err) {
+			s.log.Errorf("delete archive %s error, %s", job.ArchiveId, err)
+			continue
+		}
+		job.ArchiveId = ""
+		if err := s.update(ctx, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
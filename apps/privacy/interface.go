@@ -0,0 +1,17 @@
+package privacy
+
+import (
+	"context"
+)
+
+// Service 数据主体请求, 用于处理用户的数据导出和注销请求
+type Service interface {
+	// 创建导出或者注销任务, 任务在后台执行
+	CreateJob(context.Context, *CreateJobRequest) (*Job, error)
+	// 查询任务
+	QueryJob(context.Context, *QueryJobRequest) (*JobSet, error)
+	// 查询任务详情
+	DescribeJob(context.Context, *DescribeJobRequest) (*Job, error)
+	// 下载导出任务的归档文件
+	DownloadArchive(context.Context, *DownloadArchiveRequest) (*Job, error)
+}
@@ -0,0 +1,771 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: apps/privacy/pb/privacy.proto
+
+package privacy
+
+import (
+	request "github.com/infraboard/mcube/http/request"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 数据主体请求类型
+type JOB_TYPE int32
+
+const (
+	// 导出用户的所有数据
+	JOB_TYPE_EXPORT JOB_TYPE = 0
+	// 注销用户, 删除个人数据, 审计记录匿名化后保留
+	JOB_TYPE_ERASURE JOB_TYPE = 1
+)
+
+// Enum value maps for JOB_TYPE.
+var (
+	JOB_TYPE_name = map[int32]string{
+		0: "EXPORT",
+		1: "ERASURE",
+	}
+	JOB_TYPE_value = map[string]int32{
+		"EXPORT":  0,
+		"ERASURE": 1,
+	}
+)
+
+func (x JOB_TYPE) Enum() *JOB_TYPE {
+	p := new(JOB_TYPE)
+	*p = x
+	return p
+}
+
+func (x JOB_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JOB_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_privacy_pb_privacy_proto_enumTypes[0].Descriptor()
+}
+
+func (JOB_TYPE) Type() protoreflect.EnumType {
+	return &file_apps_privacy_pb_privacy_proto_enumTypes[0]
+}
+
+func (x JOB_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JOB_TYPE.Descriptor instead.
+func (JOB_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_apps_privacy_pb_privacy_proto_rawDescGZIP(), []int{0}
+}
+
+// 任务状态
+type JOB_STATUS int32
+
+const (
+	// 等待执行
+	JOB_STATUS_PENDING JOB_STATUS = 0
+	// 执行中
+	JOB_STATUS_RUNNING JOB_STATUS = 1
+	// 执行成功
+	JOB_STATUS_SUCCEEDED JOB_STATUS = 2
+	// 执行失败
+	JOB_STATUS_FAILED JOB_STATUS = 3
+)
+
+// Enum value maps for JOB_STATUS.
+var (
+	JOB_STATUS_name = map[int32]string{
+		0: "PENDING",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	JOB_STATUS_value = map[string]int32{
+		"PENDING":   0,
+		"RUNNING":   1,
+		"SUCCEEDED": 2,
+		"FAILED":    3,
+	}
+)
+
+func (x JOB_STATUS) Enum() *JOB_STATUS {
+	p := new(JOB_STATUS)
+	*p = x
+	return p
+}
+
+func (x JOB_STATUS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JOB_STATUS) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_privacy_pb_privacy_proto_enumTypes[1].Descriptor()
+}
+
+func (JOB_STATUS) Type() protoreflect.EnumType {
+	return &file_apps_privacy_pb_privacy_proto_enumTypes[1]
+}
+
+func (x JOB_STATUS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JOB_STATUS.Descriptor instead.
+func (JOB_STATUS) EnumDescriptor() ([]byte, []int) {
+	return file_apps_privacy_pb_privacy_proto_rawDescGZIP(), []int{1}
+}
+
+// Job 数据主体请求(导出/注销)任务
+type Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 任务Id
+	// @gotags: bson:"_id" json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id"`
+	// 创建时间
+	// @gotags: bson:"create_at" json:"create_at"
+	CreateAt int64 `protobuf:"varint,2,opt,name=create_at,json=createAt,proto3" json:"create_at" bson:"create_at"`
+	// 任务定义
+	// @gotags: bson:"spec" json:"spec"
+	Spec *CreateJobRequest `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec" bson:"spec"`
+	// 用户所属域
+	// @gotags: bson:"domain" json:"domain"
+	Domain string `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain" bson:"domain"`
+	// 用户名, 注销完成后替换为匿名标识
+	// @gotags: bson:"username" json:"username"
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username" bson:"username"`
+	// 任务状态
+	// @gotags: bson:"status" json:"status"
+	Status JOB_STATUS `protobuf:"varint,6,opt,name=status,proto3,enum=infraboard.mcenter.privacy.JOB_STATUS" json:"status" bson:"status"`
+	// 完成时间
+	// @gotags: bson:"finished_at" json:"finished_at"
+	FinishedAt int64 `protobuf:"varint,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at" bson:"finished_at"`
+	// 失败原因
+	// @gotags: bson:"message" json:"message"
+	Message string `protobuf:"bytes,8,opt,name=message,proto3" json:"message" bson:"message"`
+	// 每类数据的数量, 导出时为导出的数量, 注销时为删除或者匿名化的数量
+	// @gotags: bson:"summary" json:"summary"
+	Summary map[string]int64 `protobuf:"bytes,9,rep,name=summary,proto3" json:"summary" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3" bson:"summary"`
+	// 导出的归档文件Id, 保存在storage中
+	// @gotags: bson:"archive_id" json:"archive_id"
+	ArchiveId string `protobuf:"bytes,10,opt,name=archive_id,json=archiveId,proto3" json:"archive_id" bson:"archive_id"`
+	// 归档文件大小
+	// @gotags: bson:"archive_size" json:"archive_size"
+	ArchiveSize int64 `protobuf:"varint,11,opt,name=archive_size,json=archiveSize,proto3" json:"archive_size" bson:"archive_size"`
+	// 归档文件过期时间, 过期后删除
+	// @gotags: bson:"archive_expire_at" json:"archive_expire_at"
+	ArchiveExpireAt int64 `protobuf:"varint,12,opt,name=archive_expire_at,json=archiveExpireAt,proto3" json:"archive_expire_at" bson:"archive_expire_at"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_privacy_pb_privacy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_privacy_pb_privacy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_apps_privacy_pb_privacy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetCreateAt() int64 {
+	if x != nil {
+		return x.CreateAt
+	}
+	return 0
+}
+
+func (x *Job) GetSpec() *CreateJobRequest {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Job) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Job) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Job) GetStatus() JOB_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return JOB_STATUS_PENDING
+}
+
+func (x *Job) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *Job) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Job) GetSummary() map[string]int64 {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *Job) GetArchiveId() string {
+	if x != nil {
+		return x.ArchiveId
+	}
+	return ""
+}
+
+func (x *Job) GetArchiveSize() int64 {
+	if x != nil {
+		return x.ArchiveSize
+	}
+	return 0
+}
+
+func (x *Job) GetArchiveExpireAt() int64 {
+	if x != nil {
+		return x.ArchiveExpireAt
+	}
+	return 0
+}
+
+type JobSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 总数量
+	// @gotags: bson:"total" json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total" bson:"total"`
+	// 数据项
+	// @gotags: bson:"items" json:"items"
+	Items []*Job `protobuf:"bytes,2,rep,name=items,proto3" json:"items" bson:"items"`
+}
+
+func (x *JobSet) Reset() {
+	*x = JobSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_privacy_pb_privacy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSet) ProtoMessage() {}
+
+func (x *JobSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_privacy_pb_privacy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSet.ProtoReflect.Descriptor instead.
+func (*JobSet) Descriptor() ([]byte, []int) {
+	return file_apps_privacy_pb_privacy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JobSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *JobSet) GetItems() []*Job {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// CreateJobRequest 创建数据主体请求任务
+type CreateJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 任务类型
+	// @gotags: bson:"type" json:"type"
+	Type JOB_TYPE `protobuf:"varint,1,opt,name=type,proto3,enum=infraboard.mcenter.privacy.JOB_TYPE" json:"type" bson:"type"`
+	// 用户Id
+	// @gotags: bson:"user_id" json:"user_id" validate:"required"
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id" bson:"user_id" validate:"required"`
+	// 操作人
+	// @gotags: bson:"operator" json:"operator"
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator" bson:"operator"`
+	// 请求原因, 比如工单编号
+	// @gotags: bson:"reason" json:"reason" validate:"lte=200"
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason" bson:"reason" validate:"lte=200"`
+}
+
+func (x *CreateJobRequest) Reset() {
+	*x = CreateJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_privacy_pb_privacy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJobRequest) ProtoMessage() {}
+
+func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_privacy_pb_privacy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateJobRequest) Descriptor() ([]byte, []int) {
+	return file_apps_privacy_pb_privacy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateJobRequest) GetType() JOB_TYPE {
+	if x != nil {
+		return x.Type
+	}
+	return JOB_TYPE_EXPORT
+}
+
+func (x *CreateJobRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// QueryJobRequest 查询任务
+type QueryJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分页参数
+	// @gotags: json:"page"
+	Page *request.PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page"`
+	// 用户所属域
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+	// 用户Id
+	// @gotags: json:"user_id"
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	// 任务类型
+	// @gotags: json:"type"
+	Type *JOB_TYPE `protobuf:"varint,4,opt,name=type,proto3,enum=infraboard.mcenter.privacy.JOB_TYPE,oneof" json:"type"`
+}
+
+func (x *QueryJobRequest) Reset() {
+	*x = QueryJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_privacy_pb_privacy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryJobRequest) ProtoMessage() {}
+
+func (x *QueryJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_privacy_pb_privacy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryJobRequest.ProtoReflect.Descriptor instead.
+func (*QueryJobRequest) Descriptor() ([]byte, []int) {
+	return file_apps_privacy_pb_privacy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryJobRequest) GetPage() *request.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *QueryJobRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *QueryJobRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QueryJobRequest) GetType() JOB_TYPE {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return JOB_TYPE_EXPORT
+}
+
+// DescribeJobRequest 查询任务详情
+type DescribeJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 任务Id
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required"`
+}
+
+func (x *DescribeJobRequest) Reset() {
+	*x = DescribeJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_privacy_pb_privacy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeJobRequest) ProtoMessage() {}
+
+func (x *DescribeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_privacy_pb_privacy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeJobRequest.ProtoReflect.Descriptor instead.
+func (*DescribeJobRequest) Descriptor() ([]byte, []int) {
+	return file_apps_privacy_pb_privacy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DescribeJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_apps_privacy_pb_privacy_proto protoreflect.FileDescriptor
+
+var file_apps_privacy_pb_privacy_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x2f, 0x70,
+	0x62, 0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x1a, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x1a, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2f, 0x6d, 0x63, 0x75, 0x62, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x61, 0x67, 0x65,
+	0x2f, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x04, 0x0a, 0x03,
+	0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x12, 0x40, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x63, 0x79, 0x2e, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x46, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x2e,
+	0x4a, 0x6f, 0x62, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x55, 0x0a, 0x06, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x2e,
+	0x4a, 0x6f, 0x62, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x2e, 0x4a, 0x4f, 0x42, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x70, 0x61, 0x67, 0x65,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x2e, 0x4a,
+	0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x48, 0x00, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x2a, 0x23, 0x0a, 0x08, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x0a, 0x0a,
+	0x06, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x52, 0x41,
+	0x53, 0x55, 0x52, 0x45, 0x10, 0x01, 0x2a, 0x41, 0x0a, 0x0a, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_privacy_pb_privacy_proto_rawDescOnce sync.Once
+	file_apps_privacy_pb_privacy_proto_rawDescData = file_apps_privacy_pb_privacy_proto_rawDesc
+)
+
+func file_apps_privacy_pb_privacy_proto_rawDescGZIP() []byte {
+	file_apps_privacy_pb_privacy_proto_rawDescOnce.Do(func() {
+		file_apps_privacy_pb_privacy_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_privacy_pb_privacy_proto_rawDescData)
+	})
+	return file_apps_privacy_pb_privacy_proto_rawDescData
+}
+
+var file_apps_privacy_pb_privacy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_apps_privacy_pb_privacy_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_apps_privacy_pb_privacy_proto_goTypes = []interface{}{
+	(JOB_TYPE)(0),               // 0: infraboard.mcenter.privacy.JOB_TYPE
+	(JOB_STATUS)(0),             // 1: infraboard.mcenter.privacy.JOB_STATUS
+	(*Job)(nil),                 // 2: infraboard.mcenter.privacy.Job
+	(*JobSet)(nil),              // 3: infraboard.mcenter.privacy.JobSet
+	(*CreateJobRequest)(nil),    // 4: infraboard.mcenter.privacy.CreateJobRequest
+	(*QueryJobRequest)(nil),     // 5: infraboard.mcenter.privacy.QueryJobRequest
+	(*DescribeJobRequest)(nil),  // 6: infraboard.mcenter.privacy.DescribeJobRequest
+	nil,                         // 7: infraboard.mcenter.privacy.Job.SummaryEntry
+	(*request.PageRequest)(nil), // 8: infraboard.mcube.page.PageRequest
+}
+var file_apps_privacy_pb_privacy_proto_depIdxs = []int32{
+	4, // 0: infraboard.mcenter.privacy.Job.spec:type_name -> infraboard.mcenter.privacy.CreateJobRequest
+	1, // 1: infraboard.mcenter.privacy.Job.status:type_name -> infraboard.mcenter.privacy.JOB_STATUS
+	7, // 2: infraboard.mcenter.privacy.Job.summary:type_name -> infraboard.mcenter.privacy.Job.SummaryEntry
+	2, // 3: infraboard.mcenter.privacy.JobSet.items:type_name -> infraboard.mcenter.privacy.Job
+	0, // 4: infraboard.mcenter.privacy.CreateJobRequest.type:type_name -> infraboard.mcenter.privacy.JOB_TYPE
+	8, // 5: infraboard.mcenter.privacy.QueryJobRequest.page:type_name -> infraboard.mcube.page.PageRequest
+	0, // 6: infraboard.mcenter.privacy.QueryJobRequest.type:type_name -> infraboard.mcenter.privacy.JOB_TYPE
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_apps_privacy_pb_privacy_proto_init() }
+func file_apps_privacy_pb_privacy_proto_init() {
+	if File_apps_privacy_pb_privacy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_privacy_pb_privacy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_privacy_pb_privacy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_privacy_pb_privacy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_privacy_pb_privacy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_privacy_pb_privacy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_apps_privacy_pb_privacy_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_privacy_pb_privacy_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apps_privacy_pb_privacy_proto_goTypes,
+		DependencyIndexes: file_apps_privacy_pb_privacy_proto_depIdxs,
+		EnumInfos:         file_apps_privacy_pb_privacy_proto_enumTypes,
+		MessageInfos:      file_apps_privacy_pb_privacy_proto_msgTypes,
+	}.Build()
+	File_apps_privacy_pb_privacy_proto = out.File
+	file_apps_privacy_pb_privacy_proto_rawDesc = nil
+	file_apps_privacy_pb_privacy_proto_goTypes = nil
+	file_apps_privacy_pb_privacy_proto_depIdxs = nil
+}
@@ -0,0 +1,100 @@
+// Code generated by github.com/infraboard/mcube
+// DO NOT EDIT
+
+package privacy
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseJOB_TYPEFromString Parse JOB_TYPE from string
+func ParseJOB_TYPEFromString(str string) (JOB_TYPE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := JOB_TYPE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown JOB_TYPE: %s", str)
+	}
+
+	return JOB_TYPE(v), nil
+}
+
+// Equal type compare
+func (t JOB_TYPE) Equal(target JOB_TYPE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t JOB_TYPE) IsIn(targets ...JOB_TYPE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t JOB_TYPE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *JOB_TYPE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseJOB_TYPEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseJOB_STATUSFromString Parse JOB_STATUS from string
+func ParseJOB_STATUSFromString(str string) (JOB_STATUS, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := JOB_STATUS_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown JOB_STATUS: %s", str)
+	}
+
+	return JOB_STATUS(v), nil
+}
+
+// Equal type compare
+func (t JOB_STATUS) Equal(target JOB_STATUS) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t JOB_STATUS) IsIn(targets ...JOB_STATUS) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t JOB_STATUS) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *JOB_STATUS) UnmarshalJSON(b []byte) error {
+	ins, err := ParseJOB_STATUSFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
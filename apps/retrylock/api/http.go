@@ -0,0 +1,93 @@
+package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/http/restful/response"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/mcenter/apps/retrylock"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service retrylock.Service
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(retrylock.AppName)
+	h.service = app.GetInternalApp(retrylock.AppName).(retrylock.Service)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return retrylock.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"登录失败锁定"}
+
+	ws.Route(ws.GET("/").To(h.QueryRetryLock).
+		Doc("查询登录失败的账号, 包含失败次数和解锁时间").
+		Param(ws.QueryParameter("domain", "用户所属域").DataType("string")).
+		Param(ws.QueryParameter("only_locked", "true时只查询当前处于锁定状态的账号").DataType("boolean")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", retrylock.RetryLockSet{}))
+
+	ws.Route(ws.DELETE("/{key}").To(h.Unlock).
+		Doc("提前解锁, 同时清除失败次数").
+		Param(ws.PathParameter("key", "锁定的Key, 通常为用户名").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", retrylock.RetryLock{}))
+
+	ws.Route(ws.GET("/attempts").To(h.QueryFailedAttempt).
+		Doc("查询最近的登录失败记录").
+		Param(ws.QueryParameter("domain", "用户所属域").DataType("string")).
+		Param(ws.QueryParameter("username", "用户名").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", retrylock.FailedAttemptSet{}))
+}
+
+func (h *handler) QueryRetryLock(r *restful.Request, w *restful.Response) {
+	req := retrylock.NewQueryRetryLockRequestFromHTTP(r.Request)
+	set, err := h.service.QueryRetryLock(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *handler) Unlock(r *restful.Request, w *restful.Response) {
+	req := retrylock.NewUnlockRequest(r.PathParameter("key"))
+	ins, err := h.service.Unlock(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) QueryFailedAttempt(r *restful.Request, w *restful.Response) {
+	req := retrylock.NewQueryFailedAttemptRequestFromHTTP(r.Request)
+	set, err := h.service.QueryFailedAttempt(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
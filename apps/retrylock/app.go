@@ -0,0 +1,167 @@
+package retrylock
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infraboard/mcube/http/request"
+	"github.com/rs/xid"
+)
+
+const (
+	AppName = "retrylock"
+)
+
+var (
+	validate = validator.New()
+)
+
+// NewRetryLock 第一次失败时创建
+func NewRetryLock(key string, attempt *FailedAttempt) *RetryLock {
+	return &RetryLock{
+		Key:           key,
+		Domain:        attempt.Domain,
+		Username:      attempt.Username,
+		FirstFailedAt: attempt.CreateAt,
+	}
+}
+
+// IsExpired 记录是否过期, 过期后失败次数重新计算
+func (l *RetryLock) IsExpired() bool {
+	return time.Now().UnixMilli() >= l.ExpireAt
+}
+
+// IsLocked 当前是否处于锁定状态
+func (l *RetryLock) IsLocked() bool {
+	return l.LockedAt > 0 && time.Now().UnixMilli() < l.UnlockAt
+}
+
+// Fail 记录一次失败, 失败次数达到限制后锁定, 返回本次是否触发锁定
+func (l *RetryLock) Fail(at int64, limit, lockedMinite uint32) bool {
+	l.Count++
+	l.LastFailedAt = at
+	period := time.Duration(lockedMinite) * time.Minute
+	if l.ExpireAt == 0 {
+		l.ExpireAt = time.UnixMilli(l.FirstFailedAt).Add(period).UnixMilli()
+	}
+	if l.Count >= limit && l.LockedAt == 0 {
+		l.LockedAt = at
+		l.UnlockAt = time.UnixMilli(at).Add(period).UnixMilli()
+		l.ExpireAt = l.UnlockAt
+		return true
+	}
+	return false
+}
+
+// TTL 记录剩余的有效时间
+func (l *RetryLock) TTL() time.Duration {
+	return time.Until(time.UnixMilli(l.ExpireAt))
+}
+
+func NewRetryLockSet() *RetryLockSet {
+	return &RetryLockSet{
+		Items: []*RetryLock{},
+	}
+}
+
+func (s *RetryLockSet) Add(item *RetryLock) {
+	s.Items = append(s.Items, item)
+}
+
+// NewFailedAttempt 登录失败记录
+func NewFailedAttempt(domain, username, reason string) *FailedAttempt {
+	return &FailedAttempt{
+		Id:       xid.New().String(),
+		CreateAt: time.Now().UnixMilli(),
+		Domain:   domain,
+		Username: username,
+		Reason:   reason,
+	}
+}
+
+func NewFailedAttemptSet() *FailedAttemptSet {
+	return &FailedAttemptSet{
+		Items: []*FailedAttempt{},
+	}
+}
+
+func (s *FailedAttemptSet) Add(item *FailedAttempt) {
+	s.Items = append(s.Items, item)
+}
+
+func NewRecordFailureRequest(key string, attempt *FailedAttempt, limit, lockedMinite uint32) *RecordFailureRequest {
+	return &RecordFailureRequest{
+		Key:          key,
+		Attempt:      attempt,
+		RetryLimite:  limit,
+		LockedMinite: lockedMinite,
+	}
+}
+
+func (req *RecordFailureRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewQueryRetryLockRequest() *QueryRetryLockRequest {
+	return &QueryRetryLockRequest{
+		Page: request.NewPageRequest(20, 1),
+	}
+}
+
+func NewQueryRetryLockRequestFromHTTP(r *http.Request) *QueryRetryLockRequest {
+	qs := r.URL.Query()
+	req := NewQueryRetryLockRequest()
+	req.Page = request.NewPageRequestFromHTTP(r)
+	req.Domain = qs.Get("domain")
+	req.OnlyLocked = qs.Get("only_locked") == "true"
+	return req
+}
+
+// Match 记录是否满足查询条件
+func (req *QueryRetryLockRequest) Match(l *RetryLock) bool {
+	if req.Domain != "" && l.Domain != req.Domain {
+		return false
+	}
+	if req.OnlyLocked && !l.IsLocked() {
+		return false
+	}
+	return true
+}
+
+func NewUnlockRequest(key string) *UnlockRequest {
+	return &UnlockRequest{
+		Key: key,
+	}
+}
+
+func (req *UnlockRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewQueryFailedAttemptRequest() *QueryFailedAttemptRequest {
+	return &QueryFailedAttemptRequest{
+		Page: request.NewPageRequest(20, 1),
+	}
+}
+
+func NewQueryFailedAttemptRequestFromHTTP(r *http.Request) *QueryFailedAttemptRequest {
+	qs := r.URL.Query()
+	req := NewQueryFailedAttemptRequest()
+	req.Page = request.NewPageRequestFromHTTP(r)
+	req.Domain = qs.Get("domain")
+	req.Username = qs.Get("username")
+	return req
+}
+
+func NewEraseFailedAttemptRequest(domain, username, pseudonym string) *EraseFailedAttemptRequest {
+	return &EraseFailedAttemptRequest{
+		Domain:    domain,
+		Username:  username,
+		Pseudonym: pseudonym,
+	}
+}
+
+func (req *EraseFailedAttemptRequest) Validate() error {
+	return validate.Struct(req)
+}
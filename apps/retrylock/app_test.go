@@ -0,0 +1,46 @@
+package retrylock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/infraboard/mcenter/apps/retrylock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryLockFail(t *testing.T) {
+	should := assert.New(t)
+
+	attempt := retrylock.NewFailedAttempt("default", "alice", "password not correct")
+	l := retrylock.NewRetryLock("alice", attempt)
+	should.False(l.Fail(attempt.CreateAt, 3, 30))
+	should.False(l.Fail(time.Now().UnixMilli(), 3, 30))
+	should.False(l.IsLocked())
+	should.False(l.IsExpired())
+
+	should.True(l.Fail(time.Now().UnixMilli(), 3, 30))
+	should.True(l.IsLocked())
+	should.Equal(uint32(3), l.Count)
+	should.InDelta(30*time.Minute, l.TTL(), float64(time.Second))
+
+	// 锁定后继续失败不会延长锁定时间
+	unlockAt := l.UnlockAt
+	should.False(l.Fail(time.Now().UnixMilli(), 3, 30))
+	should.Equal(unlockAt, l.UnlockAt)
+}
+
+func TestQueryRetryLockMatch(t *testing.T) {
+	should := assert.New(t)
+
+	attempt := retrylock.NewFailedAttempt("default", "alice", "")
+	l := retrylock.NewRetryLock("alice", attempt)
+	l.Fail(attempt.CreateAt, 5, 30)
+
+	req := retrylock.NewQueryRetryLockRequest()
+	should.True(req.Match(l))
+	req.OnlyLocked = true
+	should.False(req.Match(l))
+	req.OnlyLocked = false
+	req.Domain = "other"
+	should.False(req.Match(l))
+}
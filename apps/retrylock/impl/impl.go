@@ -0,0 +1,75 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/cache"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+
+	"github.com/infraboard/mcenter/apps/retrylock"
+	"github.com/infraboard/mcenter/conf"
+)
+
+var (
+	// Service 服务实例
+	svr = &service{}
+)
+
+type service struct {
+	store   store
+	attempt *mongo.Collection
+	log     logger.Logger
+}
+
+func (s *service) Config() error {
+	db, err := conf.C().Mongo.GetDB()
+	if err != nil {
+		return err
+	}
+
+	ac := db.Collection("retry_lock_attempt")
+	indexs := []mongo.IndexModel{
+		{
+			Keys: bsonx.Doc{{Key: "create_at", Value: bsonx.Int32(-1)}},
+		},
+		{
+			Keys: bsonx.Doc{
+				{Key: "username", Value: bsonx.Int32(1)},
+				{Key: "create_at", Value: bsonx.Int32(-1)},
+			},
+		},
+	}
+	if _, err := ac.Indexes().CreateMany(context.Background(), indexs); err != nil {
+		return err
+	}
+	s.attempt = ac
+
+	switch conf.C().RetryLock.Store {
+	case "cache", "":
+		s.store = newCacheStore(cache.C())
+	case "mongo":
+		s.store, err = newMongoStore(db.Collection("retry_lock"))
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown retry lock store type: %s", conf.C().RetryLock.Store)
+	}
+
+	s.log = zap.L().Named(retrylock.AppName)
+	go s.runCleanup()
+	return nil
+}
+
+func (s *service) Name() string {
+	return retrylock.AppName
+}
+
+func init() {
+	app.RegistryInternalApp(svr)
+}
@@ -0,0 +1,177 @@
+package impl
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/infraboard/mcenter/apps/retrylock"
+	"github.com/infraboard/mcenter/conf"
+)
+
+const (
+	// 清理过期记录的间隔
+	CLEANUP_INTERVAL = time.Hour
+)
+
+func (s *service) DescribeRetryLock(ctx context.Context, key string) (*retrylock.RetryLock, error) {
+	return s.store.Get(ctx, key)
+}
+
+// 记录一次登录失败, 统计周期内失败次数达到限制后锁定
+func (s *service) RecordFailure(ctx context.Context, req *retrylock.RecordFailureRequest) (
+	*retrylock.RetryLock, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+	if req.Attempt == nil {
+		req.Attempt = retrylock.NewFailedAttempt("", "", "")
+	}
+
+	l, err := s.store.Fail(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.attempt.InsertOne(ctx, req.Attempt); err != nil {
+		s.log.Errorf("save failed attempt error, %s", err)
+	}
+	if req.Attempt.Locked {
+		s.log.Infof("%s locked until %s after %d failed attempts", req.Key,
+			time.UnixMilli(l.UnlockAt).Format(time.RFC3339), l.Count)
+	}
+	return l, nil
+}
+
+// 登录成功后清除失败次数
+func (s *service) ResetRetryLock(ctx context.Context, key string) error {
+	return s.store.Delete(ctx, key)
+}
+
+func (s *service) QueryRetryLock(ctx context.Context, req *retrylock.QueryRetryLockRequest) (
+	*retrylock.RetryLockSet, error) {
+	items, err := s.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := []*retrylock.RetryLock{}
+	for _, l := range items {
+		if req.Match(l) {
+			matched = append(matched, l)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].LastFailedAt > matched[j].LastFailedAt
+	})
+
+	set := retrylock.NewRetryLockSet()
+	set.Total = int64(len(matched))
+	start := int(req.Page.ComputeOffset())
+	for i := start; i < len(matched) && i < start+int(req.Page.PageSize); i++ {
+		set.Add(matched[i])
+	}
+	return set, nil
+}
+
+// 管理员提前解锁, 同时清除失败次数
+func (s *service) Unlock(ctx context.Context, req *retrylock.UnlockRequest) (*retrylock.RetryLock, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	l, err := s.store.Get(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.Delete(ctx, req.Key); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (s *service) QueryFailedAttempt(ctx context.Context, req *retrylock.QueryFailedAttemptRequest) (
+	*retrylock.FailedAttemptSet, error) {
+	filter := bson.M{}
+	if req.Domain != "" {
+		filter["domain"] = req.Domain
+	}
+	if req.Username != "" {
+		filter["username"] = req.Username
+	}
+
+	pageSize := int64(req.Page.PageSize)
+	skip := req.Page.ComputeOffset()
+	opt := &options.FindOptions{
+		Sort:  bson.D{{Key: "create_at", Value: -1}},
+		Limit: &pageSize,
+		Skip:  &skip,
+	}
+	resp, err := s.attempt.Find(ctx, filter, opt)
+	if err != nil {
+		return nil, exception.NewInternalServerError("find failed attempt error, %s", err)
+	}
+
+	set := retrylock.NewFailedAttemptSet()
+	for resp.Next(ctx) {
+		ins := &retrylock.FailedAttempt{}
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode failed attempt error, %s", err)
+		}
+		set.Add(ins)
+	}
+
+	count, err := s.attempt.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, exception.NewInternalServerError("get failed attempt count error, %s", err)
+	}
+	set.Total = count
+	return set, nil
+}
+
+// 匿名化登录失败记录, 保留失败时间和原因用于审计, 去除IP和UA
+func (s *service) EraseFailedAttempt(ctx context.Context, req *retrylock.EraseFailedAttemptRequest) (
+	*retrylock.EraseFailedAttemptResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	if err := s.ResetRetryLock(ctx, req.Username); err != nil {
+		return nil, err
+	}
+
+	rs, err := s.attempt.UpdateMany(ctx,
+		bson.M{"domain": req.Domain, "username": req.Username},
+		bson.M{"$set": bson.M{"username": req.Pseudonym, "remote_ip": "", "user_agent": ""}},
+	)
+	if err != nil {
+		return nil, exception.NewInternalServerError("anonymize failed attempt error, %s", err)
+	}
+	return &retrylock.EraseFailedAttemptResponse{Count: rs.ModifiedCount}, nil
+}
+
+// 定期清理过期的锁定状态和登录失败记录
+func (s *service) runCleanup() {
+	tk := time.NewTicker(CLEANUP_INTERVAL)
+	defer tk.Stop()
+
+	for range tk.C {
+		ctx := context.Background()
+		if err := s.store.DeleteExpired(ctx); err != nil {
+			s.log.Errorf("delete expired retry lock error, %s", err)
+		}
+
+		days := conf.C().RetryLock.AttemptRetainDays
+		if days <= 0 {
+			continue
+		}
+		before := time.Now().AddDate(0, 0, -days).UnixMilli()
+		if _, err := s.attempt.DeleteMany(ctx, bson.M{"create_at": bson.M{"$lt": before}}); err != nil {
+			s.log.Errorf("delete failed attempt error, %s", err)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package impl
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/infraboard/mcube/cache"
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+
+	"github.com/infraboard/mcenter/apps/retrylock"
+)
+
+const (
+	// 缓存中保存所有记录的Key
+	CACHE_KEY = "retry_lock"
+)
+
+// store 锁定状态的存储, 只返回未过期的记录
+type store interface {
+	Get(ctx context.Context, key string) (*retrylock.RetryLock, error)
+	// 原子的记录一次失败, 达到限制后锁定, 本次触发锁定时设置req.Attempt.Locked
+	Fail(ctx context.Context, req *retrylock.RecordFailureRequest) (*retrylock.RetryLock, error)
+	Delete(ctx context.Context, key string) error
+	List(ctx context.Context) ([]*retrylock.RetryLock, error)
+	// 清除过期的记录
+	DeleteExpired(ctx context.Context) error
+}
+
+func newCacheStore(c cache.Cache) *cacheStore {
+	return &cacheStore{c: c}
+}
+
+// cacheStore 所有记录保存在同一个缓存Key中, 便于管理员查询
+// 缓存重启后记录会丢失, 多副本部署时需要使用redis缓存
+type cacheStore struct {
+	c cache.Cache
+	sync.Mutex
+}
+
+func (s *cacheStore) load() map[string]*retrylock.RetryLock {
+	locks := map[string]*retrylock.RetryLock{}
+	// Key不存在时返回错误, 当作没有记录处理
+	if err := s.c.Get(CACHE_KEY, &locks); err != nil || locks == nil {
+		return map[string]*retrylock.RetryLock{}
+	}
+	for k, l := range locks {
+		if l.IsExpired() {
+			delete(locks, k)
+		}
+	}
+	return locks
+}
+
+func (s *cacheStore) save(locks map[string]*retrylock.RetryLock) error {
+	if len(locks) == 0 {
+		if s.c.IsExist(CACHE_KEY) {
+			return s.c.Delete(CACHE_KEY)
+		}
+		return nil
+	}
+
+	// 缓存的有效期和最晚过期的记录保持一致
+	var ttl time.Duration
+	for _, l := range locks {
+		if l.TTL() > ttl {
+			ttl = l.TTL()
+		}
+	}
+	return s.c.PutWithTTL(CACHE_KEY, locks, ttl)
+}
+
+func (s *cacheStore) Get(ctx context.Context, key string) (*retrylock.RetryLock, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	l, ok := s.load()[key]
+	if !ok {
+		return nil, exception.NewNotFound("retry lock %s not found", key)
+	}
+	return l, nil
+}
+
+// Fail 在副本内串行更新, 多副本部署时需要使用mongo存储
+func (s *cacheStore) Fail(ctx context.Context, req *retrylock.RecordFailureRequest) (*retrylock.RetryLock, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	locks := s.load()
+	l, ok := locks[req.Key]
+	if !ok {
+		l = retrylock.NewRetryLock(req.Key, req.Attempt)
+	}
+	req.Attempt.Locked = l.Fail(req.Attempt.CreateAt, req.RetryLimite, req.LockedMinite)
+	locks[l.Key] = l
+	if err := s.save(locks); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (s *cacheStore) Delete(ctx context.Context, key string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	locks := s.load()
+	delete(locks, key)
+	return s.save(locks)
+}
+
+func (s *cacheStore) List(ctx context.Context) ([]*retrylock.RetryLock, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	items := []*retrylock.RetryLock{}
+	for _, l := range s.load() {
+		items = append(items, l)
+	}
+	return items, nil
+}
+
+func (s *cacheStore) DeleteExpired(ctx context.Context) error {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.save(s.load())
+}
+
+func newMongoStore(col *mongo.Collection) (*mongoStore, error) {
+	indexs := []mongo.IndexModel{
+		{
+			Keys: bsonx.Doc{{Key: "expire_at", Value: bsonx.Int32(-1)}},
+		},
+	}
+	if _, err := col.Indexes().CreateMany(context.Background(), indexs); err != nil {
+		return nil, err
+	}
+	return &mongoStore{col: col}, nil
+}
+
+// mongoStore 锁定状态持久化到数据库, 缓存重启后依然有效
+type mongoStore struct {
+	col *mongo.Collection
+}
+
+func (s *mongoStore) Get(ctx context.Context, key string) (*retrylock.RetryLock, error) {
+	l := &retrylock.RetryLock{}
+	filter := bson.M{"_id": key, "expire_at": bson.M{"$gt": time.Now().UnixMilli()}}
+	if err := s.col.FindOne(ctx, filter).Decode(l); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, exception.NewNotFound("retry lock %s not found", key)
+		}
+		return nil, exception.NewInternalServerError("find retry lock %s error, %s", key, err)
+	}
+	return l, nil
+}
+
+// Fail 通过$inc原子的增加失败次数, 多副本同时记录失败时次数不会丢失
+func (s *mongoStore) Fail(ctx context.Context, req *retrylock.RecordFailureRequest) (*retrylock.RetryLock, error) {
+	at := req.Attempt.CreateAt
+	period := time.Duration(req.LockedMinite) * time.Minute
+
+	// 过期的记录重新计算失败次数
+	expired := bson.M{"_id": req.Key, "expire_at": bson.M{"$lte": time.Now().UnixMilli()}}
+	if _, err := s.col.DeleteOne(ctx, expired); err != nil {
+		return nil, exception.NewInternalServerError("delete expired retry lock %s error, %s", req.Key, err)
+	}
+
+	update := bson.M{
+		"$inc": bson.M{"count": 1},
+		"$max": bson.M{"last_failed_at": at},
+		"$setOnInsert": bson.M{
+			"domain":          req.Attempt.Domain,
+			"username":        req.Attempt.Username,
+			"first_failed_at": at,
+			"locked_at":       0,
+			"unlock_at":       0,
+			"expire_at":       time.UnixMilli(at).Add(period).UnixMilli(),
+		},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	l := &retrylock.RetryLock{}
+	err := s.col.FindOneAndUpdate(ctx, bson.M{"_id": req.Key}, update, opts).Decode(l)
+	// 并发插入同一个Key时只有一个成功, 其他的重试后更新已经插入的记录
+	if mongo.IsDuplicateKeyError(err) {
+		err = s.col.FindOneAndUpdate(ctx, bson.M{"_id": req.Key}, update, opts).Decode(l)
+	}
+	if err != nil {
+		return nil, exception.NewInternalServerError("update retry lock %s error, %s", req.Key, err)
+	}
+	if l.Count < req.RetryLimite || l.LockedAt > 0 {
+		return l, nil
+	}
+
+	// 达到限制后锁定, 只有一次失败可以触发锁定, 锁定后继续失败不会延长锁定时间
+	unlockAt := time.UnixMilli(at).Add(period).UnixMilli()
+	rs, err := s.col.UpdateOne(ctx,
+		bson.M{"_id": req.Key, "locked_at": 0},
+		bson.M{"$set": bson.M{"locked_at": at, "unlock_at": unlockAt, "expire_at": unlockAt}},
+	)
+	if err != nil {
+		return nil, exception.NewInternalServerError("lock retry lock %s error, %s", req.Key, err)
+	}
+	if rs.ModifiedCount > 0 {
+		l.LockedAt, l.UnlockAt, l.ExpireAt = at, unlockAt, unlockAt
+		req.Attempt.Locked = true
+	}
+	return l, nil
+}
+
+func (s *mongoStore) Delete(ctx context.Context, key string) error {
+	if _, err := s.col.DeleteOne(ctx, bson.M{"_id": key}); err != nil {
+		return exception.NewInternalServerError("delete retry lock %s error, %s", key, err)
+	}
+	return nil
+}
+
+func (s *mongoStore) List(ctx context.Context) ([]*retrylock.RetryLock, error) {
+	filter := bson.M{"expire_at": bson.M{"$gt": time.Now().UnixMilli()}}
+	resp, err := s.col.Find(ctx, filter)
+	if err != nil {
+		return nil, exception.NewInternalServerError("find retry lock error, %s", err)
+	}
+
+	items := []*retrylock.RetryLock{}
+	for resp.Next(ctx) {
+		l := &retrylock.RetryLock{}
+		if err := resp.Decode(l); err != nil {
+			return nil, exception.NewInternalServerError("decode retry lock error, %s", err)
+		}
+		items = append(items, l)
+	}
+	return items, nil
+}
+
+func (s *mongoStore) DeleteExpired(ctx context.Context) error {
+	filter := bson.M{"expire_at": bson.M{"$lte": time.Now().UnixMilli()}}
+	if _, err := s.col.DeleteMany(ctx, filter); err != nil {
+		return exception.NewInternalServerError("delete expired retry lock error, %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package retrylock
+
+import (
+	"context"
+)
+
+// Service 登录失败重试锁定
+type Service interface {
+	// 查询Key当前的锁定状态, 没有记录时返回NotFound
+	DescribeRetryLock(ctx context.Context, key string) (*RetryLock, error)
+	// 记录一次登录失败, 失败次数达到限制后锁定
+	RecordFailure(context.Context, *RecordFailureRequest) (*RetryLock, error)
+	// 登录成功后清除失败次数
+	ResetRetryLock(ctx context.Context, key string) error
+	// 查询失败和锁定的记录
+	QueryRetryLock(context.Context, *QueryRetryLockRequest) (*RetryLockSet, error)
+	// 管理员提前解锁
+	Unlock(context.Context, *UnlockRequest) (*RetryLock, error)
+	// 查询最近的登录失败记录
+	QueryFailedAttempt(context.Context, *QueryFailedAttemptRequest) (*FailedAttemptSet, error)
+	// 注销用户时匿名化登录失败记录, 同时清除锁定状态
+	EraseFailedAttempt(context.Context, *EraseFailedAttemptRequest) (*EraseFailedAttemptResponse, error)
+}
@@ -0,0 +1,1078 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: apps/retrylock/pb/retrylock.proto
+
+package retrylock
+
+import (
+	request "github.com/infraboard/mcube/http/request"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RetryLock 登录失败重试锁定状态
+type RetryLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 锁定的Key, 通常为用户名
+	// @gotags: bson:"_id" json:"key"
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key" bson:"_id"`
+	// 用户所属域
+	// @gotags: bson:"domain" json:"domain"
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain" bson:"domain"`
+	// 用户名
+	// @gotags: bson:"username" json:"username"
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username" bson:"username"`
+	// 连续失败次数
+	// @gotags: bson:"count" json:"count"
+	Count uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count" bson:"count"`
+	// 第一次失败的时间
+	// @gotags: bson:"first_failed_at" json:"first_failed_at"
+	FirstFailedAt int64 `protobuf:"varint,5,opt,name=first_failed_at,json=firstFailedAt,proto3" json:"first_failed_at" bson:"first_failed_at"`
+	// 最近一次失败的时间
+	// @gotags: bson:"last_failed_at" json:"last_failed_at"
+	LastFailedAt int64 `protobuf:"varint,6,opt,name=last_failed_at,json=lastFailedAt,proto3" json:"last_failed_at" bson:"last_failed_at"`
+	// 锁定时间, 为0表示未锁定
+	// @gotags: bson:"locked_at" json:"locked_at"
+	LockedAt int64 `protobuf:"varint,7,opt,name=locked_at,json=lockedAt,proto3" json:"locked_at" bson:"locked_at"`
+	// 解锁时间
+	// @gotags: bson:"unlock_at" json:"unlock_at"
+	UnlockAt int64 `protobuf:"varint,8,opt,name=unlock_at,json=unlockAt,proto3" json:"unlock_at" bson:"unlock_at"`
+	// 记录过期时间, 过期后失败次数重新计算
+	// @gotags: bson:"expire_at" json:"expire_at"
+	ExpireAt int64 `protobuf:"varint,9,opt,name=expire_at,json=expireAt,proto3" json:"expire_at" bson:"expire_at"`
+}
+
+func (x *RetryLock) Reset() {
+	*x = RetryLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryLock) ProtoMessage() {}
+
+func (x *RetryLock) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryLock.ProtoReflect.Descriptor instead.
+func (*RetryLock) Descriptor() ([]byte, []int) {
+	return file_apps_retrylock_pb_retrylock_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RetryLock) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RetryLock) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *RetryLock) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RetryLock) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RetryLock) GetFirstFailedAt() int64 {
+	if x != nil {
+		return x.FirstFailedAt
+	}
+	return 0
+}
+
+func (x *RetryLock) GetLastFailedAt() int64 {
+	if x != nil {
+		return x.LastFailedAt
+	}
+	return 0
+}
+
+func (x *RetryLock) GetLockedAt() int64 {
+	if x != nil {
+		return x.LockedAt
+	}
+	return 0
+}
+
+func (x *RetryLock) GetUnlockAt() int64 {
+	if x != nil {
+		return x.UnlockAt
+	}
+	return 0
+}
+
+func (x *RetryLock) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+type RetryLockSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 总数量
+	// @gotags: bson:"total" json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total" bson:"total"`
+	// 数据项
+	// @gotags: bson:"items" json:"items"
+	Items []*RetryLock `protobuf:"bytes,2,rep,name=items,proto3" json:"items" bson:"items"`
+}
+
+func (x *RetryLockSet) Reset() {
+	*x = RetryLockSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryLockSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryLockSet) ProtoMessage() {}
+
+func (x *RetryLockSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryLockSet.ProtoReflect.Descriptor instead.
+func (*RetryLockSet) Descriptor() ([]byte, []int) {
+	return file_apps_retrylock_pb_retrylock_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RetryLockSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RetryLockSet) GetItems() []*RetryLock {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// FailedAttempt 登录失败记录
+type FailedAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 记录Id
+	// @gotags: bson:"_id" json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id"`
+	// 失败时间
+	// @gotags: bson:"create_at" json:"create_at"
+	CreateAt int64 `protobuf:"varint,2,opt,name=create_at,json=createAt,proto3" json:"create_at" bson:"create_at"`
+	// 用户所属域
+	// @gotags: bson:"domain" json:"domain"
+	Domain string `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain" bson:"domain"`
+	// 用户名
+	// @gotags: bson:"username" json:"username"
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username" bson:"username"`
+	// 授权类型
+	// @gotags: bson:"grant_type" json:"grant_type"
+	GrantType string `protobuf:"bytes,5,opt,name=grant_type,json=grantType,proto3" json:"grant_type" bson:"grant_type"`
+	// 客户端IP
+	// @gotags: bson:"remote_ip" json:"remote_ip"
+	RemoteIp string `protobuf:"bytes,6,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip" bson:"remote_ip"`
+	// 客户端UA
+	// @gotags: bson:"user_agent" json:"user_agent"
+	UserAgent string `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent" bson:"user_agent"`
+	// 失败原因
+	// @gotags: bson:"reason" json:"reason"
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason" bson:"reason"`
+	// 本次失败后是否触发锁定
+	// @gotags: bson:"locked" json:"locked"
+	Locked bool `protobuf:"varint,9,opt,name=locked,proto3" json:"locked" bson:"locked"`
+}
+
+func (x *FailedAttempt) Reset() {
+	*x = FailedAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailedAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedAttempt) ProtoMessage() {}
+
+func (x *FailedAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedAttempt.ProtoReflect.Descriptor instead.
+func (*FailedAttempt) Descriptor() ([]byte, []int) {
+	return file_apps_retrylock_pb_retrylock_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FailedAttempt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FailedAttempt) GetCreateAt() int64 {
+	if x != nil {
+		return x.CreateAt
+	}
+	return 0
+}
+
+func (x *FailedAttempt) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *FailedAttempt) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *FailedAttempt) GetGrantType() string {
+	if x != nil {
+		return x.GrantType
+	}
+	return ""
+}
+
+func (x *FailedAttempt) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *FailedAttempt) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *FailedAttempt) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FailedAttempt) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+type FailedAttemptSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 总数量
+	// @gotags: bson:"total" json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total" bson:"total"`
+	// 数据项
+	// @gotags: bson:"items" json:"items"
+	Items []*FailedAttempt `protobuf:"bytes,2,rep,name=items,proto3" json:"items" bson:"items"`
+}
+
+func (x *FailedAttemptSet) Reset() {
+	*x = FailedAttemptSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailedAttemptSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedAttemptSet) ProtoMessage() {}
+
+func (x *FailedAttemptSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedAttemptSet.ProtoReflect.Descriptor instead.
+func (*FailedAttemptSet) Descriptor() ([]byte, []int) {
+	return file_apps_retrylock_pb_retrylock_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FailedAttemptSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FailedAttemptSet) GetItems() []*FailedAttempt {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// RecordFailureRequest 记录登录失败
+type RecordFailureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 锁定的Key
+	// @gotags: json:"key" validate:"required"
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key" validate:"required"`
+	// 失败记录
+	// @gotags: json:"attempt"
+	Attempt *FailedAttempt `protobuf:"bytes,2,opt,name=attempt,proto3" json:"attempt"`
+	// 重试限制, 连续失败次数达到限制后锁定
+	// @gotags: json:"retry_limite" validate:"required"
+	RetryLimite uint32 `protobuf:"varint,3,opt,name=retry_limite,json=retryLimite,proto3" json:"retry_limite" validate:"required"`
+	// 锁定时长, 同时也是失败次数的统计周期
+	// @gotags: json:"locked_minite" validate:"required"
+	LockedMinite uint32 `protobuf:"varint,4,opt,name=locked_minite,json=lockedMinite,proto3" json:"locked_minite" validate:"required"`
+}
+
+func (x *RecordFailureRequest) Reset() {
+	*x = RecordFailureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordFailureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordFailureRequest) ProtoMessage() {}
+
+func (x *RecordFailureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordFailureRequest.ProtoReflect.Descriptor instead.
+func (*RecordFailureRequest) Descriptor() ([]byte, []int) {
+	return file_apps_retrylock_pb_retrylock_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecordFailureRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RecordFailureRequest) GetAttempt() *FailedAttempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+func (x *RecordFailureRequest) GetRetryLimite() uint32 {
+	if x != nil {
+		return x.RetryLimite
+	}
+	return 0
+}
+
+func (x *RecordFailureRequest) GetLockedMinite() uint32 {
+	if x != nil {
+		return x.LockedMinite
+	}
+	return 0
+}
+
+// QueryRetryLockRequest 查询重试锁定状态
+type QueryRetryLockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分页参数
+	// @gotags: json:"page"
+	Page *request.PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page"`
+	// 用户所属域
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+	// 只查询当前处于锁定状态的记录
+	// @gotags: json:"only_locked"
+	OnlyLocked bool `protobuf:"varint,3,opt,name=only_locked,json=onlyLocked,proto3" json:"only_locked"`
+}
+
+func (x *QueryRetryLockRequest) Reset() {
+	*x = QueryRetryLockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRetryLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRetryLockRequest) ProtoMessage() {}
+
+func (x *QueryRetryLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRetryLockRequest.ProtoReflect.Descriptor instead.
+func (*QueryRetryLockRequest) Descriptor() ([]byte, []int) {
+	return file_apps_retrylock_pb_retrylock_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryRetryLockRequest) GetPage() *request.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *QueryRetryLockRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *QueryRetryLockRequest) GetOnlyLocked() bool {
+	if x != nil {
+		return x.OnlyLocked
+	}
+	return false
+}
+
+// UnlockRequest 提前解锁
+type UnlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 锁定的Key, 通常为用户名
+	// @gotags: json:"key" validate:"required"
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key" validate:"required"`
+}
+
+func (x *UnlockRequest) Reset() {
+	*x = UnlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockRequest) ProtoMessage() {}
+
+func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockRequest.ProtoReflect.Descriptor instead.
+func (*UnlockRequest) Descriptor() ([]byte, []int) {
+	return file_apps_retrylock_pb_retrylock_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnlockRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// QueryFailedAttemptRequest 查询登录失败记录
+type QueryFailedAttemptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 分页参数
+	// @gotags: json:"page"
+	Page *request.PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page"`
+	// 用户所属域
+	// @gotags: json:"domain"
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+	// 用户名
+	// @gotags: json:"username"
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username"`
+}
+
+func (x *QueryFailedAttemptRequest) Reset() {
+	*x = QueryFailedAttemptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFailedAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFailedAttemptRequest) ProtoMessage() {}
+
+func (x *QueryFailedAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryFailedAttemptRequest.ProtoReflect.Descriptor instead.
+func (*QueryFailedAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_apps_retrylock_pb_retrylock_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryFailedAttemptRequest) GetPage() *request.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *QueryFailedAttemptRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *QueryFailedAttemptRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// EraseFailedAttemptRequest 注销用户时匿名化登录失败记录
+type EraseFailedAttemptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 用户名
+	// @gotags: json:"username" validate:"required"
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username" validate:"required"`
+	// 替换用户名的匿名标识
+	// @gotags: json:"pseudonym" validate:"required"
+	Pseudonym string `protobuf:"bytes,2,opt,name=pseudonym,proto3" json:"pseudonym" validate:"required"`
+	// 用户所属域, 不同域中可能存在同名用户
+	// @gotags: json:"domain" validate:"required"
+	Domain string `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain" validate:"required"`
+}
+
+func (x *EraseFailedAttemptRequest) Reset() {
+	*x = EraseFailedAttemptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EraseFailedAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseFailedAttemptRequest) ProtoMessage() {}
+
+func (x *EraseFailedAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseFailedAttemptRequest.ProtoReflect.Descriptor instead.
+func (*EraseFailedAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_apps_retrylock_pb_retrylock_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EraseFailedAttemptRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *EraseFailedAttemptRequest) GetPseudonym() string {
+	if x != nil {
+		return x.Pseudonym
+	}
+	return ""
+}
+
+func (x *EraseFailedAttemptRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type EraseFailedAttemptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 匿名化的记录数量
+	// @gotags: json:"count"
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *EraseFailedAttemptResponse) Reset() {
+	*x = EraseFailedAttemptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EraseFailedAttemptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseFailedAttemptResponse) ProtoMessage() {}
+
+func (x *EraseFailedAttemptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_retrylock_pb_retrylock_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseFailedAttemptResponse.ProtoReflect.Descriptor instead.
+func (*EraseFailedAttemptResponse) Descriptor() ([]byte, []int) {
+	return file_apps_retrylock_pb_retrylock_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EraseFailedAttemptResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_apps_retrylock_pb_retrylock_proto protoreflect.FileDescriptor
+
+var file_apps_retrylock_pb_retrylock_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2f, 0x70, 0x62, 0x2f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x6c, 0x6f, 0x63,
+	0x6b, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x75, 0x62, 0x65, 0x2f, 0x70,
+	0x62, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x8c, 0x02, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74,
+	0x22, 0x63, 0x0a, 0x0c, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x49, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x22, 0x6b, 0x0a, 0x10, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x41, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69,
+	0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x2e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0xb7, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x07, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69,
+	0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x2e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x6d, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x6d, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x6e, 0x6c, 0x79, 0x4c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x21, 0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x87, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x6d, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x6d, 0x0a, 0x19, 0x45, 0x72, 0x61, 0x73, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x73, 0x65, 0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x22, 0x32, 0x0a, 0x1a, 0x45, 0x72, 0x61, 0x73, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apps_retrylock_pb_retrylock_proto_rawDescOnce sync.Once
+	file_apps_retrylock_pb_retrylock_proto_rawDescData = file_apps_retrylock_pb_retrylock_proto_rawDesc
+)
+
+func file_apps_retrylock_pb_retrylock_proto_rawDescGZIP() []byte {
+	file_apps_retrylock_pb_retrylock_proto_rawDescOnce.Do(func() {
+		file_apps_retrylock_pb_retrylock_proto_rawDescData = protoimpl.X.CompressGZIP(file_apps_retrylock_pb_retrylock_proto_rawDescData)
+	})
+	return file_apps_retrylock_pb_retrylock_proto_rawDescData
+}
+
+var file_apps_retrylock_pb_retrylock_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_apps_retrylock_pb_retrylock_proto_goTypes = []interface{}{
+	(*RetryLock)(nil),                  // 0: infraboard.mcenter.retrylock.RetryLock
+	(*RetryLockSet)(nil),               // 1: infraboard.mcenter.retrylock.RetryLockSet
+	(*FailedAttempt)(nil),              // 2: infraboard.mcenter.retrylock.FailedAttempt
+	(*FailedAttemptSet)(nil),           // 3: infraboard.mcenter.retrylock.FailedAttemptSet
+	(*RecordFailureRequest)(nil),       // 4: infraboard.mcenter.retrylock.RecordFailureRequest
+	(*QueryRetryLockRequest)(nil),      // 5: infraboard.mcenter.retrylock.QueryRetryLockRequest
+	(*UnlockRequest)(nil),              // 6: infraboard.mcenter.retrylock.UnlockRequest
+	(*QueryFailedAttemptRequest)(nil),  // 7: infraboard.mcenter.retrylock.QueryFailedAttemptRequest
+	(*EraseFailedAttemptRequest)(nil),  // 8: infraboard.mcenter.retrylock.EraseFailedAttemptRequest
+	(*EraseFailedAttemptResponse)(nil), // 9: infraboard.mcenter.retrylock.EraseFailedAttemptResponse
+	(*request.PageRequest)(nil),        // 10: infraboard.mcube.page.PageRequest
+}
+var file_apps_retrylock_pb_retrylock_proto_depIdxs = []int32{
+	0,  // 0: infraboard.mcenter.retrylock.RetryLockSet.items:type_name -> infraboard.mcenter.retrylock.RetryLock
+	2,  // 1: infraboard.mcenter.retrylock.FailedAttemptSet.items:type_name -> infraboard.mcenter.retrylock.FailedAttempt
+	2,  // 2: infraboard.mcenter.retrylock.RecordFailureRequest.attempt:type_name -> infraboard.mcenter.retrylock.FailedAttempt
+	10, // 3: infraboard.mcenter.retrylock.QueryRetryLockRequest.page:type_name -> infraboard.mcube.page.PageRequest
+	10, // 4: infraboard.mcenter.retrylock.QueryFailedAttemptRequest.page:type_name -> infraboard.mcube.page.PageRequest
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_apps_retrylock_pb_retrylock_proto_init() }
+func file_apps_retrylock_pb_retrylock_proto_init() {
+	if File_apps_retrylock_pb_retrylock_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apps_retrylock_pb_retrylock_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_retrylock_pb_retrylock_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryLockSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_retrylock_pb_retrylock_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailedAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_retrylock_pb_retrylock_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailedAttemptSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_retrylock_pb_retrylock_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordFailureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_retrylock_pb_retrylock_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRetryLockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_retrylock_pb_retrylock_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_retrylock_pb_retrylock_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFailedAttemptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_retrylock_pb_retrylock_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseFailedAttemptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_retrylock_pb_retrylock_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseFailedAttemptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_apps_retrylock_pb_retrylock_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apps_retrylock_pb_retrylock_proto_goTypes,
+		DependencyIndexes: file_apps_retrylock_pb_retrylock_proto_depIdxs,
+		MessageInfos:      file_apps_retrylock_pb_retrylock_proto_msgTypes,
+	}.Build()
+	File_apps_retrylock_pb_retrylock_proto = out.File
+	file_apps_retrylock_pb_retrylock_proto_rawDesc = nil
+	file_apps_retrylock_pb_retrylock_proto_goTypes = nil
+	file_apps_retrylock_pb_retrylock_proto_depIdxs = nil
+}
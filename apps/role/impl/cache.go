@@ -3,6 +3,7 @@ package impl
 import (
 	"context"
 
+	"github.com/infraboard/mcenter/apps/domain"
 	"github.com/infraboard/mcenter/apps/role"
 	"github.com/infraboard/mcube/cache"
 )
@@ -90,3 +91,23 @@ func (d *Decorator) UpdatePermission(ctx context.Context, req *role.UpdatePermis
 	}
 	return ins, err
 }
+
+func (d *Decorator) DeleteDomainResource(ctx context.Context, req *domain.DeleteDomainResourceRequest) (
+	*domain.DeleteDomainResourceResponse, error) {
+	ids, err := d.domainRoleIds(ctx, req.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := d.impl.DeleteDomainResource(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		if err := cache.C().Delete(id); err != nil {
+			d.log.Debugf("delete %s from cache error, %s", id, err)
+		}
+	}
+	return resp, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/infraboard/mcube/logger/zap"
 	"google.golang.org/grpc"
 
+	"github.com/infraboard/mcenter/apps/domain"
 	"github.com/infraboard/mcenter/apps/policy"
 	"github.com/infraboard/mcenter/apps/role"
 	"github.com/infraboard/mcenter/conf"
@@ -25,6 +26,7 @@ type impl struct {
 	role.UnimplementedRPCServer
 
 	policy policy.Service
+	domain domain.Service
 }
 
 func (i *impl) Config() error {
@@ -36,6 +38,7 @@ func (i *impl) Config() error {
 	i.perm = db.Collection("permission")
 
 	i.log = zap.L().Named(i.Name())
+	i.domain = app.GetInternalApp(domain.AppName).(domain.Service)
 	return nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 
+	"github.com/infraboard/mcenter/apps/domain"
 	"github.com/infraboard/mcenter/apps/policy"
 	"github.com/infraboard/mcenter/apps/role"
 )
@@ -19,6 +20,13 @@ func (s *impl) CreateRole(ctx context.Context, req *role.CreateRoleRequest) (*ro
 		return nil, err
 	}
 
+	// 内置角色不受配额限制
+	if req.Type == role.RoleType_CUSTOM {
+		if err := s.checkQuota(ctx, req.Domain); err != nil {
+			return nil, err
+		}
+	}
+
 	// 保存角色
 	if _, err := s.role.InsertOne(ctx, r); err != nil {
 		return nil, exception.NewInternalServerError("inserted role(%s) document error, %s",
@@ -158,3 +166,72 @@ func (s *impl) DeleteRole(ctx context.Context, req *role.DeleteRoleRequest) (*ro
 
 	return r, nil
 }
+
+// 删除域下所有的角色和角色的权限
+func (s *impl) DeleteDomainResource(ctx context.Context, req *domain.DeleteDomainResourceRequest) (
+	*domain.DeleteDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ids, err := s.domainRoleIds(ctx, req.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return domain.NewDeleteDomainResourceResponse(0), nil
+	}
+
+	if _, err := s.perm.DeleteMany(ctx, bson.M{"role_id": bson.M{"$in": ids}}); err != nil {
+		return nil, exception.NewInternalServerError("delete domain %s permission error, %s", req.Domain, err)
+	}
+	rs, err := s.role.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, exception.NewInternalServerError("delete domain %s role error, %s", req.Domain, err)
+	}
+	return domain.NewDeleteDomainResourceResponse(rs.DeletedCount), nil
+}
+
+func (s *impl) domainRoleIds(ctx context.Context, domainName string) ([]string, error) {
+	resp, err := s.role.Find(ctx, bson.M{"spec.domain": domainName})
+	if err != nil {
+		return nil, exception.NewInternalServerError("find domain %s role error, %s", domainName, err)
+	}
+
+	ids := []string{}
+	for resp.Next(ctx) {
+		ins := role.NewDefaultRole()
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode role error, %s", err)
+		}
+		ids = append(ids, ins.Id)
+	}
+	return ids, nil
+}
+
+// 统计域下的自定义角色数量, 用于配额检查
+func (s *impl) CountDomainResource(ctx context.Context, req *domain.CountDomainResourceRequest) (
+	*domain.CountDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	count, err := s.role.CountDocuments(ctx, bson.M{"spec.domain": req.Domain, "spec.type": role.RoleType_CUSTOM})
+	if err != nil {
+		return nil, exception.NewInternalServerError("count domain %s role error, %s", req.Domain, err)
+	}
+	return domain.NewCountDomainResourceResponse(count), nil
+}
+
+func (s *impl) checkQuota(ctx context.Context, domainName string) error {
+	if domainName == "" {
+		return nil
+	}
+
+	resp, err := s.CountDomainResource(ctx, domain.NewCountDomainResourceRequest(domainName))
+	if err != nil {
+		return err
+	}
+	_, err = s.domain.CheckQuota(ctx, domain.NewCheckQuotaRequest(domainName, domain.QUOTA_RESOURCE_CUSTOM_ROLE, resp.Count))
+	return err
+}
@@ -1,6 +1,10 @@
 package role
 
-import context "context"
+import (
+	context "context"
+
+	"github.com/infraboard/mcenter/apps/domain"
+)
 
 type Service interface {
 	CreateRole(context.Context, *CreateRoleRequest) (*Role, error)
@@ -8,5 +12,9 @@ type Service interface {
 	AddPermissionToRole(context.Context, *AddPermissionToRoleRequest) (*PermissionSet, error)
 	RemovePermissionFromRole(context.Context, *RemovePermissionFromRoleRequest) (*PermissionSet, error)
 	UpdatePermission(context.Context, *UpdatePermissionRequest) (*Permission, error)
+	// 删除域下所有的角色和角色的权限, 清除域时调用
+	DeleteDomainResource(context.Context, *domain.DeleteDomainResourceRequest) (*domain.DeleteDomainResourceResponse, error)
+	// 统计域下的自定义角色数量, 用于配额检查
+	CountDomainResource(context.Context, *domain.CountDomainResourceRequest) (*domain.CountDomainResourceResponse, error)
 	RPCServer
 }
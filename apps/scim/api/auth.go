@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/infraboard/mcenter/apps/scim"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+const (
+	TOKEN_ATTRIBUTE_NAME = "token"
+)
+
+// Auth SCIM客户端使用主账号颁发的私有令牌(private token)认证, 只能管理令牌所属域的资源
+func (h *handler) Auth(r *restful.Request, w *restful.Response, chain *restful.FilterChain) {
+	ak := token.GetTokenFromHTTPHeader(r.Request)
+	if ak == "" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+		failed(w, scim.NewError(http.StatusUnauthorized, "", "bearer token required"))
+		return
+	}
+
+	tk, err := h.token.ValidateToken(r.Request.Context(), token.NewValidateTokenRequest(ak))
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim", error="invalid_token"`)
+		failed(w, scim.NewError(http.StatusUnauthorized, "", err.Error()))
+		return
+	}
+
+	if !tk.GrantType.Equal(token.GRANT_TYPE_PRIVATE_TOKEN) {
+		failed(w, scim.NewError(http.StatusForbidden, "", "scim only support private token, but %s", tk.GrantType))
+		return
+	}
+	if tk.UserType < user.TYPE_PRIMARY {
+		failed(w, scim.NewError(http.StatusForbidden, "", "permission deny: %s, required: %s", tk.UserType, user.TYPE_PRIMARY))
+		return
+	}
+
+	r.SetAttribute(TOKEN_ATTRIBUTE_NAME, tk)
+	chain.ProcessFilter(r, w)
+}
+
+func getToken(r *restful.Request) *token.Token {
+	return r.Attribute(TOKEN_ATTRIBUTE_NAME).(*token.Token)
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/infraboard/mcenter/apps/scim"
+)
+
+func (h *handler) ServiceProviderConfig(r *restful.Request, w *restful.Response) {
+	success(w, http.StatusOK, scim.NewServiceProviderConfig(baseURL(r)), nil)
+}
+
+func (h *handler) QueryResourceType(r *restful.Request, w *restful.Response) {
+	set := scim.NewListResponse(1)
+	for _, item := range scim.NewResourceTypes(baseURL(r)) {
+		set.Add(item)
+	}
+	set.TotalResults = set.ItemsPerPage
+	success(w, http.StatusOK, set, nil)
+}
+
+func (h *handler) DescribeResourceType(r *restful.Request, w *restful.Response) {
+	id := r.PathParameter("id")
+	for _, item := range scim.NewResourceTypes(baseURL(r)) {
+		if item.Id == id {
+			success(w, http.StatusOK, item, nil)
+			return
+		}
+	}
+	failed(w, scim.NewError(http.StatusNotFound, "", "resource type %s not found", id))
+}
+
+func (h *handler) QuerySchema(r *restful.Request, w *restful.Response) {
+	set := scim.NewListResponse(1)
+	for _, item := range scim.NewSchemas(baseURL(r)) {
+		set.Add(item)
+	}
+	set.TotalResults = set.ItemsPerPage
+	success(w, http.StatusOK, set, nil)
+}
+
+func (h *handler) DescribeSchema(r *restful.Request, w *restful.Response) {
+	id := r.PathParameter("id")
+	for _, item := range scim.NewSchemas(baseURL(r)) {
+		if item.Id == id {
+			success(w, http.StatusOK, item, nil)
+			return
+		}
+	}
+	failed(w, scim.NewError(http.StatusNotFound, "", "schema %s not found", id))
+}
@@ -0,0 +1,286 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/scim"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+func (h *handler) QueryGroup(r *restful.Request, w *restful.Response) {
+	ctx := r.Request.Context()
+	tk := getToken(r)
+	page := pagination(r)
+	base := baseURL(r)
+
+	set := scim.NewListResponse(page.StartIndex)
+	expr := r.QueryParameter("filter")
+
+	if expr == "" {
+		req := group.NewQueryGroupRequest()
+		req.Domain = tk.Domain
+		req.Page.PageSize = uint64(page.Count)
+		req.Page.Offset = page.Offset()
+		gs, err := h.group.QueryGroup(ctx, req)
+		if err != nil {
+			failed(w, err)
+			return
+		}
+		if page.Count > 0 {
+			for _, g := range gs.Items {
+				set.Add(scim.NewGroupFromMcenter(g, nil, base))
+			}
+		}
+		set.TotalResults = gs.Total
+		success(w, http.StatusOK, set, nil)
+		return
+	}
+
+	f, err := scim.ParseFilter(expr)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+
+	// 过滤条件为displayName相等时直接通过名称查询
+	scan := h.scanGroup
+	if attr, value, ok := scim.SimpleEqual(f); ok && strings.EqualFold(attr, "displayName") {
+		scan = func(ctx context.Context, domain string, fn func(*group.Group) error) error {
+			req := group.NewQueryGroupRequest()
+			req.Domain = domain
+			req.Name = value
+			gs, err := h.group.QueryGroup(ctx, req)
+			if err != nil {
+				return err
+			}
+			for _, g := range gs.Items {
+				if err := fn(g); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	err = scan(ctx, tk.Domain, func(g *group.Group) error {
+		sg := scim.NewGroupFromMcenter(g, nil, base)
+		m, err := scim.ToMap(sg)
+		if err != nil {
+			return err
+		}
+		if !f.Match(m) {
+			return nil
+		}
+
+		set.TotalResults++
+		if set.TotalResults > page.Offset() && set.ItemsPerPage < page.Count {
+			set.Add(sg)
+		}
+		return nil
+	})
+	if err != nil {
+		failed(w, err)
+		return
+	}
+
+	success(w, http.StatusOK, set, nil)
+}
+
+// scanGroup 遍历域内的用户组
+func (h *handler) scanGroup(ctx context.Context, domain string, fn func(*group.Group) error) error {
+	req := group.NewQueryGroupRequest()
+	req.Domain = domain
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := h.group.QueryGroup(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, g := range set.Items {
+			if err := fn(g); err != nil {
+				return err
+			}
+		}
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return nil
+		}
+		req.Page.PageNumber++
+	}
+}
+
+func (h *handler) DescribeGroup(r *restful.Request, w *restful.Response) {
+	g, err := h.describeGroup(r)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+
+	sg, err := h.newGroup(r, g)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if !checkPrecondition(r, w, sg.Meta.Version) {
+		return
+	}
+	success(w, http.StatusOK, sg, sg.Meta)
+}
+
+func (h *handler) CreateGroup(r *restful.Request, w *restful.Response) {
+	tk := getToken(r)
+
+	sg := scim.NewGroup()
+	if err := readEntity(r, sg); err != nil {
+		failed(w, err)
+		return
+	}
+	if err := sg.Validate(); err != nil {
+		failed(w, err)
+		return
+	}
+
+	g, err := h.group.CreateGroup(r.Request.Context(), sg.CreateGroupRequest(tk.Domain, tk.Username))
+	if err != nil {
+		failed(w, err)
+		return
+	}
+
+	resp, err := h.newGroup(r, g)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	success(w, http.StatusCreated, resp, resp.Meta)
+}
+
+func (h *handler) PutGroup(r *restful.Request, w *restful.Response) {
+	g, err := h.describeGroup(r)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if !checkPrecondition(r, w, scim.ETag(g.CreateAt, g.UpdateAt)) {
+		return
+	}
+
+	sg := scim.NewGroup()
+	if err := readEntity(r, sg); err != nil {
+		failed(w, err)
+		return
+	}
+	h.replaceGroup(r, w, g, sg)
+}
+
+func (h *handler) PatchGroup(r *restful.Request, w *restful.Response) {
+	g, err := h.describeGroup(r)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if !checkPrecondition(r, w, scim.ETag(g.CreateAt, g.UpdateAt)) {
+		return
+	}
+
+	req := scim.NewPatchRequest()
+	if err := readEntity(r, req); err != nil {
+		failed(w, err)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		failed(w, err)
+		return
+	}
+
+	m, err := scim.ToMap(scim.NewGroupFromMcenter(g, nil, baseURL(r)))
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if err := req.Apply(m); err != nil {
+		failed(w, err)
+		return
+	}
+	sg := scim.NewGroup()
+	if err := scim.FromMap(m, sg); err != nil {
+		failed(w, err)
+		return
+	}
+	h.replaceGroup(r, w, g, sg)
+}
+
+func (h *handler) replaceGroup(r *restful.Request, w *restful.Response, g *group.Group, sg *scim.Group) {
+	if err := sg.Validate(); err != nil {
+		failed(w, err)
+		return
+	}
+
+	req := sg.PutGroupRequest(g.Id, getToken(r).Username)
+	// SCIM中没有描述信息和父组, 保留原来的值
+	req.Spec.Description = g.Spec.Description
+	req.Spec.ParentId = g.Spec.ParentId
+	g, err := h.group.UpdateGroup(r.Request.Context(), req)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+
+	resp, err := h.newGroup(r, g)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	success(w, http.StatusOK, resp, resp.Meta)
+}
+
+func (h *handler) DeleteGroup(r *restful.Request, w *restful.Response) {
+	g, err := h.describeGroup(r)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if !checkPrecondition(r, w, scim.ETag(g.CreateAt, g.UpdateAt)) {
+		return
+	}
+
+	if _, err := h.group.DeleteGroup(r.Request.Context(), group.NewDeleteGroupRequest(g.Id)); err != nil {
+		failed(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// describeGroup 查询令牌所属域内的用户组
+func (h *handler) describeGroup(r *restful.Request) (*group.Group, error) {
+	id := r.PathParameter("id")
+	g, err := h.group.DescribeGroup(r.Request.Context(), group.NewDescribeGroupRequest(id))
+	if err != nil {
+		return nil, err
+	}
+	if g.Spec.Domain != getToken(r).Domain {
+		return nil, exception.NewNotFound("group %s not found", id)
+	}
+	return g, nil
+}
+
+// newGroup 转换为SCIM用户组, 补充成员的显示名称
+func (h *handler) newGroup(r *restful.Request, g *group.Group) (*scim.Group, error) {
+	users := user.NewUserSet()
+	if len(g.Spec.Users) > 0 {
+		req := user.NewQueryUserRequest()
+		req.Domain = g.Spec.Domain
+		req.UserIds = g.Spec.Users
+		req.Page.PageSize = uint64(len(g.Spec.Users))
+		set, err := h.user.QueryUser(r.Request.Context(), req)
+		if err != nil {
+			return nil, err
+		}
+		users = set
+	}
+	return scim.NewGroupFromMcenter(g, users, baseURL(r)), nil
+}
@@ -0,0 +1,236 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/scim"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	user  user.Service
+	group group.Service
+	token token.Service
+	log   logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(scim.AppName)
+	h.user = app.GetInternalApp(user.AppName).(user.Service)
+	h.group = app.GetInternalApp(group.AppName).(group.Service)
+	h.token = app.GetInternalApp(token.AppName).(token.Service)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return "scim/v2"
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	// SCIM客户端使用application/scim+json
+	ws.Consumes(restful.MIME_JSON, scim.MIME_SCIM)
+	ws.Produces(scim.MIME_SCIM, restful.MIME_JSON)
+	ws.Filter(h.Auth)
+
+	tags := []string{"SCIM"}
+
+	ws.Route(ws.GET("/Users").To(h.QueryUser).
+		Doc("查询用户列表").
+		Param(ws.QueryParameter("filter", "SCIM filter expression").DataType("string")).
+		Param(ws.QueryParameter("startIndex", "1-based index of the first result").DataType("integer")).
+		Param(ws.QueryParameter("count", "max number of results").DataType("integer")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", scim.ListResponse{}))
+
+	ws.Route(ws.POST("/Users").To(h.CreateUser).
+		Doc("创建用户").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(scim.User{}).
+		Returns(201, "创建成功", scim.User{}))
+
+	ws.Route(ws.GET("/Users/{id}").To(h.DescribeUser).
+		Doc("查询用户详情").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", scim.User{}))
+
+	ws.Route(ws.PUT("/Users/{id}").To(h.PutUser).
+		Doc("全量修改用户").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(scim.User{}).
+		Returns(200, "OK", scim.User{}))
+
+	ws.Route(ws.PATCH("/Users/{id}").To(h.PatchUser).
+		Doc("部分修改用户").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(scim.PatchRequest{}).
+		Returns(200, "OK", scim.User{}))
+
+	ws.Route(ws.DELETE("/Users/{id}").To(h.DeleteUser).
+		Doc("停用用户, 用户会被冻结而不是删除").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags))
+
+	ws.Route(ws.GET("/Groups").To(h.QueryGroup).
+		Doc("查询用户组列表").
+		Param(ws.QueryParameter("filter", "SCIM filter expression").DataType("string")).
+		Param(ws.QueryParameter("startIndex", "1-based index of the first result").DataType("integer")).
+		Param(ws.QueryParameter("count", "max number of results").DataType("integer")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", scim.ListResponse{}))
+
+	ws.Route(ws.POST("/Groups").To(h.CreateGroup).
+		Doc("创建用户组").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(scim.Group{}).
+		Returns(201, "创建成功", scim.Group{}))
+
+	ws.Route(ws.GET("/Groups/{id}").To(h.DescribeGroup).
+		Doc("查询用户组详情").
+		Param(ws.PathParameter("id", "identifier of the group").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", scim.Group{}))
+
+	ws.Route(ws.PUT("/Groups/{id}").To(h.PutGroup).
+		Doc("全量修改用户组").
+		Param(ws.PathParameter("id", "identifier of the group").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(scim.Group{}).
+		Returns(200, "OK", scim.Group{}))
+
+	ws.Route(ws.PATCH("/Groups/{id}").To(h.PatchGroup).
+		Doc("部分修改用户组").
+		Param(ws.PathParameter("id", "identifier of the group").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(scim.PatchRequest{}).
+		Returns(200, "OK", scim.Group{}))
+
+	ws.Route(ws.DELETE("/Groups/{id}").To(h.DeleteGroup).
+		Doc("删除用户组").
+		Param(ws.PathParameter("id", "identifier of the group").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags))
+
+	ws.Route(ws.GET("/ServiceProviderConfig").To(h.ServiceProviderConfig).
+		Doc("服务支持的特性").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", scim.ServiceProviderConfig{}))
+
+	ws.Route(ws.GET("/ResourceTypes").To(h.QueryResourceType).
+		Doc("支持的资源类型").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", scim.ListResponse{}))
+
+	ws.Route(ws.GET("/ResourceTypes/{id}").To(h.DescribeResourceType).
+		Doc("资源类型详情").
+		Param(ws.PathParameter("id", "identifier of the resource type").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", scim.ResourceType{}))
+
+	ws.Route(ws.GET("/Schemas").To(h.QuerySchema).
+		Doc("资源的属性定义").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", scim.ListResponse{}))
+
+	ws.Route(ws.GET("/Schemas/{id}").To(h.DescribeSchema).
+		Doc("资源的属性定义详情").
+		Param(ws.PathParameter("id", "schema urn").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", scim.Schema{}))
+}
+
+// baseURL SCIM服务的根地址, 用于生成资源的location
+func baseURL(r *restful.Request) string {
+	scheme := "http"
+	if r.Request.TLS != nil {
+		scheme = "https"
+	}
+	if v := r.HeaderParameter("X-Forwarded-Proto"); v != "" {
+		scheme = v
+	}
+
+	path := r.Request.URL.Path
+	if i := strings.Index(path, "/scim/v2"); i >= 0 {
+		path = path[:i+len("/scim/v2")]
+	}
+	return scheme + "://" + r.Request.Host + path
+}
+
+func readEntity(r *restful.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Request.Body).Decode(v); err != nil {
+		return scim.NewBadRequest(scim.SCIM_TYPE_INVALID_SYNTAX, "decode request body error, %s", err)
+	}
+	return nil
+}
+
+// pagination 从请求中获取分页参数
+func pagination(r *restful.Request) *scim.Pagination {
+	startIndex, _ := strconv.ParseInt(r.QueryParameter("startIndex"), 10, 64)
+	count := int64(scim.DEFAULT_COUNT)
+	if v := r.QueryParameter("count"); v != "" {
+		count, _ = strconv.ParseInt(v, 10, 64)
+	}
+	return scim.NewPagination(startIndex, count)
+}
+
+func success(w *restful.Response, status int, data interface{}, meta *scim.Meta) {
+	if meta != nil {
+		if meta.Version != "" {
+			w.Header().Set("ETag", meta.Version)
+		}
+		if status == http.StatusCreated {
+			w.Header().Set("Location", meta.Location)
+		}
+	}
+
+	if err := w.WriteHeaderAndJson(status, data, scim.MIME_SCIM); err != nil {
+		zap.L().Errorf("send scim response error, %s", err)
+	}
+}
+
+func failed(w *restful.Response, err error) {
+	e := scim.NewErrorFromException(err)
+	if err := w.WriteHeaderAndJson(e.HTTPStatus(), e, scim.MIME_SCIM); err != nil {
+		zap.L().Errorf("send scim error response error, %s", err)
+	}
+}
+
+// checkPrecondition 处理If-Match/If-None-Match条件请求, 返回false表示请求已经处理
+func checkPrecondition(r *restful.Request, w *restful.Response, etag string) bool {
+	if v := r.HeaderParameter("If-Match"); v != "" && !scim.MatchETag(v, etag) {
+		failed(w, scim.NewError(http.StatusPreconditionFailed, "", "resource version %s not match", etag))
+		return false
+	}
+
+	// 只有读请求支持If-None-Match
+	if v := r.HeaderParameter("If-None-Match"); v != "" && r.Request.Method == http.MethodGet && scim.MatchETag(v, etag) {
+		w.Header().Set("ETag", etag)
+		w.WriteHeader(http.StatusNotModified)
+		return false
+	}
+	return true
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
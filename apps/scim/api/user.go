@@ -0,0 +1,342 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/scim"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+const (
+	// 内存过滤时, 每次从数据库加载的数据量
+	SCAN_PAGE_SIZE = 500
+)
+
+func (h *handler) QueryUser(r *restful.Request, w *restful.Response) {
+	ctx := r.Request.Context()
+	tk := getToken(r)
+	page := pagination(r)
+	base := baseURL(r)
+
+	groups, err := h.userGroups(ctx, tk.Domain)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+
+	set := scim.NewListResponse(page.StartIndex)
+	expr := r.QueryParameter("filter")
+
+	// 没有过滤条件时直接使用数据库分页
+	if expr == "" {
+		req := user.NewQueryUserRequest()
+		req.Domain = tk.Domain
+		req.Page.PageSize = uint64(page.Count)
+		req.Page.Offset = page.Offset()
+		req.SkipItems = page.Count == 0
+		us, err := h.user.QueryUser(ctx, req)
+		if err != nil {
+			failed(w, err)
+			return
+		}
+		for _, u := range us.Items {
+			set.Add(scim.NewUserFromMcenter(u, groups[u.Id], base))
+		}
+		set.TotalResults = us.Total
+		success(w, http.StatusOK, set, nil)
+		return
+	}
+
+	f, err := scim.ParseFilter(expr)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+
+	err = h.scanUser(ctx, tk.Domain, f, func(u *user.User) error {
+		su := scim.NewUserFromMcenter(u, groups[u.Id], base)
+		m, err := scim.ToMap(su)
+		if err != nil {
+			return err
+		}
+		if !f.Match(m) {
+			return nil
+		}
+
+		set.TotalResults++
+		if set.TotalResults > page.Offset() && set.ItemsPerPage < page.Count {
+			set.Add(su)
+		}
+		return nil
+	})
+	if err != nil {
+		failed(w, err)
+		return
+	}
+
+	success(w, http.StatusOK, set, nil)
+}
+
+// scanUser 遍历域内的用户, 过滤条件为userName相等时直接通过用户名查询
+func (h *handler) scanUser(ctx context.Context, domain string, f scim.Filter, fn func(*user.User) error) error {
+	if attr, value, ok := scim.SimpleEqual(f); ok && strings.EqualFold(attr, "userName") {
+		u, err := h.user.DescribeUser(ctx, user.NewDescriptUserRequestWithDomainName(domain, value))
+		if exception.IsNotFoundError(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		u.Desensitize()
+		return fn(u)
+	}
+
+	req := user.NewQueryUserRequest()
+	req.Domain = domain
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := h.user.QueryUser(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, u := range set.Items {
+			if err := fn(u); err != nil {
+				return err
+			}
+		}
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return nil
+		}
+		req.Page.PageNumber++
+	}
+}
+
+// userGroups 域内用户所属的用户组
+func (h *handler) userGroups(ctx context.Context, domain string) (map[string]*group.GroupSet, error) {
+	groups := map[string]*group.GroupSet{}
+	err := h.scanGroup(ctx, domain, func(g *group.Group) error {
+		for _, uid := range g.Spec.Users {
+			if _, ok := groups[uid]; !ok {
+				groups[uid] = group.NewGroupSet()
+			}
+			groups[uid].Add(g)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (h *handler) DescribeUser(r *restful.Request, w *restful.Response) {
+	u, err := h.describeUser(r)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+
+	su, err := h.newUser(r, u)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if !checkPrecondition(r, w, su.Meta.Version) {
+		return
+	}
+	success(w, http.StatusOK, su, su.Meta)
+}
+
+func (h *handler) CreateUser(r *restful.Request, w *restful.Response) {
+	ctx := r.Request.Context()
+	tk := getToken(r)
+
+	su := scim.NewUser()
+	if err := readEntity(r, su); err != nil {
+		failed(w, err)
+		return
+	}
+	if err := su.Validate(); err != nil {
+		failed(w, err)
+		return
+	}
+
+	u, err := h.user.CreateUser(ctx, su.CreateUserRequest(tk.Domain))
+	if err != nil {
+		failed(w, err)
+		return
+	}
+
+	u, err = h.user.UpdateUser(ctx, su.PutUserRequest(u))
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if !su.IsActive() {
+		u, err = h.updateStatus(ctx, u, false)
+		if err != nil {
+			failed(w, err)
+			return
+		}
+	}
+
+	resp, err := h.newUser(r, u)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	success(w, http.StatusCreated, resp, resp.Meta)
+}
+
+func (h *handler) PutUser(r *restful.Request, w *restful.Response) {
+	u, err := h.describeUser(r)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if !checkPrecondition(r, w, scim.ETag(u.CreateAt, u.UpdateAt)) {
+		return
+	}
+
+	su := scim.NewUser()
+	if err := readEntity(r, su); err != nil {
+		failed(w, err)
+		return
+	}
+	h.replaceUser(r, w, u, su)
+}
+
+func (h *handler) PatchUser(r *restful.Request, w *restful.Response) {
+	u, err := h.describeUser(r)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if !checkPrecondition(r, w, scim.ETag(u.CreateAt, u.UpdateAt)) {
+		return
+	}
+
+	req := scim.NewPatchRequest()
+	if err := readEntity(r, req); err != nil {
+		failed(w, err)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		failed(w, err)
+		return
+	}
+
+	// 在当前资源的JSON表示上执行PATCH, 再整体替换
+	m, err := scim.ToMap(scim.NewUserFromMcenter(u, nil, baseURL(r)))
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if err := req.Apply(m); err != nil {
+		failed(w, err)
+		return
+	}
+	su := scim.NewUser()
+	if err := scim.FromMap(m, su); err != nil {
+		failed(w, err)
+		return
+	}
+	h.replaceUser(r, w, u, su)
+}
+
+func (h *handler) replaceUser(r *restful.Request, w *restful.Response, u *user.User, su *scim.User) {
+	ctx := r.Request.Context()
+
+	if err := su.Validate(); err != nil {
+		failed(w, err)
+		return
+	}
+	// 用户名不允许修改
+	if !strings.EqualFold(su.UserName, u.Spec.Username) {
+		failed(w, scim.NewBadRequest(scim.SCIM_TYPE_MUTABILITY, "userName is immutable"))
+		return
+	}
+
+	u, err := h.user.UpdateUser(ctx, su.PutUserRequest(u))
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if su.IsActive() == u.IsLocked() {
+		u, err = h.updateStatus(ctx, u, su.IsActive())
+		if err != nil {
+			failed(w, err)
+			return
+		}
+	}
+
+	resp, err := h.newUser(r, u)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	success(w, http.StatusOK, resp, resp.Meta)
+}
+
+// DeleteUser 外部系统删除用户时只冻结用户, 保留用户数据用于审计
+func (h *handler) DeleteUser(r *restful.Request, w *restful.Response) {
+	u, err := h.describeUser(r)
+	if err != nil {
+		failed(w, err)
+		return
+	}
+	if !checkPrecondition(r, w, scim.ETag(u.CreateAt, u.UpdateAt)) {
+		return
+	}
+
+	if !u.IsLocked() {
+		if _, err := h.updateStatus(r.Request.Context(), u, false); err != nil {
+			failed(w, err)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// updateStatus 激活或者停用用户
+func (h *handler) updateStatus(ctx context.Context, u *user.User, active bool) (*user.User, error) {
+	req := user.NewUpdateUserStatusRequest(u.Id)
+	req.Locked = !active
+	req.Operator = scim.SCIM_OPERATOR
+	if !active {
+		req.Reason = scim.DEPROVISION_REASON
+	}
+	return h.user.UpdateUserStatus(ctx, req)
+}
+
+// describeUser 查询令牌所属域内的用户
+func (h *handler) describeUser(r *restful.Request) (*user.User, error) {
+	id := r.PathParameter("id")
+	u, err := h.user.DescribeUser(r.Request.Context(), user.NewDescriptUserRequestWithId(id))
+	if err != nil {
+		return nil, err
+	}
+	if u.Spec.Domain != getToken(r).Domain {
+		return nil, exception.NewNotFound("user %s not found", id)
+	}
+	u.Desensitize()
+	return u, nil
+}
+
+func (h *handler) newUser(r *restful.Request, u *user.User) (*scim.User, error) {
+	req := group.NewQueryGroupRequest()
+	req.Domain = u.Spec.Domain
+	req.UserId = u.Id
+	req.Page.PageSize = scim.MAX_RESULTS
+	groups, err := h.group.QueryGroup(r.Request.Context(), req)
+	if err != nil {
+		return nil, err
+	}
+	return scim.NewUserFromMcenter(u, groups, baseURL(r)), nil
+}
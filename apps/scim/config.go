@@ -0,0 +1,242 @@
+package scim
+
+// 服务发现相关的资源: https://www.rfc-editor.org/rfc/rfc7644#section-4
+
+// ServiceProviderConfig 服务支持的特性
+type ServiceProviderConfig struct {
+	Schemas               []string                `json:"schemas"`
+	DocumentationUri      string                  `json:"documentationUri,omitempty"`
+	Patch                 *Supported              `json:"patch"`
+	Bulk                  *BulkSupported          `json:"bulk"`
+	Filter                *FilterSupported        `json:"filter"`
+	ChangePassword        *Supported              `json:"changePassword"`
+	Sort                  *Supported              `json:"sort"`
+	Etag                  *Supported              `json:"etag"`
+	AuthenticationSchemes []*AuthenticationScheme `json:"authenticationSchemes"`
+	Meta                  *Meta                   `json:"meta,omitempty"`
+}
+
+type Supported struct {
+	Supported bool `json:"supported"`
+}
+
+type BulkSupported struct {
+	Supported      bool  `json:"supported"`
+	MaxOperations  int64 `json:"maxOperations"`
+	MaxPayloadSize int64 `json:"maxPayloadSize"`
+}
+
+type FilterSupported struct {
+	Supported  bool  `json:"supported"`
+	MaxResults int64 `json:"maxResults"`
+}
+
+type AuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary,omitempty"`
+}
+
+func NewServiceProviderConfig(baseURL string) *ServiceProviderConfig {
+	return &ServiceProviderConfig{
+		Schemas:        []string{SCHEMA_SERVICE_PROVIDER},
+		Patch:          &Supported{Supported: true},
+		Bulk:           &BulkSupported{Supported: false},
+		Filter:         &FilterSupported{Supported: true, MaxResults: MAX_RESULTS},
+		ChangePassword: &Supported{Supported: false},
+		Sort:           &Supported{Supported: false},
+		Etag:           &Supported{Supported: true},
+		AuthenticationSchemes: []*AuthenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "OAuth Bearer Token",
+				Description: "使用用户中心颁发的私有令牌(private token)认证",
+				Primary:     true,
+			},
+		},
+		Meta: &Meta{
+			ResourceType: "ServiceProviderConfig",
+			Location:     baseURL + "/ServiceProviderConfig",
+		},
+	}
+}
+
+// ResourceType 支持的资源类型
+type ResourceType struct {
+	Schemas     []string `json:"schemas"`
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Endpoint    string   `json:"endpoint"`
+	Description string   `json:"description"`
+	Schema      string   `json:"schema"`
+	// 扩展Schema
+	SchemaExtensions []*SchemaExtension `json:"schemaExtensions,omitempty"`
+	Meta             *Meta              `json:"meta,omitempty"`
+}
+
+type SchemaExtension struct {
+	Schema   string `json:"schema"`
+	Required bool   `json:"required"`
+}
+
+func NewResourceTypes(baseURL string) []*ResourceType {
+	return []*ResourceType{
+		{
+			Schemas:     []string{SCHEMA_RESOURCE_TYPE},
+			Id:          "User",
+			Name:        "User",
+			Endpoint:    "/Users",
+			Description: "User Account",
+			Schema:      SCHEMA_USER,
+			SchemaExtensions: []*SchemaExtension{
+				{Schema: SCHEMA_MCENTER_USER, Required: false},
+			},
+			Meta: &Meta{ResourceType: "ResourceType", Location: baseURL + "/ResourceTypes/User"},
+		},
+		{
+			Schemas:     []string{SCHEMA_RESOURCE_TYPE},
+			Id:          "Group",
+			Name:        "Group",
+			Endpoint:    "/Groups",
+			Description: "Group",
+			Schema:      SCHEMA_GROUP,
+			Meta:        &Meta{ResourceType: "ResourceType", Location: baseURL + "/ResourceTypes/Group"},
+		},
+	}
+}
+
+// Schema 资源的属性定义
+type Schema struct {
+	Schemas     []string     `json:"schemas"`
+	Id          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Attributes  []*Attribute `json:"attributes"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+type Attribute struct {
+	Name          string       `json:"name"`
+	Type          string       `json:"type"`
+	MultiValued   bool         `json:"multiValued"`
+	Required      bool         `json:"required"`
+	CaseExact     bool         `json:"caseExact"`
+	Mutability    string       `json:"mutability"`
+	Returned      string       `json:"returned"`
+	Uniqueness    string       `json:"uniqueness"`
+	SubAttributes []*Attribute `json:"subAttributes,omitempty"`
+}
+
+func newAttribute(name, typ string) *Attribute {
+	return &Attribute{
+		Name:       name,
+		Type:       typ,
+		Mutability: "readWrite",
+		Returned:   "default",
+		Uniqueness: "none",
+	}
+}
+
+func (a *Attribute) multi() *Attribute {
+	a.MultiValued = true
+	return a
+}
+
+func (a *Attribute) required() *Attribute {
+	a.Required = true
+	return a
+}
+
+func (a *Attribute) mutability(m string) *Attribute {
+	a.Mutability = m
+	return a
+}
+
+func (a *Attribute) returned(r string) *Attribute {
+	a.Returned = r
+	return a
+}
+
+func (a *Attribute) unique() *Attribute {
+	a.Uniqueness = "server"
+	return a
+}
+
+func (a *Attribute) sub(attrs ...*Attribute) *Attribute {
+	a.SubAttributes = attrs
+	return a
+}
+
+func multiValuedAttribute(name string) *Attribute {
+	return newAttribute(name, "complex").multi().sub(
+		newAttribute("value", "string"),
+		newAttribute("type", "string"),
+		newAttribute("primary", "boolean"),
+	)
+}
+
+func NewSchemas(baseURL string) []*Schema {
+	return []*Schema{
+		{
+			Schemas:     []string{SCHEMA_SCHEMA},
+			Id:          SCHEMA_USER,
+			Name:        "User",
+			Description: "User Account",
+			Attributes: []*Attribute{
+				newAttribute("userName", "string").required().unique().mutability("immutable"),
+				newAttribute("name", "complex").sub(
+					newAttribute("formatted", "string"),
+					newAttribute("familyName", "string"),
+					newAttribute("givenName", "string"),
+				),
+				newAttribute("displayName", "string"),
+				newAttribute("nickName", "string"),
+				newAttribute("preferredLanguage", "string"),
+				newAttribute("password", "string").mutability("writeOnly").returned("never"),
+				newAttribute("active", "boolean"),
+				multiValuedAttribute("emails"),
+				multiValuedAttribute("phoneNumbers"),
+				newAttribute("addresses", "complex").multi().sub(
+					newAttribute("formatted", "string"),
+					newAttribute("locality", "string"),
+					newAttribute("region", "string"),
+					newAttribute("type", "string"),
+					newAttribute("primary", "boolean"),
+				),
+				newAttribute("groups", "complex").multi().mutability("readOnly").sub(
+					newAttribute("value", "string").mutability("readOnly"),
+					newAttribute("display", "string").mutability("readOnly"),
+					newAttribute("$ref", "reference").mutability("readOnly"),
+				),
+			},
+			Meta: &Meta{ResourceType: "Schema", Location: baseURL + "/Schemas/" + SCHEMA_USER},
+		},
+		{
+			Schemas:     []string{SCHEMA_SCHEMA},
+			Id:          SCHEMA_MCENTER_USER,
+			Name:        "McenterUser",
+			Description: "Mcenter User Extension",
+			Attributes: []*Attribute{
+				newAttribute("attributes", "complex"),
+			},
+			Meta: &Meta{ResourceType: "Schema", Location: baseURL + "/Schemas/" + SCHEMA_MCENTER_USER},
+		},
+		{
+			Schemas:     []string{SCHEMA_SCHEMA},
+			Id:          SCHEMA_GROUP,
+			Name:        "Group",
+			Description: "Group",
+			Attributes: []*Attribute{
+				newAttribute("displayName", "string").required().unique(),
+				newAttribute("members", "complex").multi().sub(
+					newAttribute("value", "string").mutability("immutable"),
+					newAttribute("display", "string").mutability("readOnly"),
+					newAttribute("$ref", "reference").mutability("immutable"),
+					newAttribute("type", "string").mutability("immutable"),
+				),
+			},
+			Meta: &Meta{ResourceType: "Schema", Location: baseURL + "/Schemas/" + SCHEMA_GROUP},
+		},
+	}
+}
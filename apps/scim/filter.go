@@ -0,0 +1,528 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SCIM过滤表达式: https://www.rfc-editor.org/rfc/rfc7644#section-3.4.2.2
+// 比如: userName eq "bjensen" and (emails[type eq "work"] pr or not (title co "Manager"))
+
+const (
+	OP_EQ = "eq"
+	OP_NE = "ne"
+	OP_CO = "co"
+	OP_SW = "sw"
+	OP_EW = "ew"
+	OP_PR = "pr"
+	OP_GT = "gt"
+	OP_GE = "ge"
+	OP_LT = "lt"
+	OP_LE = "le"
+)
+
+// Filter 过滤表达式, 基于资源的JSON表示进行匹配
+type Filter interface {
+	Match(resource map[string]interface{}) bool
+	String() string
+}
+
+// ParseFilter 解析过滤表达式
+func ParseFilter(expr string) (Filter, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, NewBadRequest(SCIM_TYPE_INVALID_FILTER, "filter is empty")
+	}
+
+	p := &parser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.eof() {
+		return nil, NewBadRequest(SCIM_TYPE_INVALID_FILTER, "unexpected token %s", p.peek())
+	}
+	return f, nil
+}
+
+// AndFilter 逻辑与
+type AndFilter struct {
+	Left, Right Filter
+}
+
+func (f *AndFilter) Match(r map[string]interface{}) bool {
+	return f.Left.Match(r) && f.Right.Match(r)
+}
+
+func (f *AndFilter) String() string {
+	return fmt.Sprintf("(%s and %s)", f.Left, f.Right)
+}
+
+// OrFilter 逻辑或
+type OrFilter struct {
+	Left, Right Filter
+}
+
+func (f *OrFilter) Match(r map[string]interface{}) bool {
+	return f.Left.Match(r) || f.Right.Match(r)
+}
+
+func (f *OrFilter) String() string {
+	return fmt.Sprintf("(%s or %s)", f.Left, f.Right)
+}
+
+// NotFilter 逻辑非
+type NotFilter struct {
+	Filter Filter
+}
+
+func (f *NotFilter) Match(r map[string]interface{}) bool {
+	return !f.Filter.Match(r)
+}
+
+func (f *NotFilter) String() string {
+	return fmt.Sprintf("not (%s)", f.Filter)
+}
+
+// ValuePathFilter 多值属性过滤, 比如: emails[type eq "work"]
+type ValuePathFilter struct {
+	Attr   string
+	Filter Filter
+}
+
+func (f *ValuePathFilter) Match(r map[string]interface{}) bool {
+	for _, item := range toSlice(getValue(r, f.Attr)) {
+		if m, ok := item.(map[string]interface{}); ok && f.Filter.Match(m) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *ValuePathFilter) String() string {
+	return fmt.Sprintf("%s[%s]", f.Attr, f.Filter)
+}
+
+// CompareFilter 属性比较
+type CompareFilter struct {
+	Attr  string
+	Op    string
+	Value interface{}
+}
+
+func (f *CompareFilter) Match(r map[string]interface{}) bool {
+	values := lookup(r, f.Attr)
+
+	switch f.Op {
+	case OP_PR:
+		for _, v := range values {
+			if !isEmpty(v) {
+				return true
+			}
+		}
+		return false
+	case OP_NE:
+		for _, v := range values {
+			if compare(OP_EQ, v, f.Value) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, v := range values {
+		if compare(f.Op, v, f.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *CompareFilter) String() string {
+	if f.Op == OP_PR {
+		return fmt.Sprintf("%s pr", f.Attr)
+	}
+	v, _ := json.Marshal(f.Value)
+	return fmt.Sprintf("%s %s %s", f.Attr, f.Op, v)
+}
+
+// lookup 获取属性的值, 多值属性会展开, 多值的复杂属性默认比较value子属性
+func lookup(r map[string]interface{}, attr string) []interface{} {
+	parts := strings.SplitN(trimSchema(attr), ".", 2)
+	v := getValue(r, parts[0])
+	if v == nil {
+		return nil
+	}
+
+	values := []interface{}{}
+	for _, item := range toSlice(v) {
+		m, isMap := item.(map[string]interface{})
+		switch {
+		case len(parts) == 2 && isMap:
+			values = append(values, lookup(m, parts[1])...)
+		case len(parts) == 2:
+			continue
+		case isMap:
+			if sub := getValue(m, "value"); sub != nil {
+				values = append(values, sub)
+			}
+		default:
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+func compare(op string, actual, expect interface{}) bool {
+	switch a := actual.(type) {
+	case string:
+		e, ok := expect.(string)
+		if !ok {
+			return false
+		}
+		a, e = strings.ToLower(a), strings.ToLower(e)
+		switch op {
+		case OP_EQ:
+			return a == e
+		case OP_CO:
+			return strings.Contains(a, e)
+		case OP_SW:
+			return strings.HasPrefix(a, e)
+		case OP_EW:
+			return strings.HasSuffix(a, e)
+		case OP_GT:
+			return a > e
+		case OP_GE:
+			return a >= e
+		case OP_LT:
+			return a < e
+		case OP_LE:
+			return a <= e
+		}
+	case float64:
+		e, ok := expect.(float64)
+		if !ok {
+			return false
+		}
+		switch op {
+		case OP_EQ:
+			return a == e
+		case OP_GT:
+			return a > e
+		case OP_GE:
+			return a >= e
+		case OP_LT:
+			return a < e
+		case OP_LE:
+			return a <= e
+		}
+	case bool:
+		e, ok := expect.(bool)
+		return ok && op == OP_EQ && a == e
+	case nil:
+		return op == OP_EQ && expect == nil
+	}
+	return false
+}
+
+func isEmpty(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return t == ""
+	case []interface{}:
+		return len(t) == 0
+	case map[string]interface{}:
+		return len(t) == 0
+	}
+	return false
+}
+
+func toSlice(v interface{}) []interface{} {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return t
+	}
+	return []interface{}{v}
+}
+
+// getValue 属性名大小写不敏感
+func getValue(r map[string]interface{}, attr string) interface{} {
+	if k, ok := findKey(r, attr); ok {
+		return r[k]
+	}
+	return nil
+}
+
+func findKey(r map[string]interface{}, attr string) (string, bool) {
+	if _, ok := r[attr]; ok {
+		return attr, true
+	}
+	for k := range r {
+		if strings.EqualFold(k, attr) {
+			return k, true
+		}
+	}
+	return attr, false
+}
+
+// trimSchema 去掉核心Schema的URN前缀, 比如: urn:ietf:params:scim:schemas:core:2.0:User:userName
+func trimSchema(attr string) string {
+	for _, s := range []string{SCHEMA_USER, SCHEMA_GROUP} {
+		if len(attr) > len(s) && strings.EqualFold(attr[:len(s)+1], s+":") {
+			return attr[len(s)+1:]
+		}
+	}
+	return attr
+}
+
+type tokenKind int
+
+const (
+	TOKEN_WORD tokenKind = iota
+	TOKEN_STRING
+	TOKEN_LPAREN
+	TOKEN_RPAREN
+	TOKEN_LBRACKET
+	TOKEN_RBRACKET
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+func (t token) String() string {
+	return t.value
+}
+
+func tokenize(expr string) ([]token, error) {
+	tokens := []token{}
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{TOKEN_LPAREN, "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{TOKEN_RPAREN, ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{TOKEN_LBRACKET, "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{TOKEN_RBRACKET, "]"})
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(expr); j++ {
+				if expr[j] == '\\' {
+					j++
+					continue
+				}
+				if expr[j] == '"' {
+					break
+				}
+			}
+			if j >= len(expr) {
+				return nil, NewBadRequest(SCIM_TYPE_INVALID_FILTER, "unterminated string at %d", i)
+			}
+			// 字符串使用JSON的转义规则
+			var s string
+			if err := json.Unmarshal([]byte(expr[i:j+1]), &s); err != nil {
+				return nil, NewBadRequest(SCIM_TYPE_INVALID_FILTER, "invalid string %s, %s", expr[i:j+1], err)
+			}
+			tokens = append(tokens, token{TOKEN_STRING, s})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(expr) && !strings.ContainsRune(" \t\n()[]\"", rune(expr[j])); j++ {
+			}
+			tokens = append(tokens, token{TOKEN_WORD, expr[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.eof() {
+		return token{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *parser) isKeyword(kw string) bool {
+	t := p.peek()
+	return !p.eof() && t.kind == TOKEN_WORD && strings.EqualFold(t.value, kw)
+}
+
+func (p *parser) expect(kind tokenKind, value string) error {
+	if p.eof() || p.peek().kind != kind {
+		return NewBadRequest(SCIM_TYPE_INVALID_FILTER, "expect %s", value)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) parseOr() (Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &OrFilter{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Filter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("and") {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &AndFilter{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (Filter, error) {
+	if p.isKeyword("not") {
+		p.pos++
+		if err := p.expect(TOKEN_LPAREN, "("); err != nil {
+			return nil, err
+		}
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(TOKEN_RPAREN, ")"); err != nil {
+			return nil, err
+		}
+		return &NotFilter{Filter: f}, nil
+	}
+	return p.parseAtom()
+}
+
+func (p *parser) parseAtom() (Filter, error) {
+	if p.eof() {
+		return nil, NewBadRequest(SCIM_TYPE_INVALID_FILTER, "unexpected end of filter")
+	}
+
+	t := p.next()
+	switch t.kind {
+	case TOKEN_LPAREN:
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(TOKEN_RPAREN, ")"); err != nil {
+			return nil, err
+		}
+		return f, nil
+	case TOKEN_WORD:
+	default:
+		return nil, NewBadRequest(SCIM_TYPE_INVALID_FILTER, "unexpected token %s", t)
+	}
+
+	attr := trimSchema(t.value)
+	if !p.eof() && p.peek().kind == TOKEN_LBRACKET {
+		p.pos++
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(TOKEN_RBRACKET, "]"); err != nil {
+			return nil, err
+		}
+		return &ValuePathFilter{Attr: attr, Filter: f}, nil
+	}
+
+	if p.eof() || p.peek().kind != TOKEN_WORD {
+		return nil, NewBadRequest(SCIM_TYPE_INVALID_FILTER, "attribute %s missing operator", attr)
+	}
+	op := strings.ToLower(p.next().value)
+	switch op {
+	case OP_PR:
+		return &CompareFilter{Attr: attr, Op: op}, nil
+	case OP_EQ, OP_NE, OP_CO, OP_SW, OP_EW, OP_GT, OP_GE, OP_LT, OP_LE:
+	default:
+		return nil, NewBadRequest(SCIM_TYPE_INVALID_FILTER, "unknown operator %s", op)
+	}
+
+	if p.eof() {
+		return nil, NewBadRequest(SCIM_TYPE_INVALID_FILTER, "attribute %s missing value", attr)
+	}
+	v := p.next()
+	value, err := parseValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return &CompareFilter{Attr: attr, Op: op, Value: value}, nil
+}
+
+func parseValue(t token) (interface{}, error) {
+	switch t.kind {
+	case TOKEN_STRING:
+		return t.value, nil
+	case TOKEN_WORD:
+		switch strings.ToLower(t.value) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		if n, err := strconv.ParseFloat(t.value, 64); err == nil {
+			return n, nil
+		}
+	}
+	return nil, NewBadRequest(SCIM_TYPE_INVALID_FILTER, "invalid value %s", t)
+}
+
+// SimpleEqual 判断过滤条件是否是单个属性的相等比较, 用于优化查询, 比如: userName eq "bjensen"
+func SimpleEqual(f Filter) (attr string, value string, ok bool) {
+	c, isCompare := f.(*CompareFilter)
+	if !isCompare || c.Op != OP_EQ {
+		return "", "", false
+	}
+	value, ok = c.Value.(string)
+	return c.Attr, value, ok
+}
@@ -0,0 +1,100 @@
+package scim_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/scim"
+)
+
+var (
+	resource = map[string]interface{}{
+		"userName": "bjensen",
+		"active":   true,
+		"title":    "Tour Guide",
+		"name": map[string]interface{}{
+			"givenName":  "Barbara",
+			"familyName": "Jensen",
+		},
+		"emails": []interface{}{
+			map[string]interface{}{"value": "bjensen@example.com", "type": "work"},
+			map[string]interface{}{"value": "babs@jensen.org", "type": "home"},
+		},
+		"meta": map[string]interface{}{
+			"lastModified": "2011-05-13T04:42:34Z",
+		},
+	}
+)
+
+func TestFilterMatch(t *testing.T) {
+	should := assert.New(t)
+
+	cases := map[string]bool{
+		`userName eq "bjensen"`:    true,
+		`USERNAME EQ "BJensen"`:    true,
+		`userName ne "bjensen"`:    false,
+		`name.familyName co "ens"`: true,
+		`userName sw "bj"`:         true,
+		`userName ew "sen"`:        true,
+		`title pr`:                 true,
+		`nickName pr`:              false,
+		`active eq true`:           true,
+		`meta.lastModified gt "2011-05-13T04:42:34Z"`:                    false,
+		`meta.lastModified ge "2011-05-13T04:42:34Z"`:                    true,
+		`emails eq "babs@jensen.org"`:                                    true,
+		`emails.type eq "home"`:                                          true,
+		`emails[type eq "work" and value co "@example.com"]`:             true,
+		`emails[type eq "home" and value co "@example.com"]`:             false,
+		`title pr and not (userName eq "bjensen")`:                       false,
+		`userName eq "x" or (title pr and active eq true)`:               true,
+		`urn:ietf:params:scim:schemas:core:2.0:User:userName pr`:         true,
+		`userName eq "bjensen" and name.givenName eq "Barbara"`:          true,
+		`userName eq "bjensen" and name.givenName eq "Babs"`:             false,
+		`not (emails[type eq "other"]) and userName sw "bjen"`:           true,
+		`userName eq "bj\"ensen"`:                                        false,
+		`meta.lastModified lt "2012-01-01T00:00:00Z" and active eq true`: true,
+	}
+
+	for expr, expect := range cases {
+		f, err := scim.ParseFilter(expr)
+		if should.NoError(err, expr) {
+			should.Equal(expect, f.Match(resource), expr)
+		}
+	}
+}
+
+func TestFilterParseError(t *testing.T) {
+	should := assert.New(t)
+
+	for _, expr := range []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName xx "a"`,
+		`userName eq "a" and`,
+		`(userName eq "a"`,
+		`emails[type eq "work"`,
+		`userName eq "a`,
+		`userName eq abc`,
+	} {
+		_, err := scim.ParseFilter(expr)
+		should.Error(err, expr)
+	}
+}
+
+func TestSimpleEqual(t *testing.T) {
+	should := assert.New(t)
+
+	f, err := scim.ParseFilter(`userName eq "bjensen"`)
+	should.NoError(err)
+	attr, value, ok := scim.SimpleEqual(f)
+	should.True(ok)
+	should.Equal("userName", attr)
+	should.Equal("bjensen", value)
+
+	f, err = scim.ParseFilter(`userName eq "bjensen" and active eq true`)
+	should.NoError(err)
+	_, _, ok = scim.SimpleEqual(f)
+	should.False(ok)
+}
@@ -0,0 +1,286 @@
+package scim
+
+import (
+	"reflect"
+	"strings"
+)
+
+// PATCH操作: https://www.rfc-editor.org/rfc/rfc7644#section-3.5.2
+const (
+	PATCH_OP_ADD     = "add"
+	PATCH_OP_REPLACE = "replace"
+	PATCH_OP_REMOVE  = "remove"
+)
+
+// PatchRequest PATCH请求
+type PatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*PatchOperation `json:"Operations"`
+}
+
+func NewPatchRequest() *PatchRequest {
+	return &PatchRequest{
+		Operations: []*PatchOperation{},
+	}
+}
+
+func (req *PatchRequest) Validate() error {
+	if len(req.Operations) == 0 {
+		return NewBadRequest(SCIM_TYPE_INVALID_SYNTAX, "operations required")
+	}
+	return nil
+}
+
+// Apply 按顺序执行所有的操作, 任意一个失败则整体失败
+func (req *PatchRequest) Apply(resource map[string]interface{}) error {
+	for i := range req.Operations {
+		if err := req.Operations[i].Apply(resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PatchOperation 单个PATCH操作
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Path PATCH操作的目标路径, 比如: members[value eq "2819c223"].display
+type Path struct {
+	Attr   string
+	Filter Filter
+	Sub    string
+}
+
+// ParsePath 解析PATCH路径
+func ParsePath(path string) (*Path, error) {
+	path = trimSchema(strings.TrimSpace(path))
+	p := &Path{}
+
+	if i := strings.Index(path, "["); i > 0 {
+		j := strings.LastIndex(path, "]")
+		if j < i {
+			return nil, NewBadRequest(SCIM_TYPE_INVALID_PATH, "invalid path %s", path)
+		}
+		f, err := ParseFilter(path[i+1 : j])
+		if err != nil {
+			return nil, NewBadRequest(SCIM_TYPE_INVALID_PATH, "invalid path %s, %s", path, err)
+		}
+		p.Attr, p.Filter = path[:i], f
+		rest := path[j+1:]
+		if rest != "" {
+			if !strings.HasPrefix(rest, ".") || len(rest) == 1 {
+				return nil, NewBadRequest(SCIM_TYPE_INVALID_PATH, "invalid path %s", path)
+			}
+			p.Sub = rest[1:]
+		}
+		return p, nil
+	}
+
+	if path == "" || strings.ContainsAny(path, "] ") {
+		return nil, NewBadRequest(SCIM_TYPE_INVALID_PATH, "invalid path %s", path)
+	}
+	kv := strings.SplitN(path, ".", 2)
+	p.Attr = kv[0]
+	if len(kv) == 2 {
+		p.Sub = kv[1]
+	}
+	return p, nil
+}
+
+// Apply 在资源的JSON表示上执行操作
+func (o *PatchOperation) Apply(r map[string]interface{}) error {
+	op := strings.ToLower(o.Op)
+	switch op {
+	case PATCH_OP_ADD, PATCH_OP_REPLACE, PATCH_OP_REMOVE:
+	default:
+		return NewBadRequest(SCIM_TYPE_INVALID_SYNTAX, "unknown op %s", o.Op)
+	}
+
+	// 没有路径时, value为需要修改的属性集合
+	if o.Path == "" {
+		if op == PATCH_OP_REMOVE {
+			return NewBadRequest(SCIM_TYPE_NO_TARGET, "remove operation requires path")
+		}
+		values, ok := o.Value.(map[string]interface{})
+		if !ok {
+			return NewBadRequest(SCIM_TYPE_INVALID_VALUE, "value must be object when path is empty")
+		}
+		for k, v := range values {
+			sub := &PatchOperation{Op: op, Path: k, Value: v}
+			if err := sub.Apply(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	p, err := ParsePath(o.Path)
+	if err != nil {
+		return err
+	}
+
+	if p.Filter != nil {
+		return o.applyFilter(op, r, p)
+	}
+	if p.Sub != "" {
+		return o.applySub(op, r, p)
+	}
+	return o.applyAttr(op, r, p.Attr)
+}
+
+func (o *PatchOperation) applyAttr(op string, r map[string]interface{}, attr string) error {
+	key, exist := findKey(r, attr)
+	switch op {
+	case PATCH_OP_REMOVE:
+		// 多值属性携带value时, 只移除指定的值, 比如: {"op":"remove","path":"members","value":[{"value":"id"}]}
+		if old, ok := r[key].([]interface{}); ok && o.Value != nil {
+			result := []interface{}{}
+			remove := toSlice(o.Value)
+			for _, v := range old {
+				if !contains(remove, v) {
+					result = append(result, v)
+				}
+			}
+			r[key] = result
+			return nil
+		}
+		delete(r, key)
+	case PATCH_OP_REPLACE:
+		r[key] = o.Value
+	case PATCH_OP_ADD:
+		// 多值属性追加, 已经存在的值忽略
+		if old, ok := r[key].([]interface{}); exist && ok {
+			for _, v := range toSlice(o.Value) {
+				if !contains(old, v) {
+					old = append(old, v)
+				}
+			}
+			r[key] = old
+			return nil
+		}
+		// 复杂属性合并
+		if old, ok := r[key].(map[string]interface{}); exist && ok {
+			if values, ok := o.Value.(map[string]interface{}); ok {
+				for k, v := range values {
+					old[k] = v
+				}
+				return nil
+			}
+		}
+		r[key] = o.Value
+	}
+	return nil
+}
+
+func (o *PatchOperation) applySub(op string, r map[string]interface{}, p *Path) error {
+	key, exist := findKey(r, p.Attr)
+	if !exist {
+		if op == PATCH_OP_REMOVE {
+			return nil
+		}
+		r[key] = map[string]interface{}{}
+	}
+
+	switch t := r[key].(type) {
+	case map[string]interface{}:
+		return o.applyAttr(op, t, p.Sub)
+	case []interface{}:
+		for _, item := range t {
+			if m, ok := item.(map[string]interface{}); ok {
+				if err := o.applyAttr(op, m, p.Sub); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return NewBadRequest(SCIM_TYPE_INVALID_PATH, "attribute %s is not complex", p.Attr)
+}
+
+func (o *PatchOperation) applyFilter(op string, r map[string]interface{}, p *Path) error {
+	key, _ := findKey(r, p.Attr)
+	items := toSlice(r[key])
+
+	matched := 0
+	result := []interface{}{}
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok || !p.Filter.Match(m) {
+			result = append(result, item)
+			continue
+		}
+
+		matched++
+		switch {
+		case op == PATCH_OP_REMOVE && p.Sub == "":
+			continue
+		case p.Sub != "":
+			if err := o.applyAttr(op, m, p.Sub); err != nil {
+				return err
+			}
+		default:
+			values, ok := o.Value.(map[string]interface{})
+			if !ok {
+				return NewBadRequest(SCIM_TYPE_INVALID_VALUE, "value must be object")
+			}
+			if op == PATCH_OP_REPLACE {
+				m = map[string]interface{}{}
+			}
+			for k, v := range values {
+				m[k] = v
+			}
+		}
+		result = append(result, m)
+	}
+
+	if matched == 0 {
+		if op == PATCH_OP_REMOVE {
+			return nil
+		}
+		// 没有匹配的元素时, 如果过滤条件是简单的相等比较, 则创建一个新元素
+		// 比如: emails[type eq "work"].value
+		attr, value, ok := SimpleEqual(p.Filter)
+		if !ok || (p.Sub == "" && o.Value == nil) {
+			return NewBadRequest(SCIM_TYPE_NO_TARGET, "no target match %s", o.Path)
+		}
+		m := map[string]interface{}{attr: value}
+		if p.Sub != "" {
+			m[p.Sub] = o.Value
+		} else if values, ok := o.Value.(map[string]interface{}); ok {
+			for k, v := range values {
+				m[k] = v
+			}
+		}
+		result = append(result, m)
+	}
+
+	r[key] = result
+	return nil
+}
+
+func contains(items []interface{}, v interface{}) bool {
+	for _, item := range items {
+		if equal(item, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// equal 多值的复杂属性通过value子属性判断是否相同
+func equal(a, b interface{}) bool {
+	am, aok := a.(map[string]interface{})
+	bm, bok := b.(map[string]interface{})
+	if aok && bok {
+		av, bv := getValue(am, "value"), getValue(bm, "value")
+		return av != nil && reflect.DeepEqual(av, bv)
+	}
+	if aok || bok {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,104 @@
+package scim_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/scim"
+)
+
+func newResource(t *testing.T) map[string]interface{} {
+	m := map[string]interface{}{}
+	err := json.Unmarshal([]byte(`{
+		"userName": "bjensen",
+		"displayName": "Babs",
+		"active": true,
+		"emails": [{"value": "bjensen@example.com", "type": "work", "primary": true}],
+		"members": [{"value": "u1"}, {"value": "u2"}]
+	}`), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func newPatch(t *testing.T, body string) *scim.PatchRequest {
+	req := scim.NewPatchRequest()
+	if err := json.Unmarshal([]byte(body), req); err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func TestPatchReplace(t *testing.T) {
+	should := assert.New(t)
+
+	m := newResource(t)
+	req := newPatch(t, `{"Operations": [
+		{"op": "Replace", "path": "active", "value": false},
+		{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "babs@example.com"},
+		{"op": "replace", "value": {"displayName": "Barbara", "name.givenName": "Barbara"}}
+	]}`)
+	should.NoError(req.Validate())
+	should.NoError(req.Apply(m))
+
+	should.Equal(false, m["active"])
+	should.Equal("Barbara", m["displayName"])
+	should.Equal("Barbara", m["name"].(map[string]interface{})["givenName"])
+	email := m["emails"].([]interface{})[0].(map[string]interface{})
+	should.Equal("babs@example.com", email["value"])
+	should.Equal(true, email["primary"])
+}
+
+func TestPatchAddFilterNoMatch(t *testing.T) {
+	should := assert.New(t)
+
+	m := newResource(t)
+	req := newPatch(t, `{"Operations": [
+		{"op": "add", "path": "emails[type eq \"home\"].value", "value": "babs@jensen.org"}
+	]}`)
+	should.NoError(req.Apply(m))
+	should.Len(m["emails"], 2)
+
+	f, _ := scim.ParseFilter(`emails[type eq "home" and value eq "babs@jensen.org"]`)
+	should.True(f.Match(m))
+}
+
+func TestPatchMembers(t *testing.T) {
+	should := assert.New(t)
+
+	m := newResource(t)
+	req := newPatch(t, `{"Operations": [
+		{"op": "add", "path": "members", "value": [{"value": "u2"}, {"value": "u3"}]},
+		{"op": "remove", "path": "members[value eq \"u1\"]"},
+		{"op": "remove", "path": "members", "value": [{"value": "u2"}]}
+	]}`)
+	should.NoError(req.Apply(m))
+
+	members := m["members"].([]interface{})
+	if should.Len(members, 1) {
+		should.Equal("u3", members[0].(map[string]interface{})["value"])
+	}
+}
+
+func TestPatchError(t *testing.T) {
+	should := assert.New(t)
+
+	for _, body := range []string{
+		`{"Operations": []}`,
+		`{"Operations": [{"op": "move", "path": "active"}]}`,
+		`{"Operations": [{"op": "remove"}]}`,
+		`{"Operations": [{"op": "replace", "value": "x"}]}`,
+		`{"Operations": [{"op": "replace", "path": "emails[type eq ]", "value": "x"}]}`,
+		`{"Operations": [{"op": "replace", "path": "emails[type pr]"}]}`,
+	} {
+		req := newPatch(t, body)
+		err := req.Validate()
+		if err == nil {
+			err = req.Apply(newResource(t))
+		}
+		should.Error(err, body)
+	}
+}
@@ -0,0 +1,387 @@
+package scim
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/infraboard/mcenter/apps/group"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+const (
+	// 通过SCIM停用的用户, 冻结原因
+	DEPROVISION_REASON = "deprovisioned by scim"
+	// 通过SCIM修改用户状态时的操作人
+	SCIM_OPERATOR = "scim"
+)
+
+// User SCIM用户资源: https://www.rfc-editor.org/rfc/rfc7643#section-4.1
+type User struct {
+	Schemas           []string       `json:"schemas"`
+	Id                string         `json:"id,omitempty"`
+	ExternalId        string         `json:"externalId,omitempty"`
+	UserName          string         `json:"userName"`
+	Name              *Name          `json:"name,omitempty"`
+	DisplayName       string         `json:"displayName,omitempty"`
+	NickName          string         `json:"nickName,omitempty"`
+	PreferredLanguage string         `json:"preferredLanguage,omitempty"`
+	Password          string         `json:"password,omitempty"`
+	Active            *bool          `json:"active,omitempty"`
+	Emails            []*MultiValued `json:"emails,omitempty"`
+	PhoneNumbers      []*MultiValued `json:"phoneNumbers,omitempty"`
+	Addresses         []*Address     `json:"addresses,omitempty"`
+	Groups            []*Reference   `json:"groups,omitempty"`
+	Meta              *Meta          `json:"meta,omitempty"`
+	Mcenter           *McenterUser   `json:"urn:ietf:params:scim:schemas:extension:mcenter:2.0:User,omitempty"`
+}
+
+// McenterUser 用户中心扩展属性
+type McenterUser struct {
+	// 域自定义的用户属性
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+type MultiValued struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type Address struct {
+	Formatted string `json:"formatted,omitempty"`
+	Locality  string `json:"locality,omitempty"`
+	Region    string `json:"region,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Primary   bool   `json:"primary,omitempty"`
+}
+
+// Reference 资源引用, 用于组成员和用户所属的组
+type Reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+func NewUser() *User {
+	return &User{
+		Schemas: []string{SCHEMA_USER},
+	}
+}
+
+// NewUserFromMcenter 把用户中心的用户转换为SCIM用户
+func NewUserFromMcenter(u *user.User, groups *group.GroupSet, baseURL string) *User {
+	su := NewUser()
+	su.Id = u.Id
+	su.ExternalId = u.Spec.ExternalId
+	su.UserName = u.Spec.Username
+	active := !u.IsLocked()
+	su.Active = &active
+
+	if p := u.Profile; p != nil {
+		if p.RealName != "" {
+			su.Name = &Name{Formatted: p.RealName}
+		}
+		su.DisplayName = p.NickName
+		su.PreferredLanguage = p.Language
+		if p.Email != "" {
+			su.Emails = []*MultiValued{{Value: p.Email, Type: "work", Primary: true}}
+		}
+		if p.Phone != "" {
+			su.PhoneNumbers = []*MultiValued{{Value: p.Phone, Type: "work", Primary: true}}
+		}
+		if len(p.Attributes) > 0 {
+			su.Schemas = append(su.Schemas, SCHEMA_MCENTER_USER)
+			su.Mcenter = &McenterUser{Attributes: p.Attributes}
+		}
+		if p.Address != "" || p.City != "" || p.Province != "" {
+			su.Addresses = []*Address{{
+				Formatted: p.Address,
+				Locality:  p.City,
+				Region:    p.Province,
+				Type:      "work",
+				Primary:   true,
+			}}
+		}
+	}
+
+	if groups != nil {
+		for _, g := range groups.Items {
+			su.Groups = append(su.Groups, &Reference{
+				Value:   g.Id,
+				Display: g.Spec.Name,
+				Ref:     baseURL + "/Groups/" + g.Id,
+				Type:    "direct",
+			})
+		}
+	}
+
+	su.Meta = &Meta{
+		ResourceType: "User",
+		Created:      formatTime(u.CreateAt),
+		LastModified: formatTime(lastModified(u.CreateAt, u.UpdateAt)),
+		Location:     baseURL + "/Users/" + u.Id,
+		Version:      ETag(u.CreateAt, u.UpdateAt),
+	}
+	return su
+}
+
+func (u *User) Validate() error {
+	if u.UserName == "" {
+		return NewBadRequest(SCIM_TYPE_INVALID_VALUE, "userName required")
+	}
+	return nil
+}
+
+// UnmarshalJSON 兼容部分客户端(比如Azure AD)使用字符串表示active, 比如: "False"
+func (u *User) UnmarshalJSON(b []byte) error {
+	type alias User
+	aux := &struct {
+		Active interface{} `json:"active,omitempty"`
+		*alias
+	}{alias: (*alias)(u)}
+	if err := json.Unmarshal(b, aux); err != nil {
+		return err
+	}
+
+	switch v := aux.Active.(type) {
+	case nil:
+		u.Active = nil
+	case bool:
+		u.Active = &v
+	case string:
+		active, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid active value %s", v)
+		}
+		u.Active = &active
+	default:
+		return fmt.Errorf("invalid active value %v", v)
+	}
+	return nil
+}
+
+// IsActive 未设置时默认为激活状态
+func (u *User) IsActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// Profile 转换为用户中心的用户Profile
+func (u *User) Profile() *user.Profile {
+	p := user.NewProfile()
+	if u.Name != nil {
+		p.RealName = u.Name.Formatted
+		if p.RealName == "" {
+			p.RealName = strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+		}
+	}
+	p.NickName = u.DisplayName
+	if p.NickName == "" {
+		p.NickName = u.NickName
+	}
+	p.Language = u.PreferredLanguage
+	if u.Mcenter != nil {
+		p.Attributes = u.Mcenter.Attributes
+	}
+	if e := primary(u.Emails); e != nil {
+		p.Email = e.Value
+	}
+	if e := primary(u.PhoneNumbers); e != nil {
+		p.Phone = e.Value
+	}
+	for i := range u.Addresses {
+		if i == 0 || u.Addresses[i].Primary {
+			p.Address = u.Addresses[i].Formatted
+			p.City = u.Addresses[i].Locality
+			p.Province = u.Addresses[i].Region
+		}
+	}
+	return p
+}
+
+// CreateUserRequest 转换为创建用户的请求, 没有提供密码时生成随机密码
+func (u *User) CreateUserRequest(domain string) *user.CreateUserRequest {
+	req := user.NewCreateUserRequest()
+	req.Provider = user.PROVIDER_LOCAL
+	req.Type = user.TYPE_SUB
+	req.CreateBy = user.CREATE_BY_SYNC
+	req.Domain = domain
+	req.Username = u.UserName
+	req.Password = u.Password
+	if req.Password == "" {
+		req.Password = RandomPassword()
+	}
+	req.ExternalId = u.ExternalId
+	if u.Mcenter != nil {
+		req.Attributes = u.Mcenter.Attributes
+	}
+	return req
+}
+
+// PutUserRequest 转换为全量更新用户的请求, 没有携带用户中心扩展时保留用户原有的自定义属性
+func (u *User) PutUserRequest(ins *user.User) *user.UpdateUserRequest {
+	req := user.NewPutUserRequest(ins.Id)
+	req.Profile = u.Profile()
+	if u.Mcenter == nil && ins.Profile != nil {
+		req.Profile.Attributes = ins.Profile.Attributes
+	}
+	return req
+}
+
+func primary(items []*MultiValued) *MultiValued {
+	var v *MultiValued
+	for i := range items {
+		if v == nil || items[i].Primary {
+			v = items[i]
+		}
+	}
+	return v
+}
+
+// Group SCIM用户组资源: https://www.rfc-editor.org/rfc/rfc7643#section-4.2
+type Group struct {
+	Schemas     []string     `json:"schemas"`
+	Id          string       `json:"id,omitempty"`
+	ExternalId  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []*Reference `json:"members,omitempty"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+func NewGroup() *Group {
+	return &Group{
+		Schemas: []string{SCHEMA_GROUP},
+	}
+}
+
+// NewGroupFromMcenter 把用户中心的用户组转换为SCIM用户组, users用于补充成员的显示名称
+func NewGroupFromMcenter(g *group.Group, users *user.UserSet, baseURL string) *Group {
+	display := map[string]string{}
+	if users != nil {
+		for _, u := range users.Items {
+			display[u.Id] = u.Spec.Username
+		}
+	}
+
+	sg := NewGroup()
+	sg.Id = g.Id
+	sg.ExternalId = g.Spec.ExternalId
+	sg.DisplayName = g.Spec.Name
+	for _, uid := range g.Spec.Users {
+		sg.Members = append(sg.Members, &Reference{
+			Value:   uid,
+			Display: display[uid],
+			Ref:     baseURL + "/Users/" + uid,
+			Type:    "User",
+		})
+	}
+	sg.Meta = &Meta{
+		ResourceType: "Group",
+		Created:      formatTime(g.CreateAt),
+		LastModified: formatTime(lastModified(g.CreateAt, g.UpdateAt)),
+		Location:     baseURL + "/Groups/" + g.Id,
+		Version:      ETag(g.CreateAt, g.UpdateAt),
+	}
+	return sg
+}
+
+func (g *Group) Validate() error {
+	if g.DisplayName == "" {
+		return NewBadRequest(SCIM_TYPE_INVALID_VALUE, "displayName required")
+	}
+	for _, m := range g.Members {
+		if m.Type != "" && !strings.EqualFold(m.Type, "User") {
+			return NewBadRequest(SCIM_TYPE_INVALID_VALUE, "member type %s not supported", m.Type)
+		}
+	}
+	return nil
+}
+
+// UserIds 组成员的用户Id列表
+func (g *Group) UserIds() []string {
+	ids := []string{}
+	for _, m := range g.Members {
+		ids = append(ids, m.Value)
+	}
+	return ids
+}
+
+// CreateGroupRequest 转换为创建用户组的请求
+func (g *Group) CreateGroupRequest(domain, createBy string) *group.CreateGroupRequest {
+	req := group.NewCreateGroupRequest()
+	req.Domain = domain
+	req.Name = g.DisplayName
+	req.CreateBy = createBy
+	req.ExternalId = g.ExternalId
+	req.Users = g.UserIds()
+	return req
+}
+
+// PutGroupRequest 转换为全量更新用户组的请求
+func (g *Group) PutGroupRequest(groupId, updateBy string) *group.UpdateGroupRequest {
+	req := group.NewPutGroupRequest(groupId)
+	req.UpdateBy = updateBy
+	req.Spec.Name = g.DisplayName
+	req.Spec.ExternalId = g.ExternalId
+	req.Spec.Users = g.UserIds()
+	return req
+}
+
+// ToMap 资源转换为JSON对象, 用于过滤和PATCH
+func ToMap(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FromMap JSON对象转换为资源
+func FromMap(m map[string]interface{}, v interface{}) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return NewBadRequest(SCIM_TYPE_INVALID_VALUE, err.Error())
+	}
+	return nil
+}
+
+// RandomPassword 外部系统没有同步密码时, 使用随机密码创建用户
+func RandomPassword() string {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func formatTime(ms int64) string {
+	if ms == 0 {
+		return ""
+	}
+	return time.UnixMilli(ms).UTC().Format(time.RFC3339)
+}
+
+func lastModified(createAt, updateAt int64) int64 {
+	if updateAt > createAt {
+		return updateAt
+	}
+	return createAt
+}
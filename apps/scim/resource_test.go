@@ -0,0 +1,62 @@
+package scim_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/scim"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+func TestUserActive(t *testing.T) {
+	should := assert.New(t)
+
+	u := scim.NewUser()
+	should.NoError(json.Unmarshal([]byte(`{"userName": "bjensen", "active": "False"}`), u))
+	should.False(u.IsActive())
+	should.Equal("bjensen", u.UserName)
+
+	u = scim.NewUser()
+	should.NoError(json.Unmarshal([]byte(`{"userName": "bjensen"}`), u))
+	should.True(u.IsActive())
+
+	should.Error(json.Unmarshal([]byte(`{"active": "no"}`), scim.NewUser()))
+}
+
+func TestUserConvert(t *testing.T) {
+	should := assert.New(t)
+
+	u := user.NewDefaultUser()
+	u.Id = "u1"
+	u.CreateAt = 1668000000000
+	u.Spec = user.NewCreateUserRequest()
+	u.Spec.Username = "bjensen"
+	u.Profile = &user.Profile{RealName: "Barbara", Email: "bjensen@example.com"}
+	u.Lock(scim.DEPROVISION_REASON)
+
+	su := scim.NewUserFromMcenter(u, nil, "http://localhost/scim/v2")
+	should.False(su.IsActive())
+	should.Equal("http://localhost/scim/v2/Users/u1", su.Meta.Location)
+	should.Equal(`W/"1668000000000"`, su.Meta.Version)
+
+	p := su.Profile()
+	should.Equal("Barbara", p.RealName)
+	should.Equal("bjensen@example.com", p.Email)
+
+	req := su.CreateUserRequest("default")
+	should.NotEmpty(req.Password)
+	should.Equal("default", req.Domain)
+}
+
+func TestETag(t *testing.T) {
+	should := assert.New(t)
+
+	etag := scim.ETag(1, 2)
+	should.Equal(`W/"2"`, etag)
+	should.True(scim.MatchETag(`"2"`, etag))
+	should.True(scim.MatchETag(`W/"1", W/"2"`, etag))
+	should.True(scim.MatchETag(`*`, etag))
+	should.False(scim.MatchETag(`W/"1"`, etag))
+}
@@ -0,0 +1,178 @@
+package scim
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/infraboard/mcube/exception"
+)
+
+// SCIM 2.0 协议: https://www.rfc-editor.org/rfc/rfc7644
+const (
+	AppName = "scim"
+)
+
+const (
+	SCHEMA_USER                   = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCHEMA_GROUP                  = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCHEMA_SERVICE_PROVIDER       = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SCHEMA_RESOURCE_TYPE          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	SCHEMA_SCHEMA                 = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+	SCHEMA_LIST_RESPONSE          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCHEMA_PATCH_OP               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SCHEMA_ERROR                  = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SCHEMA_ENTERPRISE_USER_PREFIX = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	// 用户中心扩展, 用于同步域自定义的用户属性
+	SCHEMA_MCENTER_USER = "urn:ietf:params:scim:schemas:extension:mcenter:2.0:User"
+)
+
+const (
+	// SCIM协议使用的Content-Type
+	MIME_SCIM = "application/scim+json"
+	// 单次查询最大返回的资源数量
+	MAX_RESULTS = 200
+	// 默认返回的资源数量
+	DEFAULT_COUNT = 100
+)
+
+// SCIM错误类型 https://www.rfc-editor.org/rfc/rfc7644#section-3.12
+const (
+	SCIM_TYPE_INVALID_FILTER = "invalidFilter"
+	SCIM_TYPE_INVALID_PATH   = "invalidPath"
+	SCIM_TYPE_INVALID_VALUE  = "invalidValue"
+	SCIM_TYPE_INVALID_SYNTAX = "invalidSyntax"
+	SCIM_TYPE_NO_TARGET      = "noTarget"
+	SCIM_TYPE_MUTABILITY     = "mutability"
+	SCIM_TYPE_UNIQUENESS     = "uniqueness"
+)
+
+// Error SCIM协议的错误响应
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+	Status   string   `json:"status"`
+
+	status int
+}
+
+func NewError(status int, scimType, format string, a ...interface{}) *Error {
+	return &Error{
+		Schemas:  []string{SCHEMA_ERROR},
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, a...),
+		Status:   fmt.Sprintf("%d", status),
+		status:   status,
+	}
+}
+
+func NewBadRequest(scimType, format string, a ...interface{}) *Error {
+	return NewError(http.StatusBadRequest, scimType, format, a...)
+}
+
+// NewErrorFromException 把内部服务的异常转换为SCIM错误
+func NewErrorFromException(err error) *Error {
+	switch t := err.(type) {
+	case *Error:
+		return t
+	case exception.APIException:
+		code := t.ErrorCode()
+		switch {
+		case code == exception.Conflict:
+			return NewError(http.StatusConflict, SCIM_TYPE_UNIQUENESS, t.Error())
+		case code/100 >= 4 && code/100 <= 5:
+			return NewError(code, "", t.Error())
+		case code >= exception.AccessTokenExpired && code <= exception.RefreshTokenIllegal,
+			code >= exception.OtherPlaceLoggedIn && code <= exception.SessionTerminated:
+			return NewError(http.StatusUnauthorized, "", t.Error())
+		}
+	}
+	return NewError(http.StatusInternalServerError, "", err.Error())
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
+
+// HTTPStatus 响应的HTTP状态码
+func (e *Error) HTTPStatus() int {
+	return e.status
+}
+
+// ListResponse 列表查询响应
+type ListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int64         `json:"totalResults"`
+	StartIndex   int64         `json:"startIndex"`
+	ItemsPerPage int64         `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+func NewListResponse(startIndex int64) *ListResponse {
+	return &ListResponse{
+		Schemas:    []string{SCHEMA_LIST_RESPONSE},
+		StartIndex: startIndex,
+		Resources:  []interface{}{},
+	}
+}
+
+func (l *ListResponse) Add(item interface{}) {
+	l.Resources = append(l.Resources, item)
+	l.ItemsPerPage = int64(len(l.Resources))
+}
+
+// Meta 资源元数据
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+// ETag 基于修改时间生成弱校验的版本号
+func ETag(createAt, updateAt int64) string {
+	if updateAt > createAt {
+		return fmt.Sprintf(`W/"%d"`, updateAt)
+	}
+	return fmt.Sprintf(`W/"%d"`, createAt)
+}
+
+// MatchETag 判断If-Match/If-None-Match头中是否包含指定的版本
+func MatchETag(header, etag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.TrimPrefix(v, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Pagination SCIM的分页参数, startIndex从1开始
+type Pagination struct {
+	StartIndex int64
+	Count      int64
+}
+
+func NewPagination(startIndex, count int64) *Pagination {
+	if startIndex < 1 {
+		startIndex = 1
+	}
+	if count < 0 {
+		count = 0
+	}
+	if count > MAX_RESULTS {
+		count = MAX_RESULTS
+	}
+	return &Pagination{
+		StartIndex: startIndex,
+		Count:      count,
+	}
+}
+
+// Offset 数据的偏移量
+func (p *Pagination) Offset() int64 {
+	return p.StartIndex - 1
+}
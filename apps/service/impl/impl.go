@@ -12,6 +12,8 @@ import (
 	"github.com/infraboard/mcube/logger/zap"
 	"google.golang.org/grpc"
 
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/service"
 	"github.com/infraboard/mcenter/conf"
 )
@@ -25,6 +27,9 @@ type impl struct {
 	col *mongo.Collection
 	log logger.Logger
 	service.UnimplementedRPCServer
+
+	domain    domain.Service
+	namespace namespace.Service
 }
 
 func (i *impl) Config() error {
@@ -54,6 +59,8 @@ func (i *impl) Config() error {
 	}
 
 	i.log = zap.L().Named(i.Name())
+	i.domain = app.GetInternalApp(domain.AppName).(domain.Service)
+	i.namespace = app.GetInternalApp(namespace.AppName).(namespace.Service)
 	return nil
 }
 
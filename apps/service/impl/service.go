@@ -3,9 +3,12 @@ package impl
 import (
 	"context"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/service"
 	"github.com/infraboard/mcube/exception"
 	"github.com/infraboard/mcube/pb/request"
@@ -32,6 +35,16 @@ func (i *impl) CreateService(ctx context.Context, req *service.CreateServiceRequ
 		return nil, exception.NewBadRequest("validate create book error, %s", err)
 	}
 
+	// 已归档和删除中的空间是只读的
+	if err := i.checkNamespaceWritable(ctx, ins.Spec.Domain, ins.Spec.Namespace); err != nil {
+		return nil, err
+	}
+
+	// 检查域的服务配额
+	if err := i.checkQuota(ctx, ins.Spec.Domain); err != nil {
+		return nil, err
+	}
+
 	if err := i.save(ctx, ins); err != nil {
 		return nil, err
 	}
@@ -97,3 +110,88 @@ func (i *impl) RefreshCredential(ctx context.Context, req *service.DescribeServi
 	*service.Service, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshCredential not implemented")
 }
+
+// 删除域下所有的服务
+func (i *impl) DeleteDomainResource(ctx context.Context, req *domain.DeleteDomainResourceRequest) (
+	*domain.DeleteDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	rs, err := i.col.DeleteMany(ctx, bson.M{"spec.domain": req.Domain})
+	if err != nil {
+		return nil, exception.NewInternalServerError("delete domain %s service error, %s", req.Domain, err)
+	}
+	return domain.NewDeleteDomainResourceResponse(rs.DeletedCount), nil
+}
+
+// 统计域下的服务数量, 用于配额检查
+func (i *impl) CountDomainResource(ctx context.Context, req *domain.CountDomainResourceRequest) (
+	*domain.CountDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	count, err := i.col.CountDocuments(ctx, bson.M{"spec.domain": req.Domain})
+	if err != nil {
+		return nil, exception.NewInternalServerError("count domain %s service error, %s", req.Domain, err)
+	}
+	return domain.NewCountDomainResourceResponse(count), nil
+}
+
+// 统计空间下的服务数量, 删除空间前检查依赖
+func (i *impl) CountNamespaceResource(ctx context.Context, req *namespace.CountNamespaceResourceRequest) (
+	*namespace.CountNamespaceResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	count, err := i.col.CountDocuments(ctx, bson.M{"spec.domain": req.Domain, "spec.namespace": req.Namespace})
+	if err != nil {
+		return nil, exception.NewInternalServerError("count namespace %s service error, %s", req.Namespace, err)
+	}
+	return namespace.NewCountNamespaceResourceResponse(count), nil
+}
+
+// 删除空间下所有的服务
+func (i *impl) DeleteNamespaceResource(ctx context.Context, req *namespace.DeleteNamespaceResourceRequest) (
+	*namespace.DeleteNamespaceResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	rs, err := i.col.DeleteMany(ctx, bson.M{"spec.domain": req.Domain, "spec.namespace": req.Namespace})
+	if err != nil {
+		return nil, exception.NewInternalServerError("delete namespace %s service error, %s", req.Namespace, err)
+	}
+	return namespace.NewDeleteNamespaceResourceResponse(rs.DeletedCount), nil
+}
+
+func (i *impl) checkQuota(ctx context.Context, domainName string) error {
+	if domainName == "" {
+		return nil
+	}
+
+	resp, err := i.CountDomainResource(ctx, domain.NewCountDomainResourceRequest(domainName))
+	if err != nil {
+		return err
+	}
+	_, err = i.domain.CheckQuota(ctx, domain.NewCheckQuotaRequest(domainName, domain.QUOTA_RESOURCE_SERVICE, resp.Count))
+	return err
+}
+
+// 服务所在的空间必须可写, 没有指定空间时不检查
+func (i *impl) checkNamespaceWritable(ctx context.Context, domain, ns string) error {
+	if ns == "" {
+		return nil
+	}
+
+	ins, err := i.namespace.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(domain, ns))
+	if err != nil {
+		return err
+	}
+	if err := ins.CheckWritable(); err != nil {
+		return exception.NewBadRequest(err.Error())
+	}
+	return nil
+}
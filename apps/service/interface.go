@@ -37,6 +37,14 @@ type MetaService interface {
 	UpdateService(context.Context, *UpdateServiceRequest) (*Service, error)
 	DeleteService(context.Context, *DeleteServiceRequest) (*Service, error)
 	RefreshCredential(context.Context, *DescribeServiceRequest) (*Service, error)
+	// 删除域下所有的服务, 清除域时调用
+	DeleteDomainResource(context.Context, *domain.DeleteDomainResourceRequest) (*domain.DeleteDomainResourceResponse, error)
+	// 统计域下的服务数量, 用于配额检查
+	CountDomainResource(context.Context, *domain.CountDomainResourceRequest) (*domain.CountDomainResourceResponse, error)
+	// 统计空间下的服务数量, 删除空间前检查依赖
+	CountNamespaceResource(context.Context, *namespace.CountNamespaceResourceRequest) (*namespace.CountNamespaceResourceResponse, error)
+	// 删除空间下所有的服务, 级联删除空间时调用
+	DeleteNamespaceResource(context.Context, *namespace.DeleteNamespaceResourceRequest) (*namespace.DeleteNamespaceResourceResponse, error)
 	RPCServer
 }
 
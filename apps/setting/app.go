@@ -3,11 +3,14 @@ package setting
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/infraboard/mcenter/apps/notify"
 	"github.com/infraboard/mcenter/apps/notify/provider/mail"
 	"github.com/infraboard/mcenter/apps/notify/provider/sms"
+	"github.com/infraboard/mcenter/apps/user"
 )
 
 const (
@@ -24,10 +27,13 @@ func NewDefaultSetting() *Setting {
 	return &Setting{
 		Version: DEFAULT_CONFIG_VERSION,
 		Notify: &Notify{
-			Type:  notify.NOTIFY_TYPE_MAIL,
-			Email: mail.NewDefaultConfig(),
-			SMS:   sms.NewDefaultSMS(),
-			Code:  NewDefaultCode(),
+			Type:            notify.NOTIFY_TYPE_MAIL,
+			Email:           mail.NewDefaultConfig(),
+			SMS:             sms.NewDefaultSMS(),
+			Code:            NewDefaultCode(),
+			PasswordChanged: NewDefaultPasswordChanged(),
+			Invitation:      NewDefaultInvitation(),
+			StatusChanged:   NewDefaultStatusChanged(),
 		},
 	}
 }
@@ -57,6 +63,30 @@ type Notify struct {
 	SMS *sms.SMS `bson:"sms" json:"sms"`
 	// 验证码配置
 	Code *Code `bson:"code" json:"code"`
+	// 密码修改通知配置
+	PasswordChanged *PasswordChanged `bson:"password_changed" json:"password_changed"`
+	// 用户邀请通知配置
+	Invitation *Invitation `bson:"invitation" json:"invitation"`
+	// 账号状态变更通知配置
+	StatusChanged *StatusChanged `bson:"status_changed" json:"status_changed"`
+	// 验证码和通知只发送到已验证的邮箱和手机
+	RequireVerified bool `bson:"require_verified" json:"require_verified"`
+}
+
+// CheckVerified 开启RequireVerified时, 检查通知方式对应的联系方式是否已验证
+func (n *Notify) CheckVerified(u *user.User) error {
+	if !n.RequireVerified {
+		return nil
+	}
+
+	t := user.CONTACT_TYPE_MAIL
+	if n.Type.Equal(notify.NOTIFY_TYPE_SMS) {
+		t = user.CONTACT_TYPE_PHONE
+	}
+	if !u.IsVerified(t) {
+		return fmt.Errorf("user %s %s not verified", u.Spec.Username, t)
+	}
+	return nil
 }
 
 // NewDefaultConfig todo
@@ -86,3 +116,87 @@ func (c *Code) RenderMailCentent(code string, expireMinite uint32) string {
 	t1 := strings.ReplaceAll(c.MailTemplate, "{1}", code)
 	return strings.ReplaceAll(t1, "{2}", fmt.Sprintf("%d", expireMinite))
 }
+
+// NewDefaultPasswordChanged todo
+func NewDefaultPasswordChanged() *PasswordChanged {
+	return &PasswordChanged{
+		MailTemplate: "您的账号{1}的密码已于{2}修改, 如非本人操作, 请立即联系管理员！",
+	}
+}
+
+type PasswordChanged struct {
+	// 邮件通知时的模板
+	MailTemplate string `bson:"mail_template" json:"mail_template"`
+	// 短信通知时的云商模板ID, 为空时不发送短信通知
+	SmsTemplateID string `bson:"sms_template_id" json:"sms_template_id"`
+}
+
+// RenderMailCentent todo
+func (c *PasswordChanged) RenderMailCentent(username string, changeAt time.Time) string {
+	t1 := strings.ReplaceAll(c.MailTemplate, "{1}", username)
+	return strings.ReplaceAll(t1, "{2}", changeAt.Format("2006-01-02 15:04:05"))
+}
+
+// NewDefaultStatusChanged todo
+func NewDefaultStatusChanged() *StatusChanged {
+	return &StatusChanged{
+		MailTemplate: "您的账号{1}于{2}发生变更: {3}, 原因: {4}, 如有疑问, 请联系管理员！",
+	}
+}
+
+type StatusChanged struct {
+	// 邮件通知时的模板
+	MailTemplate string `bson:"mail_template" json:"mail_template"`
+	// 短信通知时的云商模板ID, 为空时不发送短信通知
+	SmsTemplateID string `bson:"sms_template_id" json:"sms_template_id"`
+}
+
+// RenderMailCentent todo
+func (c *StatusChanged) RenderMailCentent(username string, changeAt time.Time, summary, reason string) string {
+	return strings.NewReplacer(
+		"{1}", username,
+		"{2}", changeAt.Format("2006-01-02 15:04:05"),
+		"{3}", summary,
+		"{4}", reason,
+	).Replace(c.MailTemplate)
+}
+
+// NewDefaultInvitation todo
+func NewDefaultInvitation() *Invitation {
+	return &Invitation{
+		ExpireHours:  72,
+		ActivateURL:  "http://localhost:8080/#/account/activate",
+		MailTemplate: "{1}邀请您加入, 您的账号为{2}, 请在{3}小时内点击链接设置密码并激活账号: {4}",
+	}
+}
+
+type Invitation struct {
+	// 激活链接默认有效时间
+	ExpireHours uint32 `bson:"expire_hours" json:"expire_hours" validate:"required"`
+	// 前端激活页面的地址, 激活令牌通过token参数传递
+	ActivateURL string `bson:"activate_url" json:"activate_url"`
+	// 邮件通知时的模板
+	MailTemplate string `bson:"mail_template" json:"mail_template"`
+}
+
+// ActivateLink 激活链接
+func (c *Invitation) ActivateLink(token string) string {
+	sep := "?"
+	if strings.Contains(c.ActivateURL, "?") {
+		sep = "&"
+	}
+	return c.ActivateURL + sep + "token=" + url.QueryEscape(token)
+}
+
+// RenderMailCentent todo
+func (c *Invitation) RenderMailCentent(inviteBy, username, link string, expireHours uint32) string {
+	// 如果为0 则使用默认值
+	if expireHours == 0 {
+		expireHours = c.ExpireHours
+	}
+
+	t1 := strings.ReplaceAll(c.MailTemplate, "{1}", inviteBy)
+	t2 := strings.ReplaceAll(t1, "{2}", username)
+	t3 := strings.ReplaceAll(t2, "{3}", fmt.Sprintf("%d", expireHours))
+	return strings.ReplaceAll(t3, "{4}", link)
+}
@@ -1,9 +1,11 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo/gridfs"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
@@ -67,6 +69,53 @@ func (s *service) Download(req *storage.DownloadFileRequest) error {
 	return nil
 }
 
+func (s *service) DeleteFile(req *storage.DeleteFileRequest) error {
+	if err := req.Validate(); err != nil {
+		return exception.NewBadRequest("valiate delete file request error, %s", err)
+	}
+
+	bucket, err := s.getBucket(req.BucketName)
+	if err != nil {
+		return err
+	}
+
+	if err := bucket.Delete(req.FileID); err != nil {
+		if err == gridfs.ErrFileNotFound {
+			return exception.NewNotFound("file %s not found", req.FileID)
+		}
+		return err
+	}
+
+	s.log.Debugf("delete file: %s complete", req.FileID)
+	return nil
+}
+
+func (s *service) DescribeFile(req *storage.DescribeFileRequest) (*storage.File, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("valiate describe file request error, %s", err)
+	}
+
+	bucket, err := s.getBucket(req.BucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := bucket.Find(bson.M{"_id": req.FileID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	if !cursor.Next(context.Background()) {
+		return nil, exception.NewNotFound("file %s not found", req.FileID)
+	}
+	ins := &storage.File{}
+	if err := cursor.Decode(ins); err != nil {
+		return nil, fmt.Errorf("decode file error, %s", err)
+	}
+	return ins, nil
+}
+
 func (s *service) getBucket(name string) (*gridfs.Bucket, error) {
 	opts := options.GridFSBucket()
 	opts.SetName(name)
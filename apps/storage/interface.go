@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
 // Service 存储服务
 type Service interface {
 	UploadFile(*UploadFileRequest) error
 	Download(*DownloadFileRequest) error
+	DeleteFile(*DeleteFileRequest) error
+	DescribeFile(*DescribeFileRequest) (*File, error)
 }
 
 // NewUploadFileRequestFromHTTP todo
@@ -96,3 +99,61 @@ func (req *DownloadFileRequest) Validate() error {
 func (req *DownloadFileRequest) Writer() io.Writer {
 	return req.writer
 }
+
+// NewDeleteFileRequest todo
+func NewDeleteFileRequest(bucketName, fileID string) *DeleteFileRequest {
+	return &DeleteFileRequest{
+		BucketName: bucketName,
+		FileID:     fileID,
+	}
+}
+
+// DeleteFileRequest 删除文件请求
+type DeleteFileRequest struct {
+	BucketName string
+	FileID     string
+}
+
+// Validate 输入参数校验
+func (req *DeleteFileRequest) Validate() error {
+	if req.BucketName == "" || req.FileID == "" {
+		return fmt.Errorf("bucket name or file id is \"\"")
+	}
+
+	return nil
+}
+
+// NewDescribeFileRequest todo
+func NewDescribeFileRequest(bucketName, fileID string) *DescribeFileRequest {
+	return &DescribeFileRequest{
+		BucketName: bucketName,
+		FileID:     fileID,
+	}
+}
+
+// DescribeFileRequest 查询文件信息请求, 不下载文件内容
+type DescribeFileRequest struct {
+	BucketName string
+	FileID     string
+}
+
+// Validate 输入参数校验
+func (req *DescribeFileRequest) Validate() error {
+	if req.BucketName == "" || req.FileID == "" {
+		return fmt.Errorf("bucket name or file id is \"\"")
+	}
+
+	return nil
+}
+
+// File 文件信息
+type File struct {
+	// 文件Id
+	Id string `bson:"_id" json:"id"`
+	// 文件大小
+	Length int64 `bson:"length" json:"length"`
+	// 上传时间
+	UploadDate time.Time `bson:"uploadDate" json:"upload_date"`
+	// 上传时设置的meta
+	Meta map[string]string `bson:"metadata" json:"meta"`
+}
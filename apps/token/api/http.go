@@ -55,12 +55,26 @@ func (h *handler) Registry(ws *restful.WebService) {
 		Metadata(restfulspec.KeyOpenAPITags, tags).
 		Reads(token.ChangeNamespaceRequest{}))
 
+	ws.Route(ws.PATCH("/domain").To(h.ChangeDomain).
+		Doc("切换域").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(token.ChangeDomainRequest{}).
+		Writes(token.Token{}).
+		Returns(200, "OK", token.Token{}))
+
 	ws.Route(ws.GET("/").To(h.ValidateToken).
 		Doc("验证令牌").
 		Metadata(restfulspec.KeyOpenAPITags, tags).
 		Reads(token.ValidateTokenRequest{}).
 		Writes(token.Token{}).
 		Returns(200, "OK", token.Token{}))
+
+	ws.Route(ws.POST("/validate").To(h.ValidateMacToken).
+		Doc("验证MAC令牌").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(token.ValidateTokenRequest{}).
+		Writes(token.Token{}).
+		Returns(200, "OK", token.Token{}))
 }
 
 func init() {
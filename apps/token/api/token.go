@@ -57,6 +57,21 @@ func (u *handler) ChangeNamespace(r *restful.Request, w *restful.Response) {
 	response.Success(w, set)
 }
 
+func (u *handler) ChangeDomain(r *restful.Request, w *restful.Response) {
+	req := token.NewChangeDomainRequest()
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	tk, err := h.service.ChangeDomain(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, tk)
+}
+
 func (u *handler) ValidateToken(r *restful.Request, w *restful.Response) {
 	tk := r.Request.Header.Get(token.VALIDATE_TOKEN_HEADER_KEY)
 	req := token.NewValidateTokenRequest(tk)
@@ -68,3 +83,19 @@ func (u *handler) ValidateToken(r *restful.Request, w *restful.Response) {
 	}
 	response.Success(w, resp)
 }
+
+// ValidateMacToken MAC令牌需要携带请求签名, 由调用方的认证中间件转发
+func (u *handler) ValidateMacToken(r *restful.Request, w *restful.Response) {
+	req := token.NewValidateTokenRequest("")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	resp, err := h.service.ValidateToken(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, resp)
+}
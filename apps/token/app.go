@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/infraboard/mcube/exception"
 	"github.com/infraboard/mcube/http/request"
 	"github.com/mssola/user_agent"
 )
@@ -76,6 +77,15 @@ func (req *IssueTokenRequest) AbnormalUserCheckKey() string {
 	return "abnormal_" + key
 }
 
+// RetryLockKey 登录失败锁定的Key, 只有通过用户名登录时才统计失败次数
+func (req *IssueTokenRequest) RetryLockKey() string {
+	switch req.GrantType {
+	case GRANT_TYPE_PASSWORD, GRANT_TYPE_LDAP:
+		return req.Username
+	}
+	return ""
+}
+
 // NewRevolkTokenRequest 撤销Token请求
 func NewRevolkTokenRequest(accessToken, refreshToken string) *RevolkTokenRequest {
 	return &RevolkTokenRequest{
@@ -84,10 +94,53 @@ func NewRevolkTokenRequest(accessToken, refreshToken string) *RevolkTokenRequest
 	}
 }
 
+// NewBlockUserTokenRequest 冻结用户所有令牌的请求
+func NewBlockUserTokenRequest(userId string, bt BLOCK_TYPE, reason string) *BlockUserTokenRequest {
+	return &BlockUserTokenRequest{
+		UserId:    userId,
+		BlockType: bt,
+		Reason:    reason,
+	}
+}
+
+func (req *BlockUserTokenRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDeleteUserTokenRequest(userId string) *DeleteUserTokenRequest {
+	return &DeleteUserTokenRequest{
+		UserId: userId,
+	}
+}
+
+func (req *DeleteUserTokenRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewBlockDomainTokenRequest(domain string, bt BLOCK_TYPE, reason string) *BlockDomainTokenRequest {
+	return &BlockDomainTokenRequest{
+		Domain:    domain,
+		BlockType: bt,
+		Reason:    reason,
+	}
+}
+
+func (req *BlockDomainTokenRequest) Validate() error {
+	return validate.Struct(req)
+}
+
 func NewChangeNamespaceRequest() *ChangeNamespaceRequest {
 	return &ChangeNamespaceRequest{}
 }
 
+func NewChangeDomainRequest() *ChangeDomainRequest {
+	return &ChangeDomainRequest{}
+}
+
+func (req *ChangeDomainRequest) Validate() error {
+	return validate.Struct(req)
+}
+
 func NewDefaultToken() *Token {
 	return &Token{
 		Status:   NewStatus(),
@@ -104,6 +157,7 @@ func NewToken(req *IssueTokenRequest) *Token {
 		RefreshExpiredAt: req.ExpiredAt * 4,
 		GrantType:        req.GrantType,
 		Type:             req.Type,
+		Scope:            req.Scope,
 		Description:      req.Description,
 		Status:           NewStatus(),
 		Location:         req.Location,
@@ -114,6 +168,11 @@ func NewToken(req *IssueTokenRequest) *Token {
 	default:
 		tk.Platform = PLATFORM_WEB
 	}
+
+	// MAC令牌需要颁发签名密钥
+	if req.Type.Equal(TOKEN_TYPE_MAC) {
+		tk.MacKey = MakeBearer(32)
+	}
 	return tk
 }
 
@@ -165,6 +224,21 @@ func NewQueryUserWebLastToken(uid string) *QueryTokenRequest {
 	}
 }
 
+// 查询用户最近一次颁发的令牌, 包括所有授权方式
+func NewQueryUserLastToken(uid string) *QueryTokenRequest {
+	return &QueryTokenRequest{
+		Page:   request.NewPageRequest(1, 1),
+		UserId: uid,
+	}
+}
+
+// IsCredentialError 凭证错误(用户不存在或者密码错误), 只有这类错误计入登录失败次数,
+// 认证源不可用等其他错误不计入
+func IsCredentialError(err error) bool {
+	e, ok := err.(exception.APIException)
+	return ok && e.ErrorCode() == exception.Unauthorized
+}
+
 func (s *TokenSet) Length() int {
 	return len(s.Items)
 }
@@ -235,6 +309,11 @@ func (t *Status) BlockMessage() string {
 	return fmt.Sprintf("token blocked at %d, reason: %s", t.BlockAt, t.BlockReason)
 }
 
+// Desensitize 去除敏感信息, MAC签名密钥只在颁发时返回
+func (t *Token) Desensitize() {
+	t.MacKey = ""
+}
+
 // CheckAccessIsExpired 检测token是否过期
 func (t *Token) CheckAccessIsExpired() bool {
 	if t.AccessExpiredAt == 0 {
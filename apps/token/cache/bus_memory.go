@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"context"
+	"sync"
+)
+
+// NewMemoryBus 进程内的事件总线, 适用于单节点部署
+func NewMemoryBus() Bus {
+	return &memoryBus{}
+}
+
+type memoryBus struct {
+	mu       sync.RWMutex
+	handlers []func(*Event)
+}
+
+func (b *memoryBus) Publish(ctx context.Context, e *Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, h := range b.handlers {
+		h(e)
+	}
+	return nil
+}
+
+func (b *memoryBus) Subscribe(ctx context.Context, h func(*Event)) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers = append(b.handlers, h)
+	return nil
+}
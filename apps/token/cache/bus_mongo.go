@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+)
+
+const (
+	// 事件保留时长, 只用于订阅, 无需长期保存
+	eventRetentionSeconds = 3600
+)
+
+// NewMongoBus 基于Mongo Change Stream的事件总线, 需要Mongo以副本集模式部署
+func NewMongoBus(db *mongo.Database, collection string) (Bus, error) {
+	col := db.Collection(collection)
+	indexs := []mongo.IndexModel{
+		{
+			Keys:    bsonx.Doc{{Key: "create_at", Value: bsonx.Int32(-1)}},
+			Options: options.Index().SetExpireAfterSeconds(eventRetentionSeconds),
+		},
+	}
+	if _, err := col.Indexes().CreateMany(context.Background(), indexs); err != nil {
+		return nil, err
+	}
+
+	return &mongoBus{
+		col: col,
+		log: zap.L().Named("token.cache.mongo"),
+	}, nil
+}
+
+type mongoBus struct {
+	col *mongo.Collection
+	log logger.Logger
+}
+
+func (b *mongoBus) Publish(ctx context.Context, e *Event) error {
+	if _, err := b.col.InsertOne(ctx, e); err != nil {
+		return fmt.Errorf("insert token event error, %s", err)
+	}
+	return nil
+}
+
+func (b *mongoBus) Subscribe(ctx context.Context, h func(*Event)) error {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "operationType", Value: "insert"}}}},
+	}
+	stream, err := b.col.Watch(ctx, pipeline)
+	if err != nil {
+		return fmt.Errorf("watch token event error, %s", err)
+	}
+
+	go func() {
+		defer stream.Close(context.Background())
+		for stream.Next(ctx) {
+			change := struct {
+				FullDocument *Event `bson:"fullDocument"`
+			}{}
+			if err := stream.Decode(&change); err != nil {
+				b.log.Errorf("decode change event error, %s", err)
+				continue
+			}
+			if change.FullDocument != nil {
+				h(change.FullDocument)
+			}
+		}
+		if err := stream.Err(); err != nil && ctx.Err() == nil {
+			b.log.Errorf("token event stream closed, %s", err)
+		}
+	}()
+
+	return nil
+}
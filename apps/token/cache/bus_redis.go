@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-redis/redis"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	mredis "github.com/infraboard/mcube/cache/redis"
+)
+
+// NewRedisBus 基于Redis Pub/Sub的事件总线
+func NewRedisBus(conf *mredis.Config, channel string) Bus {
+	client := redis.NewClient(&redis.Options{
+		Addr:     conf.Address,
+		Password: conf.Password,
+		DB:       conf.DB,
+	})
+
+	return &redisBus{
+		client:  client,
+		channel: channel,
+		log:     zap.L().Named("token.cache.redis"),
+	}
+}
+
+type redisBus struct {
+	client  *redis.Client
+	channel string
+	log     logger.Logger
+}
+
+func (b *redisBus) Publish(ctx context.Context, e *Event) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	return b.client.Publish(b.channel, payload).Err()
+}
+
+func (b *redisBus) Subscribe(ctx context.Context, h func(*Event)) error {
+	ps := b.client.Subscribe(b.channel)
+	// 确认订阅成功
+	if _, err := ps.Receive(); err != nil {
+		ps.Close()
+		return fmt.Errorf("subscribe channel %s error, %s", b.channel, err)
+	}
+
+	go func() {
+		defer ps.Close()
+		ch := ps.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				e := &Event{}
+				if err := json.Unmarshal([]byte(msg.Payload), e); err != nil {
+					b.log.Errorf("unmarshal event error, %s", err)
+					continue
+				}
+				h(e)
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/conf"
+)
+
+// NewCacheFromConfig 根据全局配置初始化Token缓存
+func NewCacheFromConfig() (*Cache, error) {
+	tc := conf.C().TokenCache
+	if !tc.Enabled {
+		return &Cache{}, nil
+	}
+
+	var bus Bus
+	switch tc.Bus {
+	case "memory", "":
+		bus = NewMemoryBus()
+	case "redis":
+		bus = NewRedisBus(conf.C().Cache.Redis, tc.Channel)
+	case "mongo":
+		db, err := conf.C().Mongo.GetDB()
+		if err != nil {
+			return nil, err
+		}
+		bus, err = NewMongoBus(db, tc.Channel)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown token cache bus type: %s", tc.Bus)
+	}
+
+	return NewCache(tc.Size, time.Duration(tc.TTL)*time.Second, bus), nil
+}
+
+// NewCache 创建Token缓存, size为最大缓存数量, ttl为缓存有效期
+func NewCache(size int, ttl time.Duration, bus Bus) *Cache {
+	return &Cache{
+		enabled: true,
+		lru:     newLRU(size, ttl),
+		bus:     bus,
+		log:     zap.L().Named("token.cache"),
+	}
+}
+
+// Cache 已校验通过的Token缓存, 避免每次校验都查询数据库
+// 撤销, 冻结, 切换空间等操作会通过Bus通知所有副本失效对应的缓存
+type Cache struct {
+	enabled bool
+	lru     *lru
+	bus     Bus
+	log     logger.Logger
+}
+
+// Start 订阅失效事件
+func (c *Cache) Start(ctx context.Context) error {
+	if !c.enabled {
+		return nil
+	}
+	return c.bus.Subscribe(ctx, c.handle)
+}
+
+// Get 获取缓存的Token, 返回的是副本, 可以安全修改
+func (c *Cache) Get(accessToken string) *token.Token {
+	if !c.enabled {
+		return nil
+	}
+
+	tk, ok := c.lru.Get(accessToken)
+	if !ok {
+		return nil
+	}
+	return proto.Clone(tk).(*token.Token)
+}
+
+// Put 缓存Token
+func (c *Cache) Put(tk *token.Token) {
+	if !c.enabled || tk == nil {
+		return
+	}
+	c.lru.Add(tk.AccessToken, proto.Clone(tk).(*token.Token))
+}
+
+// Invalidate 失效本地缓存, 并通知其他副本
+func (c *Cache) Invalidate(ctx context.Context, e *Event) error {
+	if !c.enabled {
+		return nil
+	}
+
+	c.handle(e)
+	if err := c.bus.Publish(ctx, e); err != nil {
+		return fmt.Errorf("publish token event %s error, %s", e, err)
+	}
+	return nil
+}
+
+func (c *Cache) handle(e *Event) {
+	switch {
+	case e.AccessToken != "":
+		c.lru.Remove(e.AccessToken)
+	case e.UserId != "":
+		c.lru.RemoveFunc(func(tk *token.Token) bool {
+			return tk.UserId == e.UserId
+		})
+	case e.Domain != "":
+		c.lru.RemoveFunc(func(tk *token.Token) bool {
+			return tk.Domain == e.Domain
+		})
+	}
+	c.log.Debugf("token cache invalidated by event %s", e)
+}
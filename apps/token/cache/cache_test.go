@@ -0,0 +1,97 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/token/cache"
+)
+
+func newToken(accessToken, userId, domain string) *token.Token {
+	tk := token.NewToken(token.NewIssueTokenRequest())
+	tk.AccessToken = accessToken
+	tk.UserId = userId
+	tk.Domain = domain
+	return tk
+}
+
+func TestCacheGetPut(t *testing.T) {
+	should := assert.New(t)
+
+	c := cache.NewCache(10, time.Minute, cache.NewMemoryBus())
+	should.Nil(c.Get("tk1"))
+
+	c.Put(newToken("tk1", "u1", "default"))
+	tk := c.Get("tk1")
+	should.NotNil(tk)
+
+	// 修改返回值不影响缓存
+	tk.Namespace = "changed"
+	should.Equal("", c.Get("tk1").Namespace)
+}
+
+func TestCacheTTL(t *testing.T) {
+	should := assert.New(t)
+
+	c := cache.NewCache(10, 10*time.Millisecond, cache.NewMemoryBus())
+	c.Put(newToken("tk1", "u1", "default"))
+	time.Sleep(20 * time.Millisecond)
+	should.Nil(c.Get("tk1"))
+}
+
+func TestCacheEvict(t *testing.T) {
+	should := assert.New(t)
+
+	c := cache.NewCache(2, time.Minute, cache.NewMemoryBus())
+	c.Put(newToken("tk1", "u1", "default"))
+	c.Put(newToken("tk2", "u1", "default"))
+	// 访问tk1, 使tk2成为最久未使用
+	should.NotNil(c.Get("tk1"))
+	c.Put(newToken("tk3", "u1", "default"))
+
+	should.NotNil(c.Get("tk1"))
+	should.Nil(c.Get("tk2"))
+	should.NotNil(c.Get("tk3"))
+}
+
+func TestCacheInvalidateAcrossReplica(t *testing.T) {
+	should := assert.New(t)
+	ctx := context.Background()
+
+	// 两个副本共享同一个总线
+	bus := cache.NewMemoryBus()
+	r1 := cache.NewCache(10, time.Minute, bus)
+	r2 := cache.NewCache(10, time.Minute, bus)
+	should.NoError(r1.Start(ctx))
+	should.NoError(r2.Start(ctx))
+
+	for _, c := range []*cache.Cache{r1, r2} {
+		c.Put(newToken("tk1", "u1", "d1"))
+		c.Put(newToken("tk2", "u1", "d1"))
+		c.Put(newToken("tk3", "u2", "d2"))
+	}
+
+	should.NoError(r1.Invalidate(ctx, cache.NewAccessTokenEvent(cache.EVENT_TYPE_REVOLK, "tk1")))
+	should.Nil(r2.Get("tk1"))
+	should.NotNil(r2.Get("tk2"))
+
+	should.NoError(r1.Invalidate(ctx, cache.NewUserEvent(cache.EVENT_TYPE_BLOCK, "u1")))
+	should.Nil(r2.Get("tk2"))
+	should.NotNil(r2.Get("tk3"))
+
+	should.NoError(r2.Invalidate(ctx, cache.NewDomainEvent(cache.EVENT_TYPE_BLOCK, "d2")))
+	should.Nil(r1.Get("tk3"))
+}
+
+func TestDisabledCache(t *testing.T) {
+	should := assert.New(t)
+
+	c := &cache.Cache{}
+	c.Put(newToken("tk1", "u1", "default"))
+	should.Nil(c.Get("tk1"))
+	should.NoError(c.Invalidate(context.Background(), cache.NewAccessTokenEvent(cache.EVENT_TYPE_REVOLK, "tk1")))
+}
@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type EVENT_TYPE string
+
+const (
+	// Token被撤销
+	EVENT_TYPE_REVOLK EVENT_TYPE = "revolk"
+	// Token被冻结
+	EVENT_TYPE_BLOCK EVENT_TYPE = "block"
+	// Token切换了空间
+	EVENT_TYPE_NAMESPACE_CHANGED EVENT_TYPE = "namespace_changed"
+	// Token切换了域
+	EVENT_TYPE_DOMAIN_CHANGED EVENT_TYPE = "domain_changed"
+)
+
+// Event 缓存失效事件, 按照access_token, user_id, domain 依次匹配需要失效的Token
+type Event struct {
+	// 事件类型
+	Type EVENT_TYPE `bson:"type" json:"type"`
+	// 失效指定的Token
+	AccessToken string `bson:"access_token" json:"access_token"`
+	// 失效该用户的所有Token
+	UserId string `bson:"user_id" json:"user_id"`
+	// 失效该域的所有Token
+	Domain string `bson:"domain" json:"domain"`
+	// 事件产生时间
+	CreateAt time.Time `bson:"create_at" json:"create_at"`
+}
+
+func NewEvent(t EVENT_TYPE) *Event {
+	return &Event{
+		Type:     t,
+		CreateAt: time.Now(),
+	}
+}
+
+func NewAccessTokenEvent(t EVENT_TYPE, accessToken string) *Event {
+	e := NewEvent(t)
+	e.AccessToken = accessToken
+	return e
+}
+
+func NewUserEvent(t EVENT_TYPE, userId string) *Event {
+	e := NewEvent(t)
+	e.UserId = userId
+	return e
+}
+
+func NewDomainEvent(t EVENT_TYPE, domain string) *Event {
+	e := NewEvent(t)
+	e.Domain = domain
+	return e
+}
+
+func (e *Event) String() string {
+	return fmt.Sprintf("%s[access_token=%s user_id=%s domain=%s]", e.Type, e.AccessToken, e.UserId, e.Domain)
+}
+
+// Bus 失效事件的发布订阅, 用于多副本之间同步缓存失效
+type Bus interface {
+	// 发布事件, 所有订阅者(包括自己)都会收到
+	Publish(context.Context, *Event) error
+	// 订阅事件, ctx结束后停止订阅
+	Subscribe(context.Context, func(*Event)) error
+}
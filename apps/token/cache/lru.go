@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/infraboard/mcenter/apps/token"
+)
+
+func newLRU(size int, ttl time.Duration) *lru {
+	return &lru{
+		size:  size,
+		ttl:   ttl,
+		ll:    list.New(),
+		items: map[string]*list.Element{},
+	}
+}
+
+// lru 带过期时间的LRU, 超过容量时淘汰最久未使用的Token
+type lru struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type entry struct {
+	key      string
+	value    *token.Token
+	expireAt time.Time
+}
+
+func (c *lru) Get(key string) (*token.Token, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	ent := e.Value.(*entry)
+	if time.Now().After(ent.expireAt) {
+		c.removeElement(e)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(e)
+	return ent.value, true
+}
+
+func (c *lru) Add(key string, value *token.Token) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expireAt := time.Now().Add(c.ttl)
+	if e, ok := c.items[key]; ok {
+		c.ll.MoveToFront(e)
+		ent := e.Value.(*entry)
+		ent.value = value
+		ent.expireAt = expireAt
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&entry{key: key, value: value, expireAt: expireAt})
+	for c.size > 0 && c.ll.Len() > c.size {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+func (c *lru) Remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.items[key]; ok {
+		c.removeElement(e)
+	}
+}
+
+// RemoveFunc 删除所有满足条件的Token, 返回删除的数量
+func (c *lru) RemoveFunc(fn func(*token.Token) bool) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	count := 0
+	for e := c.ll.Front(); e != nil; {
+		next := e.Next()
+		if fn(e.Value.(*entry).value) {
+			c.removeElement(e)
+			count++
+		}
+		e = next
+	}
+	return count
+}
+
+func (c *lru) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *lru) removeElement(e *list.Element) {
+	c.ll.Remove(e)
+	delete(c.items, e.Value.(*entry).key)
+}
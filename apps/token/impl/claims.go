@@ -0,0 +1,43 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// setClaims 把域中配置为token_claim的用户属性写入令牌
+func (s *service) setClaims(ctx context.Context, tk *token.Token) error {
+	if tk.UserId == "" || tk.Domain == "" {
+		return nil
+	}
+
+	d, err := s.domain.DescribeDomain(ctx, domain.NewDescribeDomainRequestByName(tk.Domain))
+	if err != nil {
+		if exception.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	if len(d.Spec.UserAttributes) == 0 {
+		return nil
+	}
+
+	u, err := s.user.DescribeUser(ctx, user.NewDescriptUserRequestWithId(tk.UserId))
+	if err != nil {
+		return err
+	}
+	if u.Profile == nil {
+		return nil
+	}
+
+	claims := d.Spec.TokenClaims(u.Profile.Attributes)
+	if len(claims) > 0 {
+		tk.Claims = claims
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/token/cache"
 	"github.com/infraboard/mcube/exception"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -72,9 +73,65 @@ func (s *service) blockOtherWebToken(ctx context.Context, tk *token.Token) error
 		return err
 	}
 	s.log.Debugf("block %d tokens", rs.ModifiedCount)
+
+	if rs.ModifiedCount > 0 {
+		s.invalidate(ctx, cache.NewUserEvent(cache.EVENT_TYPE_BLOCK, tk.UserId))
+	}
 	return nil
 }
 
+func (s *service) blockUserToken(ctx context.Context, req *token.BlockUserTokenRequest) (int64, error) {
+	status := token.NewStatus()
+	status.IsBlock = true
+	status.BlockAt = time.Now().UnixMilli()
+	status.BlockReason = req.Reason
+	status.BlockType = req.BlockType
+
+	filter := bson.M{
+		"user_id":         req.UserId,
+		"status.is_block": false,
+	}
+	if req.Domain != "" {
+		filter["domain"] = req.Domain
+	}
+	rs, err := s.col.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"status": status}})
+	if err != nil {
+		return 0, err
+	}
+	s.log.Debugf("block user %s %d tokens", req.UserId, rs.ModifiedCount)
+
+	if rs.ModifiedCount > 0 {
+		s.invalidate(ctx, cache.NewUserEvent(cache.EVENT_TYPE_BLOCK, req.UserId))
+	}
+	return rs.ModifiedCount, nil
+}
+
+func (s *service) blockDomainToken(ctx context.Context, req *token.BlockDomainTokenRequest) (int64, error) {
+	status := token.NewStatus()
+	status.IsBlock = true
+	status.BlockAt = time.Now().UnixMilli()
+	status.BlockReason = req.Reason
+	status.BlockType = req.BlockType
+
+	rs, err := s.col.UpdateMany(
+		ctx,
+		bson.M{
+			"domain":          req.Domain,
+			"status.is_block": false,
+		},
+		bson.M{"$set": bson.M{"status": status}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	s.log.Debugf("block domain %s %d tokens", req.Domain, rs.ModifiedCount)
+
+	if rs.ModifiedCount > 0 {
+		s.invalidate(ctx, cache.NewDomainEvent(cache.EVENT_TYPE_BLOCK, req.Domain))
+	}
+	return rs.ModifiedCount, nil
+}
+
 func (s *service) delete(ctx context.Context, ins *token.Token) error {
 	if ins == nil || ins.AccessToken == "" {
 		return fmt.Errorf("access tpken is nil")
@@ -115,9 +172,30 @@ func (r *queryRequest) FindOptions() *options.FindOptions {
 
 func (r *queryRequest) FindFilter() bson.M {
 	filter := bson.M{}
+	if r.Platform != nil {
+		filter["platform"] = r.Platform
+	}
+	if r.AccessToken != "" {
+		filter["_id"] = r.AccessToken
+	}
+	if r.RefreshToken != "" {
+		filter["refresh_token"] = r.RefreshToken
+	}
+	if r.UserType != nil {
+		filter["user_type"] = r.UserType
+	}
+	if r.Domain != "" {
+		filter["domain"] = r.Domain
+	}
+	if r.UserId != "" {
+		filter["user_id"] = r.UserId
+	}
 	if r.GrantType != nil {
 		filter["grant_type"] = r.GrantType
 	}
+	if r.Type != nil {
+		filter["type"] = r.Type
+	}
 	if r.Username != "" {
 		filter["username"] = r.Username
 	}
@@ -0,0 +1,91 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/token/cache"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// 切换Token所在的域
+func (s *service) ChangeDomain(ctx context.Context, req *token.ChangeDomainRequest) (
+	*token.Token, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate change domain error, %s", err)
+	}
+
+	tk, err := s.DescribeToken(ctx, token.NewDescribeTokenRequest(req.Token))
+	if err != nil {
+		return nil, err
+	}
+	if tk.Domain == req.Domain {
+		tk.Desensitize()
+		return tk, nil
+	}
+	if tk.UserId == "" {
+		return nil, exception.NewBadRequest("token not issued to user, can't change domain")
+	}
+
+	// 以用户当前的成员身份为准, 令牌中的available_domains可能已经过时
+	u, err := s.user.DescribeUser(ctx, user.NewDescriptUserRequestWithId(tk.UserId))
+	if err != nil {
+		return nil, err
+	}
+	userType, ok := u.DomainType(req.Domain)
+	if !ok {
+		return nil, exception.NewPermissionDeny("you are not a member of domain %s", req.Domain)
+	}
+
+	tk.Domain = req.Domain
+	tk.UserType = userType
+	tk.AvailableDomains = u.AvailableDomains()
+	// 空间和用户属性都是域内的, 需要按照目标域重新计算
+	tk.Namespace = ""
+	tk.Claims = nil
+
+	// 目标域被禁用或者删除时禁止切换
+	if err := s.checkDomainActive(ctx, tk); err != nil {
+		return nil, err
+	}
+
+	// 按照目标域的安全设置重新检查
+	if err := s.AfterLoginSecurityCheck(ctx, req.VerifyCode, tk); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	if err := s.setClaims(ctx, tk); err != nil {
+		return nil, err
+	}
+
+	if err := s.update(ctx, tk); err != nil {
+		return nil, err
+	}
+	s.invalidate(ctx, cache.NewAccessTokenEvent(cache.EVENT_TYPE_DOMAIN_CHANGED, tk.AccessToken))
+
+	// 目标域中可以访问的空间
+	if err := s.setAvailableNamespace(ctx, tk); err != nil {
+		return nil, err
+	}
+	tk.Desensitize()
+	return tk, nil
+}
+
+// setAvailableDomains 用户可以切换的域
+func (s *service) setAvailableDomains(ctx context.Context, tk *token.Token) error {
+	if tk.UserId == "" {
+		return nil
+	}
+
+	u, err := s.user.DescribeUser(ctx, user.NewDescriptUserRequestWithId(tk.UserId))
+	if err != nil {
+		if exception.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	tk.AvailableDomains = u.AvailableDomains()
+	return nil
+}
@@ -13,11 +13,14 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/infraboard/mcenter/apps/code"
+	"github.com/infraboard/mcenter/apps/domain"
 	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/policy"
 	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/token/cache"
 	"github.com/infraboard/mcenter/apps/token/provider"
 	"github.com/infraboard/mcenter/apps/token/security"
+	"github.com/infraboard/mcenter/apps/user"
 	"github.com/infraboard/mcenter/conf"
 
 	_ "github.com/infraboard/mcenter/apps/token/provider/all"
@@ -37,6 +40,10 @@ type service struct {
 	ns      namespace.Service
 	checker security.Checker
 	code    code.Service
+	user    user.Service
+	domain  domain.Service
+	cache   *cache.Cache
+	nonce   *mongo.Collection
 }
 
 func (s *service) Config() error {
@@ -54,6 +61,12 @@ func (s *service) Config() error {
 		{
 			Keys: bsonx.Doc{{Key: "issue_at", Value: bsonx.Int32(-1)}},
 		},
+		{
+			Keys: bsonx.Doc{
+				{Key: "user_id", Value: bsonx.Int32(1)},
+				{Key: "issue_at", Value: bsonx.Int32(-1)},
+			},
+		},
 	}
 
 	_, err = dc.Indexes().CreateMany(context.Background(), indexs)
@@ -63,16 +76,31 @@ func (s *service) Config() error {
 
 	s.col = dc
 
+	if err := s.initNonceCollection(db); err != nil {
+		return err
+	}
+
 	s.log = zap.L().Named(s.Name())
 	s.code = app.GetInternalApp(code.AppName).(code.Service)
 	s.ns = app.GetInternalApp(namespace.AppName).(namespace.Service)
 	s.policy = app.GetInternalApp(policy.AppName).(policy.Service)
+	s.user = app.GetInternalApp(user.AppName).(user.Service)
+	s.domain = app.GetInternalApp(domain.AppName).(domain.Service)
 
 	s.checker, err = security.NewChecker()
 	if err != nil {
 		return fmt.Errorf("new checker error, %s", err)
 	}
 
+	// 初始化Token校验缓存, 并订阅其他副本的失效事件
+	s.cache, err = cache.NewCacheFromConfig()
+	if err != nil {
+		return fmt.Errorf("new token cache error, %s", err)
+	}
+	if err := s.cache.Start(context.Background()); err != nil {
+		return fmt.Errorf("start token cache error, %s", err)
+	}
+
 	// 初始化所有的auth provider
 	if err := provider.Init(); err != nil {
 		return err
@@ -0,0 +1,48 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+)
+
+const (
+	// MAC签名nonce记录的集合, 多副本共享, 保证同一个nonce在所有副本上只能使用一次
+	macNonceCollection = "token_mac_nonce"
+)
+
+func (s *service) initNonceCollection(db *mongo.Database) error {
+	col := db.Collection(macNonceCollection)
+	indexs := []mongo.IndexModel{
+		{
+			// 过期后由Mongo自动清理
+			Keys:    bsonx.Doc{{Key: "expire_at", Value: bsonx.Int32(1)}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+	if _, err := col.Indexes().CreateMany(context.Background(), indexs); err != nil {
+		return err
+	}
+	s.nonce = col
+	return nil
+}
+
+// 记录已使用的nonce, 依赖_id的唯一性判断重放, 插入冲突说明该nonce已经被使用过
+func (s *service) useNonce(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	_, err := s.nonce.InsertOne(ctx, bson.M{
+		"_id":       key,
+		"expire_at": time.Now().Add(ttl),
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("save mac nonce error, %s", err)
+	}
+	return true, nil
+}
@@ -2,16 +2,20 @@ package impl
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/infraboard/mcenter/apps/code"
+	"github.com/infraboard/mcenter/apps/domain"
 	"github.com/infraboard/mcenter/apps/namespace"
 	"github.com/infraboard/mcenter/apps/policy"
 	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/token/cache"
 	"github.com/infraboard/mcenter/apps/token/provider"
 	"github.com/infraboard/mcenter/apps/user"
 	"github.com/infraboard/mcube/exception"
 	"github.com/infraboard/mcube/http/request"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func (s *service) IssueToken(ctx context.Context, req *token.IssueTokenRequest) (
@@ -21,11 +25,19 @@ func (s *service) IssueToken(ctx context.Context, req *token.IssueTokenRequest)
 		return nil, exception.NewBadRequest(err.Error())
 	}
 
-	// 颁发令牌
+	// 颁发令牌, 用户不存在或者密码错误时记录失败次数
 	tk, err := s.IssueTokenNow(ctx, req)
 	if err != nil {
+		if token.IsCredentialError(err) {
+			if e := s.checker.UpdateFailedRetry(ctx, req, err.Error()); e != nil {
+				s.log.Errorf("update failed retry error, %s", e)
+			}
+		}
 		return nil, err
 	}
+	if err := s.checker.ResetFailedRetry(ctx, req); err != nil {
+		s.log.Errorf("reset failed retry error, %s", err)
+	}
 
 	// 登陆后安全检查
 	if err := s.AfterLoginSecurityCheck(ctx, req.VerifyCode, tk); err != nil {
@@ -42,8 +54,15 @@ func (s *service) IssueToken(ctx context.Context, req *token.IssueTokenRequest)
 }
 
 func (s *service) IssueTokenNow(ctx context.Context, req *token.IssueTokenRequest) (*token.Token, error) {
+	// 规范化访问范围
+	scope, err := token.ParseScope(req.Scope)
+	if err != nil {
+		return nil, exception.NewBadRequest("%s", err)
+	}
+	req.Scope = scope.String()
+
 	// 获取令牌颁发器
-	issuer := provider.Get(token.GRANT_TYPE_PASSWORD)
+	issuer := provider.Get(req.GrantType)
 
 	// 确保有provider
 	if issuer == nil {
@@ -56,7 +75,27 @@ func (s *service) IssueTokenNow(ctx context.Context, req *token.IssueTokenReques
 		return nil, err
 	}
 
+	// 域被禁用或者删除时禁止登录
+	if err := s.checkDomainActive(ctx, tk); err != nil {
+		return nil, err
+	}
+
+	// 写入用户自定义属性
+	if err := s.setClaims(ctx, tk); err != nil {
+		return nil, err
+	}
+
+	// 用户加入了其他域时, 登录后可以切换
+	if err := s.setAvailableDomains(ctx, tk); err != nil {
+		return nil, err
+	}
+
 	if !req.DryRun {
+		// 检查域的私有令牌配额
+		if err := s.checkQuota(ctx, tk); err != nil {
+			return nil, err
+		}
+
 		// 入库保存
 		if err := s.save(ctx, tk); err != nil {
 			return nil, err
@@ -71,6 +110,24 @@ func (s *service) IssueTokenNow(ctx context.Context, req *token.IssueTokenReques
 	return tk, nil
 }
 
+func (s *service) checkDomainActive(ctx context.Context, tk *token.Token) error {
+	if tk.Domain == "" {
+		return nil
+	}
+
+	d, err := s.domain.DescribeDomain(ctx, domain.NewDescribeDomainRequestByName(tk.Domain))
+	if err != nil {
+		if exception.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	if err := d.CheckActive(); err != nil {
+		return exception.NewPermissionDeny(err.Error())
+	}
+	return nil
+}
+
 func (s *service) BeforeLoginSecurityCheck(ctx context.Context, req *token.IssueTokenRequest) error {
 	// 连续登录失败检测
 	if err := s.checker.MaxFailedRetryCheck(ctx, req); err != nil {
@@ -91,7 +148,7 @@ func (s *service) AfterLoginSecurityCheck(ctx context.Context, verifyCode string
 	// 如果有校验码, 则直接通过校验码检测用户身份安全
 	if verifyCode != "" {
 		s.log.Debugf("verify code provided, check code ...")
-		_, err := s.code.VerifyCode(ctx, code.NewVerifyCodeRequest(tk.Username, verifyCode))
+		_, err := s.code.VerifyCode(ctx, code.NewVerifyCodeRequest(tk.UserId, verifyCode))
 		if err != nil {
 			return exception.NewPermissionDeny("verify code invalidate, error, %s", err)
 		}
@@ -127,8 +184,11 @@ func (s *service) RestoreUserState(ctx context.Context, tk *token.Token) error {
 		return nil
 	}
 
+	// 上次登录后切换到了其他域时, 空间不属于当前域
 	latestTK := set.Items[0]
-	tk.Namespace = latestTK.Namespace
+	if latestTK.Domain == tk.Domain {
+		tk.Namespace = latestTK.Namespace
+	}
 
 	return nil
 }
@@ -148,9 +208,151 @@ func (s *service) RevolkToken(ctx context.Context, req *token.RevolkTokenRequest
 	if err := s.delete(ctx, tk); err != nil {
 		return nil, err
 	}
+
+	s.invalidate(ctx, cache.NewAccessTokenEvent(cache.EVENT_TYPE_REVOLK, tk.AccessToken))
 	return tk, nil
 }
 
+// 冻结用户的所有令牌
+func (s *service) BlockUserToken(ctx context.Context, req *token.BlockUserTokenRequest) (
+	*token.BlockUserTokenResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	count, err := s.blockUserToken(ctx, req)
+	if err != nil {
+		return nil, exception.NewInternalServerError("block user %s token error, %s", req.UserId, err)
+	}
+
+	return &token.BlockUserTokenResponse{Count: count}, nil
+}
+
+// 删除用户的所有令牌, 包括私有令牌
+func (s *service) DeleteUserToken(ctx context.Context, req *token.DeleteUserTokenRequest) (
+	*token.DeleteUserTokenResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	rs, err := s.col.DeleteMany(ctx, bson.M{"user_id": req.UserId})
+	if err != nil {
+		return nil, exception.NewInternalServerError("delete user %s token error, %s", req.UserId, err)
+	}
+	if rs.DeletedCount > 0 {
+		s.invalidate(ctx, cache.NewUserEvent(cache.EVENT_TYPE_REVOLK, req.UserId))
+	}
+
+	return &token.DeleteUserTokenResponse{Count: rs.DeletedCount}, nil
+}
+
+// 冻结域下所有用户的令牌
+func (s *service) BlockDomainToken(ctx context.Context, req *token.BlockDomainTokenRequest) (
+	*token.BlockDomainTokenResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	count, err := s.blockDomainToken(ctx, req)
+	if err != nil {
+		return nil, exception.NewInternalServerError("block domain %s token error, %s", req.Domain, err)
+	}
+
+	return &token.BlockDomainTokenResponse{Count: count}, nil
+}
+
+// 删除域下所有的令牌
+func (s *service) DeleteDomainResource(ctx context.Context, req *domain.DeleteDomainResourceRequest) (
+	*domain.DeleteDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	rs, err := s.col.DeleteMany(ctx, bson.M{"domain": req.Domain})
+	if err != nil {
+		return nil, exception.NewInternalServerError("delete domain %s token error, %s", req.Domain, err)
+	}
+	if rs.DeletedCount > 0 {
+		s.invalidate(ctx, cache.NewDomainEvent(cache.EVENT_TYPE_REVOLK, req.Domain))
+	}
+
+	return domain.NewDeleteDomainResourceResponse(rs.DeletedCount), nil
+}
+
+// 统计域下未冻结的私有令牌数量, 用于配额检查
+func (s *service) CountDomainResource(ctx context.Context, req *domain.CountDomainResourceRequest) (
+	*domain.CountDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	count, err := s.col.CountDocuments(ctx, bson.M{
+		"domain":          req.Domain,
+		"grant_type":      token.GRANT_TYPE_PRIVATE_TOKEN,
+		"status.is_block": false,
+	})
+	if err != nil {
+		return nil, exception.NewInternalServerError("count domain %s private token error, %s", req.Domain, err)
+	}
+	return domain.NewCountDomainResourceResponse(count), nil
+}
+
+// 统计当前在空间中的有效令牌数量, 删除空间前检查依赖
+// 令牌模块被空间模块依赖, 该方法不在令牌的接口中声明
+func (s *service) CountNamespaceResource(ctx context.Context, req *namespace.CountNamespaceResourceRequest) (
+	*namespace.CountNamespaceResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	count, err := s.col.CountDocuments(ctx, bson.M{
+		"domain":          req.Domain,
+		"namespace":       req.Namespace,
+		"status.is_block": false,
+		"$or": bson.A{
+			bson.M{"access_expired_at": 0},
+			bson.M{"access_expired_at": bson.M{"$gt": time.Now().UnixMilli()}},
+		},
+	})
+	if err != nil {
+		return nil, exception.NewInternalServerError("count namespace %s token error, %s", req.Namespace, err)
+	}
+	return namespace.NewCountNamespaceResourceResponse(count), nil
+}
+
+// 令牌退出被删除的空间, 令牌本身保留, 用户需要重新选择空间
+func (s *service) DeleteNamespaceResource(ctx context.Context, req *namespace.DeleteNamespaceResourceRequest) (
+	*namespace.DeleteNamespaceResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	rs, err := s.col.UpdateMany(ctx,
+		bson.M{"domain": req.Domain, "namespace": req.Namespace},
+		bson.M{"$set": bson.M{"namespace": ""}},
+	)
+	if err != nil {
+		return nil, exception.NewInternalServerError("clear namespace %s token error, %s", req.Namespace, err)
+	}
+	if rs.ModifiedCount > 0 {
+		s.invalidate(ctx, cache.NewDomainEvent(cache.EVENT_TYPE_NAMESPACE_CHANGED, req.Domain))
+	}
+	return namespace.NewDeleteNamespaceResourceResponse(rs.ModifiedCount), nil
+}
+
+func (s *service) checkQuota(ctx context.Context, tk *token.Token) error {
+	if tk.Domain == "" || !tk.GrantType.Equal(token.GRANT_TYPE_PRIVATE_TOKEN) {
+		return nil
+	}
+
+	resp, err := s.CountDomainResource(ctx, domain.NewCountDomainResourceRequest(tk.Domain))
+	if err != nil {
+		return err
+	}
+	_, err = s.domain.CheckQuota(ctx, domain.NewCheckQuotaRequest(tk.Domain, domain.QUOTA_RESOURCE_PRIVATE_TOKEN, resp.Count))
+	return err
+}
+
 // 切换Token空间
 func (s *service) ChangeNamespace(ctx context.Context, req *token.ChangeNamespaceRequest) (
 	*token.Token, error) {
@@ -163,13 +365,23 @@ func (s *service) ChangeNamespace(ctx context.Context, req *token.ChangeNamespac
 		return nil, err
 	}
 
-	_, err = s.ns.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(tk.Domain, req.Namespace))
+	ns, err := s.ns.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(tk.Domain, req.Namespace))
 	if err != nil {
 		return nil, err
 	}
+	// 已归档和删除中的空间不允许切换进入
+	if err := ns.CheckWritable(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
 
-	if !tk.UserType.IsIn(user.TYPE_PRIMARY, user.TYPE_SUPPER) && !tk.HasNamespace(req.Namespace) {
-		return nil, exception.NewPermissionDeny("your has no permission to access namespace %s", req.Namespace)
+	if !tk.UserType.IsIn(user.TYPE_PRIMARY, user.TYPE_SUPPER) {
+		ok, err := s.hasNamespace(ctx, tk, req.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, exception.NewPermissionDeny("your has no permission to access namespace %s", req.Namespace)
+		}
 	}
 
 	tk.Namespace = req.Namespace
@@ -177,9 +389,30 @@ func (s *service) ChangeNamespace(ctx context.Context, req *token.ChangeNamespac
 		return nil, err
 	}
 
+	s.invalidate(ctx, cache.NewAccessTokenEvent(cache.EVENT_TYPE_NAMESPACE_CHANGED, tk.AccessToken))
+
+	tk.Desensitize()
 	return tk, nil
 }
 
+// 用户在空间或者空间继承策略的上级空间有授权时, 可以访问该空间
+func (s *service) hasNamespace(ctx context.Context, tk *token.Token, ns string) (bool, error) {
+	if tk.HasNamespace(ns) {
+		return true, nil
+	}
+
+	set, err := s.ns.QueryInheritedNamespace(ctx, namespace.NewDescriptNamespaceRequest(tk.Domain, ns))
+	if err != nil {
+		return false, err
+	}
+	for _, name := range set.Names() {
+		if tk.HasNamespace(name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // 校验Token
 func (s *service) ValidateToken(ctx context.Context, req *token.ValidateTokenRequest) (
 	*token.Token, error) {
@@ -187,26 +420,63 @@ func (s *service) ValidateToken(ctx context.Context, req *token.ValidateTokenReq
 		return nil, exception.NewBadRequest(err.Error())
 	}
 
-	tk, err := s.get(ctx, req.AccessToken)
-	if err != nil {
-		return nil, exception.NewUnauthorized(err.Error())
+	// 优先使用缓存, 缓存失效由撤销/冻结/切换空间事件驱动
+	tk := s.cache.Get(req.AccessToken)
+	if tk == nil {
+		dbToken, err := s.get(ctx, req.AccessToken)
+		if err != nil {
+			return nil, exception.NewUnauthorized(err.Error())
+		}
+		tk = dbToken
+		s.cache.Put(tk)
 	}
 
 	if tk.Status.IsBlock {
 		return nil, s.makeBlockExcption(tk.Status.BlockType, tk.Status.BlockMessage())
 	}
 
+	// MAC令牌需要校验请求签名
+	if tk.Type.Equal(token.TOKEN_TYPE_MAC) {
+		if err := s.checkMacSignature(ctx, tk, req.MacSignature); err != nil {
+			return nil, exception.NewUnauthorized(err.Error())
+		}
+	}
+
 	// 校验Access Token是否过期
 	if tk.CheckAccessIsExpired() {
 		// 如果Refresh还没有过期, 自动再续一个周期, 避免用户连续使用过程中导致访问中断
 		if err := s.reuseToken(ctx, tk); err != nil {
 			return nil, err
 		}
+		s.cache.Put(tk)
 	}
 
+	tk.Desensitize()
 	return tk, nil
 }
 
+// 校验MAC签名, 签名通过后记录nonce, 有效期内同一个nonce只能使用一次
+func (s *service) checkMacSignature(ctx context.Context, tk *token.Token, sig *token.MacSignature) error {
+	if sig == nil {
+		return fmt.Errorf("mac token required request signature")
+	}
+
+	if err := sig.Verify(tk.MacKey); err != nil {
+		return err
+	}
+
+	// 覆盖整个签名有效期窗口(前后各一个周期)
+	ttl := time.Duration(token.MAC_SIGNATURE_EXPIRE_SECOND*2) * time.Second
+	ok, err := s.useNonce(ctx, sig.NonceKey(tk.AccessToken), ttl)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("mac nonce %s has been used", sig.Nonce)
+	}
+	return nil
+}
+
 func (s *service) makeBlockExcption(bt token.BLOCK_TYPE, message string) exception.APIException {
 	switch bt {
 	case token.BLOCK_TYPE_REFRESH_TOKEN_EXPIRED:
@@ -215,6 +485,10 @@ func (s *service) makeBlockExcption(bt token.BLOCK_TYPE, message string) excepti
 		return exception.NewOtherPlaceLoggedIn(message)
 	case token.BLOCK_TYPE_OTHER_IP_LOGGED_IN:
 		return exception.NewOtherIPLoggedIn(message)
+	case token.BLOCK_TYPE_PASSWORD_CHANGED:
+		return exception.NewSessionTerminated(message)
+	case token.BLOCK_TYPE_DOMAIN_INACTIVE, token.BLOCK_TYPE_DOMAIN_MEMBERSHIP_REVOKED:
+		return exception.NewPermissionDeny(message)
 	default:
 		return exception.NewInternalServerError("unknow block type: %s, message: %s", bt, message)
 	}
@@ -230,7 +504,14 @@ func (s *service) reuseToken(ctx context.Context, tk *token.Token) error {
 	tk.AccessExpiredAt = time.Now().Add(time.Duration(token.DEFAULT_ACCESS_TOKEN_EXPIRE_SECOND)*time.Second).Unix() * 1000
 	// refresh token延长一个过期周期
 	tk.RefreshExpiredAt = time.Unix(tk.RefreshExpiredAt/1000, 0).Add(time.Duration(token.DEFAULT_REFRESH_TOKEN_EXPIRE_SECOND)*time.Second).Unix() * 1000
-	return s.save(ctx, tk)
+	return s.update(ctx, tk)
+}
+
+// 失效Token缓存, 失败时只记录日志, 缓存会在TTL后自动过期
+func (s *service) invalidate(ctx context.Context, e *cache.Event) {
+	if err := s.cache.Invalidate(ctx, e); err != nil {
+		s.log.Errorf("invalidate token cache error, %s", err)
+	}
 }
 
 // 查询Token, 用于查询Token颁发记录, 也就是登陆日志
@@ -249,6 +530,7 @@ func (s *service) QueryToken(ctx context.Context, req *token.QueryTokenRequest)
 		if err := resp.Decode(tk); err != nil {
 			return nil, exception.NewInternalServerError("decode token error, error is %s", err)
 		}
+		tk.Desensitize()
 		tokenSet.Add(tk)
 	}
 
@@ -272,17 +554,26 @@ func (s *service) DescribeToken(ctx context.Context, req *token.DescribeTokenReq
 		return nil, exception.NewUnauthorized(err.Error())
 	}
 
-	// 查询用户可以访问的空间
+	if err := s.setAvailableNamespace(ctx, tk); err != nil {
+		return nil, err
+	}
+	return tk, nil
+}
+
+// setAvailableNamespace 查询用户在当前域中可以访问的空间
+func (s *service) setAvailableNamespace(ctx context.Context, tk *token.Token) error {
 	query := policy.NewQueryPolicyRequest()
 	query.Page = request.NewPageRequest(policy.MAX_USER_POLICY, 1)
+	query.Domain = tk.Domain
 	query.Username = tk.Username
+	query.WithGroup = true
 	ps, err := s.policy.QueryPolicy(ctx, query)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if ps.Total > policy.MAX_USER_POLICY {
 		s.log.Warnf("user policy large than max policy count %d, total: %d", policy.MAX_USER_POLICY, ps.Total)
 	}
 	tk.AvailableNamespace = ps.GetNamespace()
-	return tk, nil
+	return nil
 }
@@ -1,14 +1,30 @@
 package token
 
-import context "context"
+import (
+	context "context"
+
+	"github.com/infraboard/mcenter/apps/domain"
+)
 
 type Service interface {
 	// 颁发Token
 	IssueToken(context.Context, *IssueTokenRequest) (*Token, error)
 	// 撤销Token
 	RevolkToken(context.Context, *RevolkTokenRequest) (*Token, error)
+	// 冻结用户的所有令牌, 比如用户修改密码后
+	BlockUserToken(context.Context, *BlockUserTokenRequest) (*BlockUserTokenResponse, error)
+	// 删除用户的所有令牌, 包括私有令牌, 用于注销用户
+	DeleteUserToken(context.Context, *DeleteUserTokenRequest) (*DeleteUserTokenResponse, error)
+	// 冻结域下所有用户的令牌, 比如域被禁用或者删除
+	BlockDomainToken(context.Context, *BlockDomainTokenRequest) (*BlockDomainTokenResponse, error)
+	// 删除域下所有的令牌, 清除域时调用
+	DeleteDomainResource(context.Context, *domain.DeleteDomainResourceRequest) (*domain.DeleteDomainResourceResponse, error)
+	// 统计域下未冻结的私有令牌数量, 用于配额检查
+	CountDomainResource(context.Context, *domain.CountDomainResourceRequest) (*domain.CountDomainResourceResponse, error)
 	// 切换Token空间
 	ChangeNamespace(context.Context, *ChangeNamespaceRequest) (*Token, error)
+	// 切换Token所在的域, 用户需要是目标域的成员, 按照目标域重新计算用户类型和可访问的空间
+	ChangeDomain(context.Context, *ChangeDomainRequest) (*Token, error)
 	// 查询Token, 用于查询Token颁发记录, 也就是登陆日志
 	QueryToken(context.Context, *QueryTokenRequest) (*TokenSet, error)
 	// 查询Token详情
@@ -0,0 +1,161 @@
+package token
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// MAC认证的Authorization Scheme
+	MAC_AUTH_SCHEME = "MAC"
+	// 签名有效期, 超过该时间的签名视为过期, 单位秒
+	MAC_SIGNATURE_EXPIRE_SECOND = 300
+)
+
+// NewMacSignature 对请求进行签名, path需要包含查询参数
+func NewMacSignature(method, path string, body []byte) *MacSignature {
+	return &MacSignature{
+		Method:    strings.ToUpper(method),
+		Path:      path,
+		Timestamp: time.Now().Unix(),
+		Nonce:     MakeBearer(16),
+		BodyHash:  HashBody(body),
+	}
+}
+
+// HashBody 计算请求体摘要
+func HashBody(body []byte) string {
+	h := sha256.Sum256(body)
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+// StringToSign 待签名字符串
+func (s *MacSignature) StringToSign() string {
+	return strings.Join([]string{
+		strings.ToUpper(s.Method),
+		s.Path,
+		strconv.FormatInt(s.Timestamp, 10),
+		s.Nonce,
+		s.BodyHash,
+	}, "\n")
+}
+
+func (s *MacSignature) compute(key string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(s.StringToSign()))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Sign 使用mac_key签名
+func (s *MacSignature) Sign(key string) *MacSignature {
+	s.Mac = s.compute(key)
+	return s
+}
+
+// Verify 校验签名以及签名时间
+func (s *MacSignature) Verify(key string) error {
+	if s.Mac == "" || s.Nonce == "" {
+		return fmt.Errorf("mac and nonce required")
+	}
+
+	skew := time.Now().Unix() - s.Timestamp
+	if skew > MAC_SIGNATURE_EXPIRE_SECOND || skew < -MAC_SIGNATURE_EXPIRE_SECOND {
+		return fmt.Errorf("mac signature expired, timestamp: %d", s.Timestamp)
+	}
+
+	if !hmac.Equal([]byte(s.compute(key)), []byte(s.Mac)) {
+		return fmt.Errorf("mac signature not correct")
+	}
+
+	return nil
+}
+
+// NonceKey 用于防重放的缓存key
+func (s *MacSignature) NonceKey(accessToken string) string {
+	return "mac_nonce_" + accessToken + "_" + s.Nonce
+}
+
+// Header 生成Authorization头, 格式: MAC id="", ts="", nonce="", bodyhash="", mac=""
+func (s *MacSignature) Header(accessToken string) string {
+	return fmt.Sprintf(`%s id="%s", ts="%d", nonce="%s", bodyhash="%s", mac="%s"`,
+		MAC_AUTH_SCHEME, accessToken, s.Timestamp, s.Nonce, s.BodyHash, s.Mac)
+}
+
+// SignHTTPRequest 对HTTP请求签名, 并设置Authorization头
+func SignHTTPRequest(r *http.Request, accessToken, key string) error {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+
+	sig := NewMacSignature(r.Method, r.URL.RequestURI(), body).Sign(key)
+	r.Header.Set(ACCESS_TOKEN_HEADER_KEY, sig.Header(accessToken))
+	return nil
+}
+
+// NewValidateTokenRequestFromHTTP 从HTTP请求中解析令牌, 支持Bearer和MAC两种方式
+// MAC方式的请求体摘要由服务端根据实际请求体计算, 不信任客户端传递的bodyhash
+func NewValidateTokenRequestFromHTTP(r *http.Request) (*ValidateTokenRequest, error) {
+	auth := r.Header.Get(ACCESS_TOKEN_HEADER_KEY)
+	scheme, params, _ := strings.Cut(strings.TrimSpace(auth), " ")
+	if !strings.EqualFold(scheme, MAC_AUTH_SCHEME) {
+		return NewValidateTokenRequest(GetTokenFromHTTPHeader(r)), nil
+	}
+
+	kv := parseMacParams(params)
+	ts, err := strconv.ParseInt(kv["ts"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("mac ts format error, %s", err)
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := NewValidateTokenRequest(kv["id"])
+	req.MacSignature = &MacSignature{
+		Method:    r.Method,
+		Path:      r.URL.RequestURI(),
+		Timestamp: ts,
+		Nonce:     kv["nonce"],
+		BodyHash:  HashBody(body),
+		Mac:       kv["mac"],
+	}
+	return req, nil
+}
+
+func parseMacParams(params string) map[string]string {
+	kv := map[string]string{}
+	for _, item := range strings.Split(params, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			continue
+		}
+		kv[k] = strings.Trim(v, `"`)
+	}
+	return kv
+}
+
+// readBody 读取请求体后重新放回, 保证后续处理可以继续读取
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return []byte{}, nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read request body error, %s", err)
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
@@ -0,0 +1,67 @@
+package token_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/token"
+)
+
+func TestMacSignHTTPRequest(t *testing.T) {
+	should := assert.New(t)
+
+	r := httptest.NewRequest("POST", "/mcenter/api/v1/service?page=1", strings.NewReader(`{"name":"cmdb"}`))
+	should.NoError(token.SignHTTPRequest(r, "ak", "secret"))
+	should.True(strings.HasPrefix(r.Header.Get(token.ACCESS_TOKEN_HEADER_KEY), "MAC "))
+
+	req, err := token.NewValidateTokenRequestFromHTTP(r)
+	if should.NoError(err) {
+		should.Equal("ak", req.AccessToken)
+		should.Equal("/mcenter/api/v1/service?page=1", req.MacSignature.Path)
+		should.NoError(req.MacSignature.Verify("secret"))
+		should.Error(req.MacSignature.Verify("other secret"))
+	}
+
+	// 请求体读取后需要放回
+	body, _ := io.ReadAll(r.Body)
+	should.Equal(`{"name":"cmdb"}`, string(body))
+}
+
+func TestMacTamperedBody(t *testing.T) {
+	should := assert.New(t)
+
+	r := httptest.NewRequest("POST", "/v1/service", strings.NewReader(`{"name":"cmdb"}`))
+	should.NoError(token.SignHTTPRequest(r, "ak", "secret"))
+
+	// 篡改请求体, 服务端根据实际请求体计算摘要
+	r.Body = io.NopCloser(strings.NewReader(`{"name":"evil"}`))
+	req, err := token.NewValidateTokenRequestFromHTTP(r)
+	if should.NoError(err) {
+		should.Error(req.MacSignature.Verify("secret"))
+	}
+}
+
+func TestMacSignatureExpired(t *testing.T) {
+	should := assert.New(t)
+
+	sig := token.NewMacSignature("GET", "/v1/service", nil)
+	sig.Timestamp -= token.MAC_SIGNATURE_EXPIRE_SECOND + 1
+	sig.Sign("secret")
+	should.Error(sig.Verify("secret"))
+}
+
+func TestBearerFromHTTP(t *testing.T) {
+	should := assert.New(t)
+
+	r := httptest.NewRequest("GET", "/v1/service", nil)
+	r.Header.Set(token.ACCESS_TOKEN_HEADER_KEY, "Bearer ak")
+	req, err := token.NewValidateTokenRequestFromHTTP(r)
+	if should.NoError(err) {
+		should.Equal("ak", req.AccessToken)
+		should.Nil(req.MacSignature)
+	}
+}
@@ -57,6 +57,10 @@ func (i *issuer) IssueToken(ctx context.Context, req *token.IssueTokenRequest) (
 	// 检查用户密码是否正确
 	u, err := p.CheckUserPassword(username, req.Password)
 	if err != nil {
+		// 用户不存在和密码错误一样计入失败次数
+		if exception.IsNotFoundError(err) {
+			return nil, exception.NewUnauthorized("user or password not connrect")
+		}
 		return nil, err
 	}
 
@@ -81,6 +85,11 @@ func (i *issuer) IssueToken(ctx context.Context, req *token.IssueTokenRequest) (
 		}
 	}
 
+	// 冻结, 停用或者不在生效时间内的用户不允许登录
+	if err := lu.CheckActive(); err != nil {
+		return nil, exception.NewPermissionDeny("%s", err)
+	}
+
 	// 颁发Token
 	tk := token.NewToken(req)
 	tk.Domain = lu.Spec.Domain
@@ -46,6 +46,16 @@ type UserProfile struct {
 	Username    string
 	DisplayName string
 	Groups      []string
+	// 用户在LDAP中是否被禁用, 同步时使用
+	Disabled bool
+}
+
+// Email 用户的第一个邮箱
+func (u *UserProfile) Email() string {
+	if len(u.Emails) > 0 {
+		return u.Emails[0]
+	}
+	return ""
 }
 
 func (p *Provider) dialTLS(network, addr string, config *tls.Config) (Connection, error) {
@@ -126,7 +136,7 @@ func (p *Provider) CheckUserPassword(inputUsername string, password string) (*Us
 
 	conn, err := p.connect(profile.DN, password)
 	if err != nil {
-		return nil, fmt.Errorf("authentication of user %s failed. Cause: %s", inputUsername, err)
+		return nil, exception.NewUnauthorized("authentication of user %s failed. Cause: %s", inputUsername, err)
 	}
 	defer conn.Close()
 
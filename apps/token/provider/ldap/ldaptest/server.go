@@ -0,0 +1,355 @@
+// Package ldaptest 进程内的LDAP测试服务, 只实现简单绑定和查询, 用于测试LDAP相关的功能
+package ldaptest
+
+import (
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+)
+
+// LDAP协议操作: https://www.rfc-editor.org/rfc/rfc4511#section-4.2
+const (
+	applicationBindRequest       = 0
+	applicationBindResponse      = 1
+	applicationUnbindRequest     = 2
+	applicationSearchRequest     = 3
+	applicationSearchResultEntry = 4
+	applicationSearchResultDone  = 5
+)
+
+// 过滤条件类型
+const (
+	filterAnd           = 0
+	filterOr            = 1
+	filterNot           = 2
+	filterEqualityMatch = 3
+	filterSubstrings    = 4
+	filterPresent       = 7
+)
+
+// 子串匹配的类型
+const (
+	substringInitial = 0
+	substringAny     = 1
+)
+
+const (
+	resultSuccess            = 0
+	resultInvalidCredentials = 49
+
+	// 密码属性, 不会在查询结果中返回
+	attributeUserPassword = "userPassword"
+)
+
+// Entry LDAP条目
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// NewEntry 条目
+func NewEntry(dn string) *Entry {
+	return &Entry{
+		DN:         dn,
+		Attributes: map[string][]string{},
+	}
+}
+
+// Set 设置属性
+func (e *Entry) Set(name string, values ...string) *Entry {
+	e.Attributes[name] = values
+	return e
+}
+
+// Get 属性值, 属性名称不区分大小写
+func (e *Entry) Get(name string) []string {
+	for k, v := range e.Attributes {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+// Server LDAP测试服务
+type Server struct {
+	BindDN       string
+	BindPassword string
+
+	l       net.Listener
+	lock    sync.RWMutex
+	entries []*Entry
+	wg      sync.WaitGroup
+}
+
+// NewServer 启动测试服务, 监听本地随机端口
+func NewServer(bindDN, bindPassword string) (*Server, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		BindDN:       bindDN,
+		BindPassword: bindPassword,
+		l:            l,
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// URL 服务地址, 比如: ldap://127.0.0.1:389
+func (s *Server) URL() string {
+	return "ldap://" + s.l.Addr().String()
+}
+
+// Add 添加条目
+func (s *Server) Add(entries ...*Entry) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.entries = append(s.entries, entries...)
+}
+
+// Delete 删除条目
+func (s *Server) Delete(dn string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i := range s.entries {
+		if strings.EqualFold(s.entries[i].DN, dn) {
+			s.entries = append(s.entries[:i], s.entries[i+1:]...)
+			return
+		}
+	}
+}
+
+// Lookup 查询条目
+func (s *Server) Lookup(dn string) *Entry {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	for i := range s.entries {
+		if strings.EqualFold(s.entries[i].DN, dn) {
+			return s.entries[i]
+		}
+	}
+	return nil
+}
+
+// Close 关闭服务
+func (s *Server) Close() error {
+	err := s.l.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.l.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		req, err := ber.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		if len(req.Children) < 2 {
+			return
+		}
+
+		id, _ := req.Children[0].Value.(int64)
+		op := req.Children[1]
+		switch op.Tag {
+		case applicationBindRequest:
+			err = s.bind(conn, id, op)
+		case applicationSearchRequest:
+			err = s.search(conn, id, op)
+		case applicationUnbindRequest:
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) bind(w io.Writer, id int64, op *ber.Packet) error {
+	dn := op.Children[1].Value.(string)
+	password := op.Children[2].Data.String()
+
+	if strings.EqualFold(dn, s.BindDN) && password == s.BindPassword {
+		return writeResult(w, id, applicationBindResponse, resultSuccess, "")
+	}
+	if e := s.Lookup(dn); e != nil && password != "" {
+		for _, p := range e.Get(attributeUserPassword) {
+			if p == password {
+				return writeResult(w, id, applicationBindResponse, resultSuccess, "")
+			}
+		}
+	}
+	return writeResult(w, id, applicationBindResponse, resultInvalidCredentials, "invalid credentials")
+}
+
+func (s *Server) search(w io.Writer, id int64, op *ber.Packet) error {
+	baseDN := strings.ToLower(op.Children[0].Value.(string))
+	sizeLimit, _ := op.Children[3].Value.(int64)
+	filter := op.Children[6]
+	attributes := []string{}
+	for _, a := range op.Children[7].Children {
+		attributes = append(attributes, a.Value.(string))
+	}
+
+	s.lock.RLock()
+	matched := []*Entry{}
+	for _, e := range s.entries {
+		if !strings.HasSuffix(strings.ToLower(e.DN), baseDN) {
+			continue
+		}
+		if match(e, filter) {
+			matched = append(matched, e)
+		}
+	}
+	s.lock.RUnlock()
+
+	for i, e := range matched {
+		if sizeLimit > 0 && int64(i) >= sizeLimit {
+			break
+		}
+		if _, err := w.Write(newEntryPacket(id, e, attributes).Bytes()); err != nil {
+			return err
+		}
+	}
+	return writeResult(w, id, applicationSearchResultDone, resultSuccess, "")
+}
+
+// match 判断条目是否满足过滤条件
+func match(e *Entry, f *ber.Packet) bool {
+	switch f.Tag {
+	case filterAnd:
+		for _, c := range f.Children {
+			if !match(e, c) {
+				return false
+			}
+		}
+		return true
+	case filterOr:
+		for _, c := range f.Children {
+			if match(e, c) {
+				return true
+			}
+		}
+		return false
+	case filterNot:
+		return !match(e, f.Children[0])
+	case filterEqualityMatch:
+		name, value := f.Children[0].Value.(string), f.Children[1].Value.(string)
+		if strings.EqualFold(name, "dn") {
+			return strings.EqualFold(e.DN, value)
+		}
+		for _, v := range e.Get(name) {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+		return false
+	case filterSubstrings:
+		name := f.Children[0].Value.(string)
+		for _, v := range e.Get(name) {
+			if matchSubstrings(strings.ToLower(v), f.Children[1].Children) {
+				return true
+			}
+		}
+		return false
+	case filterPresent:
+		name := f.Data.String()
+		return len(e.Get(name)) > 0
+	default:
+		return false
+	}
+}
+
+func matchSubstrings(v string, parts []*ber.Packet) bool {
+	for _, p := range parts {
+		sub := strings.ToLower(p.Data.String())
+		switch p.Tag {
+		case substringInitial:
+			if !strings.HasPrefix(v, sub) {
+				return false
+			}
+			v = v[len(sub):]
+		case substringAny:
+			i := strings.Index(v, sub)
+			if i < 0 {
+				return false
+			}
+			v = v[i+len(sub):]
+		default:
+			if !strings.HasSuffix(v, sub) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func newEntryPacket(id int64, e *Entry, attributes []string) *ber.Packet {
+	entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, applicationSearchResultEntry, nil, "Search Result Entry")
+	entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "DN"))
+
+	attrs := ber.NewSequence("Attributes")
+	for name, values := range e.Attributes {
+		if strings.EqualFold(name, attributeUserPassword) || !requested(name, attributes) {
+			continue
+		}
+		attr := ber.NewSequence("Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range values {
+			vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+		attr.AppendChild(vals)
+		attrs.AppendChild(attr)
+	}
+	entry.AppendChild(attrs)
+
+	return newMessage(id, entry)
+}
+
+func requested(name string, attributes []string) bool {
+	if len(attributes) == 0 {
+		return true
+	}
+	for _, a := range attributes {
+		if a == "*" || strings.EqualFold(a, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeResult(w io.Writer, id int64, tag ber.Tag, code int64, message string) error {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "Result Code"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, message, "Diagnostic Message"))
+	_, err := w.Write(newMessage(id, result).Bytes())
+	return err
+}
+
+func newMessage(id int64, op *ber.Packet) *ber.Packet {
+	msg := ber.NewSequence("LDAP Response")
+	msg.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "Message ID"))
+	msg.AppendChild(op)
+	return msg
+}
@@ -0,0 +1,107 @@
+package ldap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// GroupProfile LDAP用户组
+type GroupProfile struct {
+	DN   string
+	Name string
+	// 组成员, 成员的DN或者用户名
+	Members []string
+}
+
+func (p *Provider) resolveSyncFilter(filter string) string {
+	filter = strings.ReplaceAll(filter, "{username_attribute}", p.conf.UsernameAttribute)
+	return strings.ReplaceAll(filter, "{mail_attribute}", p.conf.MailAttribute)
+}
+
+// ListUsers 查询所有需要同步的用户
+func (p *Provider) ListUsers() ([]*UserProfile, error) {
+	conn, err := p.connect(p.conf.BindDn, p.conf.BindPassword)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	userFilter := p.resolveSyncFilter(p.conf.UserSyncFilter)
+	p.log.Debugf("Computed user sync filter is %s", userFilter)
+
+	attributes := []string{"dn",
+		p.conf.MailAttribute,
+		p.conf.UsernameAttribute,
+		p.conf.DisplayNameAttribute,
+	}
+	if p.conf.DisabledAttribute != "" {
+		attributes = append(attributes, p.conf.DisabledAttribute)
+	}
+
+	searchRequest := ldap.NewSearchRequest(
+		p.conf.BaseDn, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		0, 0, false, userFilter, attributes, nil,
+	)
+	sr, err := conn.Search(searchRequest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list users. Cause: %s", err)
+	}
+
+	users := make([]*UserProfile, 0, len(sr.Entries))
+	for _, entry := range sr.Entries {
+		username := entry.GetEqualFoldAttributeValue(p.conf.UsernameAttribute)
+		// 没有用户名属性的条目无法对应到本地用户
+		if username == "" {
+			p.log.Warnf("entry %s has no attribute %s, skipped", entry.DN, p.conf.UsernameAttribute)
+			continue
+		}
+
+		users = append(users, &UserProfile{
+			DN:          entry.DN,
+			Username:    username,
+			Emails:      entry.GetEqualFoldAttributeValues(p.conf.MailAttribute),
+			DisplayName: entry.GetEqualFoldAttributeValue(p.conf.DisplayNameAttribute),
+			Disabled:    p.conf.IsDisabled(entry.GetEqualFoldAttributeValues(p.conf.DisabledAttribute)),
+		})
+	}
+	return users, nil
+}
+
+// ListGroups 查询所有需要同步的用户组
+func (p *Provider) ListGroups() ([]*GroupProfile, error) {
+	conn, err := p.connect(p.conf.BindDn, p.conf.BindPassword)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	groupFilter := p.resolveSyncFilter(p.conf.GroupSyncFilter)
+	p.log.Debugf("Computed group sync filter is %s", groupFilter)
+
+	searchRequest := ldap.NewSearchRequest(
+		p.conf.BaseDn, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		0, 0, false, groupFilter, []string{"dn", p.conf.GroupnameAttribute, p.conf.GroupMemberAttribute}, nil,
+	)
+	sr, err := conn.Search(searchRequest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list groups. Cause: %s", err)
+	}
+
+	groups := make([]*GroupProfile, 0, len(sr.Entries))
+	for _, entry := range sr.Entries {
+		name := entry.GetEqualFoldAttributeValue(p.conf.GroupnameAttribute)
+		if name == "" {
+			p.log.Warnf("entry %s has no attribute %s, skipped", entry.DN, p.conf.GroupnameAttribute)
+			continue
+		}
+
+		groups = append(groups, &GroupProfile{
+			DN:      entry.DN,
+			Name:    name,
+			Members: entry.GetEqualFoldAttributeValues(p.conf.GroupMemberAttribute),
+		})
+	}
+	return groups, nil
+}
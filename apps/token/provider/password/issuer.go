@@ -3,6 +3,7 @@ package password
 import (
 	"context"
 
+	"github.com/infraboard/mcenter/apps/denylist"
 	"github.com/infraboard/mcenter/apps/domain"
 	"github.com/infraboard/mcenter/apps/token"
 	"github.com/infraboard/mcenter/apps/token/provider"
@@ -18,8 +19,9 @@ var (
 )
 
 type issuer struct {
-	user   user.Service
-	domain domain.Service
+	user     user.Service
+	domain   domain.Service
+	denylist denylist.Service
 
 	log logger.Logger
 }
@@ -27,6 +29,7 @@ type issuer struct {
 func (i *issuer) Init() error {
 	i.user = app.GetInternalApp(user.AppName).(user.Service)
 	i.domain = app.GetInternalApp(domain.AppName).(domain.Service)
+	i.denylist = app.GetInternalApp(denylist.AppName).(denylist.Service)
 	i.log = zap.L().Named("issuer.password")
 	return nil
 }
@@ -45,12 +48,40 @@ func (i *issuer) IssueToken(ctx context.Context, req *token.IssueTokenRequest) (
 	}
 
 	// 检测用户的密码是否正确
-	u, err := i.user.DescribeUser(ctx, user.NewDescriptUserRequestWithName(req.Username))
+	u, err := i.authenticate(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	if err := u.Password.CheckPassword(req.Password); err != nil {
-		return nil, AUTH_FAILED
+
+	// 冻结, 停用或者不在生效时间内的用户不允许登录
+	if err := u.CheckActive(); err != nil {
+		return nil, exception.NewPermissionDeny("%s", err)
+	}
+
+	// 被邀请的用户需要通过激活链接设置密码后才能登录
+	if u.IsPendingActivation() {
+		return nil, exception.NewPermissionDeny("user %s not activated", u.Spec.Username)
+	}
+
+	// 外部认证源的用户, 密码由认证源管理
+	if !u.Spec.Provider.Equal(user.PROVIDER_LOCAL) {
+		return i.newToken(req, u), nil
+	}
+
+	// 密码hash算法或者参数调整后, 登录时自动升级
+	if u.Password.NeedRehash() {
+		if _, err := i.user.UpgradePasswordHash(ctx, user.NewUpgradePasswordHashRequest(u.Id, req.Password)); err != nil {
+			i.log.Errorf("upgrade user %s password hash error, %s", u.Spec.Username, err)
+		}
+	}
+
+	// 黑名单更新后, 在用户下次登录时检测当前密码
+	i.checkBreached(ctx, u, req.Password)
+
+	// 管理员重置的密码, 需要用户修改后才能登录
+	// 创建用户时设置的NeedReset只做提示, 不阻止登录
+	if u.Password.ForceReset {
+		return nil, exception.NewPasswordExired("password need reset, %s", u.Password.ResetReason)
 	}
 
 	// 检测密码是否过期
@@ -76,12 +107,33 @@ func (i *issuer) IssueToken(ctx context.Context, req *token.IssueTokenRequest) (
 	}
 
 	// 3. 颁发Token
+	return i.newToken(req, u), nil
+}
+
+func (i *issuer) newToken(req *token.IssueTokenRequest, u *user.User) *token.Token {
 	tk := token.NewToken(req)
 	tk.Domain = u.Spec.Domain
 	tk.Username = u.Spec.Username
 	tk.UserType = u.Spec.Type
 	tk.UserId = u.Id
-	return tk, nil
+	return tk
+}
+
+// checkBreached 检测密码是否命中泄露密码黑名单, 结果变化时更新用户的标记, 供管理员查看
+func (i *issuer) checkBreached(ctx context.Context, u *user.User, password string) {
+	result, err := i.denylist.CheckPassword(password)
+	if err != nil {
+		i.log.Debugf("check password denylist error, %s", err)
+		return
+	}
+	if result.Matched == u.Password.Breached {
+		return
+	}
+
+	req := user.NewMarkPasswordBreachedRequest(u.Id, result.Matched, result.Reason())
+	if _, err := i.user.MarkPasswordBreached(ctx, req); err != nil {
+		i.log.Errorf("mark user %s password breached error, %s", u.Spec.Username, err)
+	}
 }
 
 func init() {
@@ -0,0 +1,135 @@
+package password
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/domain/authsource"
+	"github.com/infraboard/mcenter/apps/domain/password"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// authenticate 域配置了认证源时按照优先级依次尝试, 否则使用本地数据库认证
+func (i *issuer) authenticate(ctx context.Context, req *token.IssueTokenRequest) (*user.User, error) {
+	name := req.Domain
+	if name == "" {
+		name = domain.DEFAULT_DOMAIN
+	}
+	dom, err := i.domain.DescribeDomain(ctx, domain.NewDescribeDomainRequestByName(name))
+	if err != nil && (req.Domain != "" || !exception.IsNotFoundError(err)) {
+		return nil, err
+	}
+	if dom == nil || len(dom.Spec.AuthSources) == 0 {
+		return i.authenticateLocal(ctx, req)
+	}
+
+	// 所有认证源都是凭证错误时才返回认证失败, 认证源不可用时返回原始错误, 不计入失败次数
+	var sourceErr error
+	for _, s := range dom.Spec.MatchAuthSources(req.Username) {
+		u, err := i.authenticateBySource(ctx, dom, s, req)
+		if err != nil {
+			i.log.Debugf("auth source %s authenticate user %s failed, %s", s.Name, req.Username, err)
+			if !token.IsCredentialError(err) {
+				sourceErr = err
+			}
+			continue
+		}
+		return u, nil
+	}
+	if sourceErr != nil {
+		return nil, sourceErr
+	}
+	return nil, AUTH_FAILED
+}
+
+// authenticateLocal 本地数据库认证, 未指定域时按照用户名查询
+func (i *issuer) authenticateLocal(ctx context.Context, req *token.IssueTokenRequest) (*user.User, error) {
+	u, err := i.user.DescribeUser(ctx, user.NewDescriptUserRequestWithDomainName(req.Domain, req.Username))
+	if err != nil {
+		// 用户不存在和密码错误返回相同的错误
+		if exception.IsNotFoundError(err) {
+			return nil, AUTH_FAILED
+		}
+		return nil, err
+	}
+	if err := u.Password.CheckPassword(req.Password); err != nil {
+		return nil, AUTH_FAILED
+	}
+	return u, nil
+}
+
+// authenticateBySource 通过认证源认证, 外部认证源的用户首次登录时自动创建
+func (i *issuer) authenticateBySource(ctx context.Context, dom *domain.Domain, s *domain.AuthSource, req *token.IssueTokenRequest) (*user.User, error) {
+	a, err := authsource.New(dom.Spec.Name, s, i.user)
+	if err != nil {
+		return nil, err
+	}
+
+	username, suffix := s.SourceUsername(req.Username)
+	id, err := a.Authenticate(ctx, username, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if id.User != nil {
+		return id.User, nil
+	}
+	return i.syncUser(ctx, dom, s, id, s.LocalUsername(id.Username, suffix))
+}
+
+// syncUser 查询或者创建认证源用户对应的本地用户
+func (i *issuer) syncUser(ctx context.Context, dom *domain.Domain, s *domain.AuthSource, id *authsource.Identity, username string) (*user.User, error) {
+	provider := authsource.Provider(s.Type)
+
+	u, err := i.user.DescribeUser(ctx, user.NewDescriptUserRequestWithDomainName(dom.Spec.Name, username))
+	if err == nil {
+		// 同名的用户属于其他认证源时不允许登录
+		if !u.Spec.Provider.Equal(provider) || !s.CanBind(u.Spec.AuthSource) {
+			return nil, exception.NewPermissionDeny("user %s belongs to other auth source", username)
+		}
+		return u, nil
+	}
+	if !exception.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	// 用户通过认证源认证, 本地密码随机生成
+	var ps *domain.PasswordSecurity
+	if dom.Spec.SecuritySetting != nil && dom.Spec.SecuritySetting.PasswordSecurity != nil {
+		ps = proto.Clone(dom.Spec.SecuritySetting.PasswordSecurity).(*domain.PasswordSecurity)
+	}
+	pass, err := password.New(ps).GenerateValid()
+	if err != nil {
+		return nil, err
+	}
+
+	i.log.Debugf("sync user: %s(%s) from auth source %s", username, dom.Spec.Name, s.Name)
+	createReq := user.NewCreateUserRequest()
+	createReq.Provider = provider
+	createReq.Type = user.TYPE_SUB
+	createReq.CreateBy = user.CREATE_BY_SYNC
+	createReq.Domain = dom.Spec.Name
+	createReq.Username = username
+	createReq.Password = *pass
+	createReq.Description = "认证源" + s.Name + "自动创建"
+	createReq.ExternalId = id.ExternalId
+	createReq.AuthSource = s.Name
+	u, err = i.user.CreateUser(ctx, createReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if id.Email != "" || id.DisplayName != "" {
+		patch := user.NewPatchUserRequest(u.Id)
+		patch.Profile = user.NewProfile()
+		patch.Profile.Email = id.Email
+		patch.Profile.NickName = id.DisplayName
+		if _, err := i.user.UpdateUser(ctx, patch); err != nil {
+			i.log.Errorf("update user %s profile error, %s", username, err)
+		}
+	}
+	return u, nil
+}
@@ -49,6 +49,12 @@ func (i *issuer) IssueToken(ctx context.Context, req *token.IssueTokenRequest) (
 	newTk.UserType = tk.UserType
 	newTk.UserId = tk.UserId
 
+	// 私有令牌的访问范围只能在原令牌的范围内缩小
+	newTk.Scope, err = tk.NarrowScope(req.Scope)
+	if err != nil {
+		return nil, exception.NewPermissionDeny("%s", err)
+	}
+
 	return newTk, nil
 }
 
@@ -43,6 +43,11 @@ func (i *issuer) IssueToken(ctx context.Context, req *token.IssueTokenRequest) (
 		return nil, err
 	}
 
+	// 冻结的令牌不允许刷新
+	if tk.Status != nil && tk.Status.IsBlock {
+		return nil, exception.NewSessionTerminated(tk.Status.BlockMessage())
+	}
+
 	if tk.RefreshToken != req.RefreshToken {
 		return nil, fmt.Errorf("refresh token not correct")
 	}
@@ -50,6 +55,12 @@ func (i *issuer) IssueToken(ctx context.Context, req *token.IssueTokenRequest) (
 		return nil, fmt.Errorf("refresh token is expired")
 	}
 
+	// 刷新时可以缩小访问范围, 但不能扩大
+	scope, err := tk.NarrowScope(req.Scope)
+	if err != nil {
+		return nil, exception.NewPermissionDeny("%s", err)
+	}
+
 	// 撤销之前的Token
 	revolkReq := token.NewRevolkTokenRequest(req.AccessToken, req.RefreshToken)
 	_, err = i.token.RevolkToken(ctx, revolkReq)
@@ -65,6 +76,7 @@ func (i *issuer) IssueToken(ctx context.Context, req *token.IssueTokenRequest) (
 	newTk.Username = tk.Username
 	newTk.UserType = tk.UserType
 	newTk.UserId = tk.UserId
+	newTk.Scope = scope
 
 	return newTk, nil
 }
@@ -0,0 +1,143 @@
+package token
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// 匹配所有的key或者value
+	SCOPE_MATCH_ALL = "*"
+)
+
+// Scope 令牌的访问范围, 字符串格式: key1=v1,v2 key2=v3
+// 多个条件之间是与的关系, 同一个key的多个value之间是或的关系
+type Scope map[string][]string
+
+// ParseScope 解析范围字符串, 空字符串表示不做限制
+func ParseScope(s string) (Scope, error) {
+	scope := Scope{}
+	for _, item := range strings.Fields(s) {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+			return nil, fmt.Errorf("scope %s format error, must be key=value", item)
+		}
+
+		for _, v := range strings.Split(kv[1], ",") {
+			if v == "" {
+				return nil, fmt.Errorf("scope %s has empty value", item)
+			}
+			if !scope.hasValue(kv[0], v) {
+				scope[kv[0]] = append(scope[kv[0]], v)
+			}
+		}
+	}
+	return scope, nil
+}
+
+func (s Scope) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// String 按key排序, 保证相同的范围序列化结果相同
+func (s Scope) String() string {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	items := make([]string, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, k+"="+strings.Join(s[k], ","))
+	}
+	return strings.Join(items, " ")
+}
+
+// MatchLabel 判断资源标签是否在范围内, 匹配规则同role.Permission.MatchLabel
+func (s Scope) MatchLabel(label map[string]string) bool {
+	for k, values := range s {
+		if !matchLabel(k, values, label) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchLabel(key string, values []string, label map[string]string) bool {
+	for lk, lv := range label {
+		if key != SCOPE_MATCH_ALL && key != lk {
+			continue
+		}
+		for i := range values {
+			if values[i] == SCOPE_MATCH_ALL || values[i] == lv {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Contains 判断sub是否是当前范围的子集
+// sub必须包含当前范围的所有key, 并且每个key的取值都在当前范围内
+func (s Scope) Contains(sub Scope) bool {
+	for k := range s {
+		subValues, ok := sub[k]
+		if !ok {
+			return false
+		}
+		if s.hasValue(k, SCOPE_MATCH_ALL) {
+			continue
+		}
+		for i := range subValues {
+			if !s.hasValue(k, subValues[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Narrow 基于当前范围派生新的范围, 只允许缩小不允许扩大
+// req为空时继承当前范围
+func (s Scope) Narrow(req Scope) (Scope, error) {
+	if req.IsEmpty() {
+		return s, nil
+	}
+	if !s.Contains(req) {
+		return nil, fmt.Errorf("scope %s out of range %s", req, s)
+	}
+	return req, nil
+}
+
+func (s Scope) hasValue(key, value string) bool {
+	for _, v := range s[key] {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseScope 解析令牌的访问范围
+func (t *Token) ParseScope() (Scope, error) {
+	return ParseScope(t.Scope)
+}
+
+// NarrowScope 基于父令牌派生访问范围, 用于私有令牌颁发和令牌交换
+func (t *Token) NarrowScope(req string) (string, error) {
+	parent, err := t.ParseScope()
+	if err != nil {
+		return "", err
+	}
+	sub, err := ParseScope(req)
+	if err != nil {
+		return "", err
+	}
+	scope, err := parent.Narrow(sub)
+	if err != nil {
+		return "", err
+	}
+	return scope.String(), nil
+}
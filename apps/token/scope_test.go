@@ -0,0 +1,76 @@
+package token_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/token"
+)
+
+func TestParseScope(t *testing.T) {
+	should := assert.New(t)
+
+	s, err := token.ParseScope("env=prod,test  app=web env=prod")
+	if should.NoError(err) {
+		should.Equal("app=web env=prod,test", s.String())
+	}
+
+	s, err = token.ParseScope("")
+	if should.NoError(err) {
+		should.True(s.IsEmpty())
+	}
+
+	_, err = token.ParseScope("env")
+	should.Error(err)
+	_, err = token.ParseScope("env=prod,")
+	should.Error(err)
+}
+
+func TestScopeMatchLabel(t *testing.T) {
+	should := assert.New(t)
+
+	s, _ := token.ParseScope("env=prod,test app=*")
+	should.True(s.MatchLabel(map[string]string{"env": "prod", "app": "web"}))
+	should.False(s.MatchLabel(map[string]string{"env": "dev", "app": "web"}))
+	// 资源缺少范围要求的标签, 不允许访问
+	should.False(s.MatchLabel(map[string]string{"env": "prod"}))
+
+	all, _ := token.ParseScope("*=prod")
+	should.True(all.MatchLabel(map[string]string{"stage": "prod"}))
+
+	empty, _ := token.ParseScope("")
+	should.True(empty.MatchLabel(nil))
+}
+
+func TestNarrowScope(t *testing.T) {
+	should := assert.New(t)
+
+	tk := token.NewToken(token.NewIssueTokenRequest())
+	tk.Scope = "env=prod,test"
+
+	// 不指定时继承原范围
+	scope, err := tk.NarrowScope("")
+	if should.NoError(err) {
+		should.Equal("env=prod,test", scope)
+	}
+
+	// 缩小范围
+	scope, err = tk.NarrowScope("env=prod app=web")
+	if should.NoError(err) {
+		should.Equal("app=web env=prod", scope)
+	}
+
+	// 扩大范围
+	_, err = tk.NarrowScope("env=dev")
+	should.Error(err)
+	_, err = tk.NarrowScope("app=web")
+	should.Error(err)
+
+	// 原令牌没有范围限制时可以任意指定
+	tk.Scope = ""
+	scope, err = tk.NarrowScope("env=dev")
+	if should.NoError(err) {
+		should.Equal("env=dev", scope)
+	}
+}
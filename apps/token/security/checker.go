@@ -3,15 +3,18 @@ package security
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/infraboard/mcube/app"
 	"github.com/infraboard/mcube/cache"
+	"github.com/infraboard/mcube/exception"
 	"github.com/infraboard/mcube/logger"
 	"github.com/infraboard/mcube/logger/zap"
 
 	"github.com/infraboard/mcenter/apps/domain"
 	"github.com/infraboard/mcenter/apps/ip2region"
+	"github.com/infraboard/mcenter/apps/retrylock"
 	"github.com/infraboard/mcenter/apps/token"
 	"github.com/infraboard/mcenter/apps/user"
 )
@@ -29,6 +32,7 @@ func NewChecker() (Checker, error) {
 		token:     app.GetInternalApp(token.AppName).(token.Service),
 		cache:     c,
 		ip2Regoin: app.GetInternalApp(ip2region.AppName).(ip2region.Service),
+		retrylock: app.GetInternalApp(retrylock.AppName).(retrylock.Service),
 		log:       zap.L().Named("Login Security"),
 	}, nil
 }
@@ -39,10 +43,16 @@ type checker struct {
 	token     token.Service
 	cache     cache.Cache
 	ip2Regoin ip2region.Service
+	retrylock retrylock.Service
 	log       logger.Logger
 }
 
 func (c *checker) MaxFailedRetryCheck(ctx context.Context, req *token.IssueTokenRequest) error {
+	key := req.RetryLockKey()
+	if key == "" {
+		return nil
+	}
+
 	ss := c.getOrDefaultSecuritySettingWithUser(ctx, req.Username)
 	if !ss.LoginSecurity.RetryLock {
 		c.log.Debugf("retry lock check disabled, don't check")
@@ -50,49 +60,61 @@ func (c *checker) MaxFailedRetryCheck(ctx context.Context, req *token.IssueToken
 	}
 	c.log.Debugf("max failed retry lock check enabled, checking ...")
 
-	var count uint32
-	err := c.cache.Get(req.AbnormalUserCheckKey(), &count)
+	l, err := c.retrylock.DescribeRetryLock(ctx, key)
 	if err != nil {
-		c.log.Errorf("get key %s from cache error, %s", req.AbnormalUserCheckKey(), err)
+		if !exception.IsNotFoundError(err) {
+			c.log.Errorf("get retry lock %s error, %s", key, err)
+		}
+		return nil
 	}
 
-	rc := ss.LoginSecurity.RetryLockConfig
-	c.log.Debugf("retry times: %d, retry limite: %d", count, rc.RetryLimite)
-	if count+1 >= rc.RetryLimite {
-		return fmt.Errorf("登录失败次数过多, 请%d分钟后重试", rc.LockedMinite)
+	c.log.Debugf("retry times: %d, retry limite: %d", l.Count, ss.LoginSecurity.RetryLockConfig.RetryLimite)
+	if l.IsLocked() {
+		return fmt.Errorf("登录失败次数过多, 请%d分钟后重试", int(math.Ceil(l.TTL().Minutes())))
 	}
 
 	return nil
 }
 
-func (c *checker) UpdateFailedRetry(ctx context.Context, req *token.IssueTokenRequest) error {
-	ss := c.getOrDefaultSecuritySettingWithUser(ctx, req.Username)
+func (c *checker) UpdateFailedRetry(ctx context.Context, req *token.IssueTokenRequest, reason string) error {
+	key := req.RetryLockKey()
+	if key == "" {
+		return nil
+	}
+
+	ss := domain.NewDefaultSecuritySetting()
+	domainName := req.Domain
+	u, err := c.user.DescribeUser(ctx, user.NewDescriptUserRequestWithName(req.Username))
+	if err == nil {
+		domainName = u.Spec.Domain
+		ss = c.getOrDefaultSecuritySettingWithDomain(ctx, domainName)
+	}
 	if !ss.LoginSecurity.RetryLock {
 		c.log.Debugf("retry lock check disabled, don't check")
 		return nil
 	}
 
-	c.log.Debugf("update failed retry count, check key: %s", req.AbnormalUserCheckKey())
-
-	var count int
-	if err := c.cache.Get(req.AbnormalUserCheckKey(), &count); err == nil {
-		// 之前已经登陆失败过
-		err := c.cache.Put(req.AbnormalUserCheckKey(), count+1)
-		if err != nil {
-			c.log.Errorf("set key %s to cache error, %s", req.AbnormalUserCheckKey())
-		}
-	} else {
-		// 首次登陆失败
-		err := c.cache.PutWithTTL(
-			req.AbnormalUserCheckKey(),
-			count+1,
-			ss.LoginSecurity.RetryLockConfig.LockedMiniteDuration(),
-		)
-		if err != nil {
-			c.log.Errorf("set key %s to cache error, %s", req.AbnormalUserCheckKey())
+	c.log.Debugf("update failed retry count, check key: %s", key)
+	attempt := retrylock.NewFailedAttempt(domainName, req.Username, reason)
+	attempt.GrantType = req.GrantType.String()
+	if req.Location != nil {
+		attempt.RemoteIp = req.Location.GetIpLocation().GetRemoteIp()
+		if ua := req.Location.GetUserAgent(); ua != nil {
+			attempt.UserAgent = fmt.Sprintf("%s %s %s", ua.Os, ua.BrowserName, ua.BrowserVersion)
 		}
 	}
-	return nil
+
+	rc := ss.LoginSecurity.RetryLockConfig
+	_, err = c.retrylock.RecordFailure(ctx, retrylock.NewRecordFailureRequest(key, attempt, rc.RetryLimite, rc.LockedMinite))
+	return err
+}
+
+func (c *checker) ResetFailedRetry(ctx context.Context, req *token.IssueTokenRequest) error {
+	key := req.RetryLockKey()
+	if key == "" {
+		return nil
+	}
+	return c.retrylock.ResetRetryLock(ctx, key)
 }
 
 func (c *checker) OtherPlaceLoggedInChecK(ctx context.Context, tk *token.Token) error {
@@ -13,10 +13,13 @@ type Checker interface {
 	IPProtectChecker
 }
 
-// MaxTryChecker todo 失败重试限制
+// MaxTryChecker 失败重试限制
 type MaxTryChecker interface {
 	MaxFailedRetryCheck(context.Context, *token.IssueTokenRequest) error
-	UpdateFailedRetry(context.Context, *token.IssueTokenRequest) error
+	// 记录登录失败, reason为失败原因
+	UpdateFailedRetry(ctx context.Context, req *token.IssueTokenRequest, reason string) error
+	// 登录成功后清除失败次数
+	ResetFailedRetry(context.Context, *token.IssueTokenRequest) error
 }
 
 // ExceptionLockChecKer 异地登录限制
@@ -1,9 +1,13 @@
 package api
 
 import (
+	"bytes"
+	"io"
+
 	restfulspec "github.com/emicklei/go-restful-openapi/v2"
 	"github.com/emicklei/go-restful/v3"
 	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/exception"
 	"github.com/infraboard/mcube/http/restful/response"
 	"github.com/infraboard/mcube/logger"
 	"github.com/infraboard/mcube/logger/zap"
@@ -36,10 +40,35 @@ func (h *sub) Registry(ws *restful.WebService) {
 	tags := []string{"账号管理"}
 
 	ws.Route(ws.POST("/password").To(h.UpdatePassword).
-		Doc("子账号修改自己密码").
+		Doc("修改自己的密码, 通过用户Id或者域和用户名指定用户, 需要提供原密码, 原密码错误与登录共用失败重试锁定").
 		Metadata(restfulspec.KeyOpenAPITags, tags).
 		Reads(user.UpdatePasswordRequest{}).
+		Returns(0, "OK", &user.Password{}))
+
+	ws.Route(ws.POST("/password/recover").To(h.RecoverPassword).
+		Doc("通过找回密码的验证码设置新密码, 验证码通过验证码接口以账号方式申请").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(user.RecoverPasswordRequest{}).
+		Returns(0, "OK", &user.Password{}))
+
+	ws.Route(ws.POST("/activate").To(h.ActivateUser).
+		Doc("通过邀请邮件中的激活令牌设置密码和个人信息, 激活账号").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(user.ActivateUserRequest{}).
+		Returns(0, "OK", &user.User{}))
+
+	ws.Route(ws.POST("/verification").To(h.VerifyContact).
+		Doc("校验发送到邮箱或者手机的验证码, 验证码通过验证码接口以VERIFY_EMAIL或者VERIFY_PHONE用途申请").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(user.VerifyContactRequest{}).
 		Returns(0, "OK", &user.User{}))
+
+	ws.Route(ws.GET("/avatar/{id}").To(h.DownloadAvatar).
+		Doc("下载头像, 地址见用户profile的avatar").
+		Param(ws.PathParameter("id", "头像Id").DataType("string")).
+		Param(ws.QueryParameter("size", "缩略图尺寸: 48/128/256").DataType("integer")).
+		Produces("image/png").
+		Metadata(restfulspec.KeyOpenAPITags, tags))
 }
 
 func (h *sub) UpdatePassword(r *restful.Request, w *restful.Response) {
@@ -49,7 +78,6 @@ func (h *sub) UpdatePassword(r *restful.Request, w *restful.Response) {
 		return
 	}
 
-	req.UserId = r.PathParameter("id")
 	set, err := h.service.UpdatePassword(r.Request.Context(), req)
 	if err != nil {
 		response.Failed(w, err)
@@ -59,6 +87,75 @@ func (h *sub) UpdatePassword(r *restful.Request, w *restful.Response) {
 	response.Success(w, set)
 }
 
+func (h *sub) ActivateUser(r *restful.Request, w *restful.Response) {
+	req := user.NewActivateUserRequest()
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	ins, err := h.service.ActivateUser(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, ins)
+}
+
+func (h *sub) VerifyContact(r *restful.Request, w *restful.Response) {
+	req := user.NewVerifyContactRequest("", user.CONTACT_TYPE_MAIL, "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	ins, err := h.service.VerifyContact(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, ins)
+}
+
+func (h *sub) DownloadAvatar(r *restful.Request, w *restful.Response) {
+	// 先写入缓冲区, 下载失败时仍然可以返回错误信息
+	buf := bytes.NewBuffer(nil)
+	req, err := user.NewDownloadAvatarRequestFromHTTP(r.Request, r.PathParameter("id"), buf)
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest(err.Error()))
+		return
+	}
+	if err := h.service.DownloadAvatar(r.Request.Context(), req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	// 每次上传头像的Id都不同, 可以长期缓存
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	if _, err := io.Copy(w, buf); err != nil {
+		h.log.Errorf("write avatar error, %s", err)
+	}
+}
+
+func (h *sub) RecoverPassword(r *restful.Request, w *restful.Response) {
+	req := user.NewRecoverPasswordRequest()
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	set, err := h.service.RecoverPassword(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, set)
+}
+
 func init() {
 	app.RegistryRESTfulApp(&sub{})
 }
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"io"
+
 	restfulspec "github.com/emicklei/go-restful-openapi/v2"
 	"github.com/emicklei/go-restful/v3"
 	"github.com/infraboard/mcube/app"
@@ -41,8 +43,15 @@ func (h *primary) Registry(ws *restful.WebService) {
 		Reads(user.CreateUserRequest{}).
 		Returns(200, "创建成功", &user.User{}))
 
+	ws.Route(ws.POST("/invitation").To(h.InviteUser).
+		Doc("邀请子账号, 用户通过邮件中的激活链接设置密码").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(user.InviteUserRequest{}).
+		Returns(200, "邀请成功", &user.User{}))
+
 	ws.Route(ws.GET("/").To(h.QueryUser).
 		Doc("查询子账号列表").
+		Param(ws.QueryParameter("password_breached", "true时查询当前密码命中泄露密码黑名单的用户").DataType("boolean")).
 		Metadata(restfulspec.KeyOpenAPITags, tags).
 		Returns(200, "OK", user.UserSet{}))
 
@@ -71,9 +80,71 @@ func (h *primary) Registry(ws *restful.WebService) {
 		Metadata(restfulspec.KeyOpenAPITags, tags))
 
 	ws.Route(ws.POST("/{id}/password").To(h.ResetPassword).
-		Doc("重置子账号密码").
+		Doc("重置子账号密码, 未指定新密码时生成临时密码, 用户下次登录时需要修改").
 		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
-		Metadata(restfulspec.KeyOpenAPITags, tags))
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(user.ResetPasswordRequest{}).
+		Returns(0, "OK", &user.Password{}))
+
+	ws.Route(ws.POST("/{id}/invitation").To(h.ResendInvitation).
+		Doc("重新发送激活链接, 之前发送的链接失效").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(user.ResendInvitationRequest{}).
+		Returns(0, "OK", &user.User{}))
+
+	ws.Route(ws.DELETE("/{id}/invitation").To(h.RevokeInvitation).
+		Doc("撤销邀请, 删除未激活的子账号").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(0, "OK", &user.User{}))
+
+	ws.Route(ws.PUT("/{id}/status").To(h.UpdateUserStatus).
+		Doc("冻结/解冻子账号, 冻结后用户的令牌全部失效").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(user.UpdateUserStatusRequest{}).
+		Returns(0, "OK", &user.User{}))
+
+	ws.Route(ws.PUT("/{id}/state").To(h.ChangeUserState).
+		Doc("修改子账号的生命周期状态和生效时间, 用户不可用时令牌全部失效").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(user.ChangeUserStateRequest{}).
+		Returns(0, "OK", &user.User{}))
+
+	ws.Route(ws.POST("/{id}/domains").To(h.AddDomainMember).
+		Doc("把子账号加入其他域, 已经是成员时修改子账号在该域中的类型").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(user.AddDomainMemberRequest{}).
+		Returns(0, "OK", &user.User{}))
+
+	ws.Route(ws.DELETE("/{id}/domains/{domain}").To(h.RemoveDomainMember).
+		Doc("把子账号移出其他域, 子账号在该域中的令牌全部失效").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Param(ws.PathParameter("domain", "域名称").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(0, "OK", &user.User{}))
+
+	ws.Route(ws.GET("/{id}/status_events").To(h.QueryStatusEvent).
+		Doc("查询子账号的状态变更记录").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(0, "OK", &user.StatusEventSet{}))
+
+	ws.Route(ws.PUT("/{id}/avatar").To(h.UploadAvatar).
+		Doc("上传子账号头像, 请求体为图片内容, 支持png/jpeg/gif, 上传后替换之前的头像").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Consumes("image/png", "image/jpeg", "image/gif", "application/octet-stream").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(0, "OK", &user.User{}))
+
+	ws.Route(ws.DELETE("/{id}/avatar").To(h.DeleteAvatar).
+		Doc("删除子账号上传的头像").
+		Param(ws.PathParameter("id", "identifier of the user").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(0, "OK", &user.User{}))
 }
 
 func (h *primary) CreateUser(r *restful.Request, w *restful.Response) {
@@ -125,7 +196,8 @@ func (h *primary) PatchUser(r *restful.Request, w *restful.Response) {
 
 func (h *primary) ResetPassword(r *restful.Request, w *restful.Response) {
 	req := user.NewResetPasswordRequest()
-	if err := r.ReadEntity(req); err != nil {
+	// 允许不传请求体, 由系统生成临时密码
+	if err := r.ReadEntity(req); err != nil && err != io.EOF {
 		response.Failed(w, err)
 		return
 	}
@@ -139,6 +211,48 @@ func (h *primary) ResetPassword(r *restful.Request, w *restful.Response) {
 	response.Success(w, set)
 }
 
+func (h *primary) InviteUser(r *restful.Request, w *restful.Response) {
+	req := user.NewInviteUserRequest()
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	ins, err := h.service.InviteUser(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *primary) ResendInvitation(r *restful.Request, w *restful.Response) {
+	req := user.NewResendInvitationRequest("")
+	// 允许不传请求体, 使用默认的有效时间
+	if err := r.ReadEntity(req); err != nil && err != io.EOF {
+		response.Failed(w, err)
+		return
+	}
+	req.UserId = r.PathParameter("id")
+
+	ins, err := h.service.ResendInvitation(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *primary) RevokeInvitation(r *restful.Request, w *restful.Response) {
+	req := user.NewRevokeInvitationRequest(r.PathParameter("id"))
+	ins, err := h.service.RevokeInvitation(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
 func (h *primary) DeleteUser(r *restful.Request, w *restful.Response) {
 	req := user.NewDeleteUserRequest()
 	req.UserIds = append(req.UserIds, r.PathParameter("id"))
@@ -173,6 +287,96 @@ func (h *primary) DescribeUser(r *restful.Request, w *restful.Response) {
 	response.Success(w, ins)
 }
 
+func (h *primary) UpdateUserStatus(r *restful.Request, w *restful.Response) {
+	req := user.NewUpdateUserStatusRequest("")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.UserId = r.PathParameter("id")
+
+	ins, err := h.service.UpdateUserStatus(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *primary) AddDomainMember(r *restful.Request, w *restful.Response) {
+	req := user.NewAddDomainMemberRequest("", "", user.TYPE_SUB)
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.UserId = r.PathParameter("id")
+
+	ins, err := h.service.AddDomainMember(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *primary) RemoveDomainMember(r *restful.Request, w *restful.Response) {
+	req := user.NewRemoveDomainMemberRequest(r.PathParameter("id"), r.PathParameter("domain"))
+	ins, err := h.service.RemoveDomainMember(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *primary) ChangeUserState(r *restful.Request, w *restful.Response) {
+	req := user.NewChangeUserStateRequest("", user.STATE_ACTIVE, "")
+	if err := r.ReadEntity(req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.UserId = r.PathParameter("id")
+
+	ins, err := h.service.ChangeUserState(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *primary) QueryStatusEvent(r *restful.Request, w *restful.Response) {
+	req := user.NewQueryStatusEventRequestFromHTTP(r.Request)
+	req.UserId = r.PathParameter("id")
+
+	set, err := h.service.QueryStatusEvent(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+func (h *primary) UploadAvatar(r *restful.Request, w *restful.Response) {
+	req := user.NewUploadAvatarRequest(r.PathParameter("id"), r.Request.Body)
+	ins, err := h.service.UploadAvatar(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *primary) DeleteAvatar(r *restful.Request, w *restful.Response) {
+	req := user.NewDeleteAvatarRequest(r.PathParameter("id"))
+	ins, err := h.service.DeleteAvatar(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
 func init() {
 	app.RegistryRESTfulApp(&primary{})
 }
@@ -13,7 +13,8 @@ import (
 	request "github.com/infraboard/mcube/http/request"
 	pb_request "github.com/infraboard/mcube/pb/request"
 	"github.com/rs/xid"
-	"golang.org/x/crypto/bcrypt"
+
+	"github.com/infraboard/mcenter/apps/user/hasher"
 )
 
 const (
@@ -41,25 +42,29 @@ func New(req *CreateUserRequest) (*User, error) {
 		CreateAt:      time.Now().UnixMilli(),
 		Spec:          req,
 		Password:      pass,
-		Profile:       &Profile{},
+		Profile:       &Profile{Attributes: req.Attributes},
 		IsInitialized: false,
 		Status: &Status{
 			Locked: false,
 		},
 	}
+	// 自定义属性只保存在profile中
+	u.Spec.Attributes = nil
 
 	return u, nil
 }
 
-// NewHashedPassword 生产hash后的密码对象
+// NewHashedPassword 生产hash后的密码对象, 使用当前默认的hash算法
 func NewHashedPassword(password string) (*Password, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), 10)
+	h := hasher.Default()
+	hash, err := h.Hash(password)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Password{
-		Password:      string(bytes),
+		Password:      hash,
+		HashAlgorithm: h.Algorithm(),
 		CreateAt:      time.Now().UnixMilli(),
 		UpdateAt:      time.Now().UnixMilli(),
 		ExpiredDays:   90,
@@ -72,6 +77,12 @@ func (req *CreateUserRequest) Validate() error {
 	return validate.Struct(req)
 }
 
+// IsExternal 账号来自外部身份源(LDAP, 域的认证源), 登录或者同步时自动创建,
+// 自定义属性由管理员后续补充, 因此不强制要求必填属性
+func (req *CreateUserRequest) IsExternal() bool {
+	return !req.Provider.Equal(PROVIDER_LOCAL) || req.AuthSource != ""
+}
+
 // SetNeedReset 需要被重置
 func (p *Password) SetNeedReset(format string, a ...interface{}) {
 	p.NeedReset = true
@@ -87,7 +98,7 @@ func NewLDAPCreateUserRequest(domain, username, password, descriptoin string) *C
 	return &CreateUserRequest{
 		Provider:    PROVIDER_LDAP,
 		Type:        TYPE_SUB,
-		CreateBy:    CREATE_BY_ADMIN,
+		CreateBy:    CREATE_BY_SYNC,
 		Domain:      domain,
 		Username:    username,
 		Password:    password,
@@ -103,19 +114,31 @@ func NewQueryUserRequestFromHTTP(r *http.Request) *QueryUserRequest {
 	query.Page = request.NewPageRequestFromHTTP(r)
 	query.Keywords = qs.Get("keywords")
 	query.SkipItems = qs.Get("skip_items") == "true"
+	if v := qs.Get("password_breached"); v != "" {
+		breached := v == "true"
+		query.PasswordBreached = &breached
+	}
 
 	uids := qs.Get("user_ids")
 	if uids != "" {
 		query.UserIds = strings.Split(uids, ",")
 	}
+
+	// 自定义属性过滤, 比如: attr.department=ops
+	for k := range qs {
+		if name := strings.TrimPrefix(k, ATTRIBUTE_QUERY_PREFIX); name != k && name != "" {
+			query.Attributes[name] = qs.Get(k)
+		}
+	}
 	return query
 }
 
 // NewQueryUserRequest 列表查询请求
 func NewQueryUserRequest() *QueryUserRequest {
 	return &QueryUserRequest{
-		Page:      request.NewPageRequest(20, 1),
-		SkipItems: false,
+		Page:       request.NewPageRequest(20, 1),
+		SkipItems:  false,
+		Attributes: map[string]string{},
 	}
 }
 
@@ -135,6 +158,31 @@ func NewDescriptUserRequestWithName(username string) *DescribeUserRequest {
 	}
 }
 
+// NewDescriptUserRequestWithDomainName 查询域内的用户
+func NewDescriptUserRequestWithDomainName(domain, username string) *DescribeUserRequest {
+	return &DescribeUserRequest{
+		DescribeBy: DESCRIBE_BY_USER_NAME,
+		Domain:     domain,
+		Username:   username,
+	}
+}
+
+// NewDescriptDomainMemberRequest 查询域内的用户, 包含从其他域加入该域的成员
+func NewDescriptDomainMemberRequest(domain, username string) *DescribeUserRequest {
+	req := NewDescriptUserRequestWithDomainName(domain, username)
+	req.WithMember = true
+	return req
+}
+
+// NewDescriptUserRequestWithEmail 通过邮箱查询域内的用户
+func NewDescriptUserRequestWithEmail(domain, email string) *DescribeUserRequest {
+	return &DescribeUserRequest{
+		DescribeBy: DESCRIBE_BY_EMAIL,
+		Domain:     domain,
+		Email:      email,
+	}
+}
+
 // NewPatchAccountRequest todo
 func NewPutUserRequest(userId string) *UpdateUserRequest {
 	return &UpdateUserRequest{
@@ -164,17 +212,146 @@ func NewDeleteUserRequest() *DeleteUserRequest {
 	}
 }
 
+func NewUpdateUserStatusRequest(userId string) *UpdateUserStatusRequest {
+	return &UpdateUserStatusRequest{
+		UserId: userId,
+	}
+}
+
+func (req *UpdateUserStatusRequest) Validate() error {
+	return validate.Struct(req)
+}
+
 func NewResetPasswordRequest() *ResetPasswordRequest {
 	return &ResetPasswordRequest{}
 }
 
+func (req *ResetPasswordRequest) Validate() error {
+	if req.UserId == "" {
+		return fmt.Errorf("user_id required")
+	}
+	return nil
+}
+
+func NewUpgradePasswordHashRequest(userId, password string) *UpgradePasswordHashRequest {
+	return &UpgradePasswordHashRequest{
+		UserId:   userId,
+		Password: password,
+	}
+}
+
+func (req *UpgradePasswordHashRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewMarkPasswordBreachedRequest(userId string, breached bool, reason string) *MarkPasswordBreachedRequest {
+	return &MarkPasswordBreachedRequest{
+		UserId:   userId,
+		Breached: breached,
+		Reason:   reason,
+	}
+}
+
+func (req *MarkPasswordBreachedRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewRecoverPasswordRequest() *RecoverPasswordRequest {
+	return &RecoverPasswordRequest{}
+}
+
+func (req *RecoverPasswordRequest) Validate() error {
+	if req.Username == "" && req.Email == "" {
+		return fmt.Errorf("username or email required")
+	}
+	return validate.Struct(req)
+}
+
+// DescribeUserRequest 找回密码的用户
+func (req *RecoverPasswordRequest) DescribeUserRequest() *DescribeUserRequest {
+	if req.Email != "" {
+		return NewDescriptUserRequestWithEmail(req.Domain, req.Email)
+	}
+	return NewDescriptUserRequestWithDomainName(req.Domain, req.Username)
+}
+
+func NewInviteUserRequest() *InviteUserRequest {
+	return &InviteUserRequest{}
+}
+
+func (req *InviteUserRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// CreateUserRequest 被邀请的用户, 使用随机密码创建, 激活时由用户自己设置
+func (req *InviteUserRequest) CreateUserRequest(password string) *CreateUserRequest {
+	return &CreateUserRequest{
+		Provider:    PROVIDER_LOCAL,
+		Type:        TYPE_SUB,
+		CreateBy:    CREATE_BY_ADMIN,
+		Domain:      req.Domain,
+		Username:    req.Username,
+		Password:    password,
+		Description: req.Description,
+		Attributes:  req.Attributes,
+	}
+}
+
+func NewResendInvitationRequest(userId string) *ResendInvitationRequest {
+	return &ResendInvitationRequest{
+		UserId: userId,
+	}
+}
+
+func (req *ResendInvitationRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewRevokeInvitationRequest(userId string) *RevokeInvitationRequest {
+	return &RevokeInvitationRequest{
+		UserId: userId,
+	}
+}
+
+func (req *RevokeInvitationRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewActivateUserRequest() *ActivateUserRequest {
+	return &ActivateUserRequest{
+		Profile: NewProfile(),
+	}
+}
+
+func (req *ActivateUserRequest) Validate() error {
+	return validate.Struct(req)
+}
+
 func NewUpdatePasswordRequest() *UpdatePasswordRequest {
 	return &UpdatePasswordRequest{}
 }
 
+func (req *UpdatePasswordRequest) Validate() error {
+	if req.UserId == "" && (req.Domain == "" || req.Username == "") {
+		return fmt.Errorf("user_id or domain and username required")
+	}
+	if req.OldPass == "" || req.NewPass == "" {
+		return fmt.Errorf("old_pass and new_pass required")
+	}
+	return nil
+}
+
+// DescribeUserRequest 修改密码的用户
+func (req *UpdatePasswordRequest) DescribeUserRequest() *DescribeUserRequest {
+	if req.UserId != "" {
+		return NewDescriptUserRequestWithId(req.UserId)
+	}
+	return NewDescriptUserRequestWithDomainName(req.Domain, req.Username)
+}
+
 // CheckPassword 判断password 是否正确
 func (p *Password) CheckPassword(password string) error {
-	err := bcrypt.CompareHashAndPassword([]byte(p.Password), []byte(password))
+	err := hasher.Verify(p.Password, password)
 	if err != nil {
 		return exception.NewUnauthorized("user or password not connrect")
 	}
@@ -182,6 +359,62 @@ func (p *Password) CheckPassword(password string) error {
 	return nil
 }
 
+// IsReused 判断密码是否和最近使用过的密码重复, limit为检查的历史密码个数(包含当前密码), 0表示不检查
+func (p *Password) IsReused(password string, limit uint) bool {
+	if limit == 0 {
+		return false
+	}
+	if p.CheckPassword(password) == nil {
+		return true
+	}
+	for i := 0; i < len(p.History) && uint(i) < limit-1; i++ {
+		if hasher.Verify(p.History[i], password) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Change 生成新的密码, 当前密码记入历史, 历史密码最多保留historyLimit个
+func (p *Password) Change(password string, historyLimit uint) (*Password, error) {
+	pass, err := NewHashedPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	pass.ExpiredDays = p.ExpiredDays
+	pass.ExpiredRemind = p.ExpiredRemind
+
+	history := append([]string{p.Password}, p.History...)
+	if uint(len(history)) > historyLimit {
+		history = history[:historyLimit]
+	}
+	pass.History = history
+	return pass, nil
+}
+
+// NeedRehash 密码的hash算法或者参数已经过时, 需要重新计算
+func (p *Password) NeedRehash() bool {
+	return hasher.NeedRehash(p.HashAlgorithm, p.Password)
+}
+
+// Rehash 使用当前默认的算法重新计算hash, 不影响密码的过期时间
+func (p *Password) Rehash(password string) error {
+	h := hasher.Default()
+	hash, err := h.Hash(password)
+	if err != nil {
+		return err
+	}
+	p.Password = hash
+	p.HashAlgorithm = h.Algorithm()
+	return nil
+}
+
+// Desensitize 去除hash过的密码和历史密码
+func (p *Password) Desensitize() {
+	p.Password = ""
+	p.History = []string{}
+}
+
 // CheckPasswordExpired 检测password是否已经过期
 // remindDays 提前多少天提醒用户修改密码
 // expiredDays 多少天后密码过期
@@ -239,19 +472,94 @@ func NewDefaultUser() *User {
 // Desensitize 关键数据脱敏
 func (u *User) Desensitize() {
 	if u.Password != nil {
-		u.Password.Password = ""
-		u.Password.History = []string{}
+		u.Password.Desensitize()
+	}
+}
+
+// IsLocked 用户是否被冻结
+func (u *User) IsLocked() bool {
+	return u.Status != nil && u.Status.Locked
+}
+
+// IsPendingActivation 通过邀请创建, 还未激活的用户
+func (u *User) IsPendingActivation() bool {
+	return u.Invitation != nil && u.Invitation.ActivatedAt == 0
+}
+
+// Lock 冻结用户
+func (u *User) Lock(reason string) {
+	if u.Status == nil {
+		u.Status = &Status{}
+	}
+	u.Status.Locked = true
+	u.Status.LockedTime = time.Now().UnixMilli()
+	u.Status.LockedReson = reason
+	u.Status.UnlockTime = 0
+}
+
+// Unlock 解冻用户
+func (u *User) Unlock() {
+	if u.Status == nil {
+		u.Status = &Status{}
 	}
+	u.Status.Locked = false
+	u.Status.LockedReson = ""
+	u.Status.UnlockTime = time.Now().UnixMilli()
 }
 
 func (i *User) Update(req *UpdateUserRequest) {
-	i.UpdateAt = time.Now().UnixMicro()
+	email, phone := i.Contact(CONTACT_TYPE_MAIL), i.Contact(CONTACT_TYPE_PHONE)
+	i.UpdateAt = time.Now().UnixMilli()
 	i.Profile = req.Profile
+	i.resetChangedContact(email, phone)
 }
 
 func (i *User) Patch(req *UpdateUserRequest) error {
-	i.UpdateAt = time.Now().UnixMicro()
-	return mergo.MergeWithOverwrite(i.Profile, req.Profile)
+	email, phone := i.Contact(CONTACT_TYPE_MAIL), i.Contact(CONTACT_TYPE_PHONE)
+	i.UpdateAt = time.Now().UnixMilli()
+	if i.Profile == nil {
+		i.Profile = NewProfile()
+	}
+	attrs := map[string]string{}
+	for k, v := range i.Profile.Attributes {
+		attrs[k] = v
+	}
+	if err := mergo.MergeWithOverwrite(i.Profile, req.Profile); err != nil {
+		return err
+	}
+	// 自定义属性按照key合并, 空值表示清除该属性(mergo会忽略空值)
+	if req.Profile != nil {
+		for k, v := range req.Profile.Attributes {
+			attrs[k] = v
+		}
+	}
+	i.Profile.Attributes = attrs
+	i.resetChangedContact(email, phone)
+	return nil
+}
+
+// MergeActivateProfile 合并被邀请人激活时填写的个人信息
+// 邀请时管理员设置的自定义属性不允许被邀请人修改, 只能补充未设置的属性
+func (i *User) MergeActivateProfile(p *Profile) error {
+	if i.Profile == nil {
+		i.Profile = NewProfile()
+	}
+	if p == nil {
+		return nil
+	}
+
+	attrs := map[string]string{}
+	for k, v := range p.Attributes {
+		attrs[k] = v
+	}
+	for k, v := range i.Profile.Attributes {
+		attrs[k] = v
+	}
+	if err := mergo.MergeWithOverwrite(i.Profile, p); err != nil {
+		return err
+	}
+	i.Profile.Attributes = attrs
+	return nil
 }
 
 func SpliteUserAndDomain(username string) (string, string) {
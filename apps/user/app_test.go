@@ -0,0 +1,257 @@
+package user_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/infraboard/mcenter/apps/user"
+	"github.com/infraboard/mcenter/apps/user/hasher"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordChange(t *testing.T) {
+	should := assert.New(t)
+
+	p, err := user.NewHashedPassword("pass-1")
+	should.NoError(err)
+	should.True(p.IsReused("pass-1", 1))
+	should.False(p.IsReused("pass-1", 0))
+
+	// 历史保留2个, 最近3个密码(包含当前)不允许重复
+	for _, pass := range []string{"pass-2", "pass-3"} {
+		p, err = p.Change(pass, 2)
+		should.NoError(err)
+	}
+	should.Len(p.History, 2)
+	should.True(p.IsReused("pass-3", 3))
+	should.True(p.IsReused("pass-1", 3))
+	should.False(p.IsReused("pass-1", 2))
+
+	p, err = p.Change("pass-4", 2)
+	should.NoError(err)
+	should.False(p.IsReused("pass-1", 3))
+	should.True(p.IsReused("pass-2", 3))
+}
+
+func TestPasswordRehash(t *testing.T) {
+	should := assert.New(t)
+	defer hasher.SetDefault(hasher.Default())
+
+	hasher.SetDefault(hasher.NewBcrypt(4))
+	p, err := user.NewHashedPassword("pass-1")
+	should.NoError(err)
+	should.Equal(hasher.ALGORITHM_BCRYPT, p.HashAlgorithm)
+	should.False(p.NeedRehash())
+
+	hasher.SetDefault(hasher.NewArgon2id(&hasher.Argon2Params{Time: 1, Memory: 1024, Threads: 1}))
+	should.True(p.NeedRehash())
+	updateAt := p.UpdateAt
+	should.NoError(p.Rehash("pass-1"))
+	should.Equal(hasher.ALGORITHM_ARGON2ID, p.HashAlgorithm)
+	should.Equal(updateAt, p.UpdateAt)
+	should.False(p.NeedRehash())
+	should.NoError(p.CheckPassword("pass-1"))
+}
+
+func TestInvitationToken(t *testing.T) {
+	should := assert.New(t)
+
+	inv := user.NewInvitation("admin", "invitee@example.com")
+	inv.Renew(time.Hour)
+	token := inv.Sign("user-1", "key")
+
+	tk, err := user.ParseInvitationToken(token, "key")
+	should.NoError(err)
+	should.Equal("user-1", tk.UserId)
+	should.Equal(inv.Nonce, tk.Nonce)
+	should.False(tk.IsExpired())
+
+	// 密钥不一致或者令牌被篡改
+	_, err = user.ParseInvitationToken(token, "other")
+	should.Error(err)
+	_, err = user.ParseInvitationToken("x"+token, "key")
+	should.Error(err)
+
+	// 重新发送后随机数变化
+	nonce := inv.Nonce
+	inv.Renew(time.Hour)
+	should.NotEqual(nonce, inv.Nonce)
+	should.Equal(int32(2), inv.SendCount)
+
+	inv.Renew(-time.Minute)
+	tk, err = user.ParseInvitationToken(inv.Sign("user-1", "key"), "key")
+	should.NoError(err)
+	should.True(tk.IsExpired())
+}
+
+func TestContactVerification(t *testing.T) {
+	should := assert.New(t)
+
+	u := user.NewDefaultUser()
+	u.Profile = user.NewProfile()
+	u.Profile.Email = "alice@example.com"
+	u.Profile.Phone = "13800000000"
+	should.False(u.IsVerified(user.CONTACT_TYPE_MAIL))
+
+	u.SetVerified(user.CONTACT_TYPE_MAIL)
+	u.SetVerified(user.CONTACT_TYPE_PHONE)
+	should.True(u.IsVerified(user.CONTACT_TYPE_MAIL))
+	should.True(u.IsVerified(user.CONTACT_TYPE_PHONE))
+
+	// 修改邮箱后需要重新验证, 手机不受影响
+	req := user.NewPatchUserRequest("")
+	req.Profile.Email = "bob@example.com"
+	should.NoError(u.Patch(req))
+	should.False(u.IsVerified(user.CONTACT_TYPE_MAIL))
+	should.Zero(u.Verification.EmailVerifiedAt)
+	should.True(u.IsVerified(user.CONTACT_TYPE_PHONE))
+
+	// 全量更新时清空手机
+	req = user.NewPutUserRequest("")
+	req.Profile.Email = "bob@example.com"
+	u.Update(req)
+	should.False(u.IsVerified(user.CONTACT_TYPE_PHONE))
+}
+
+func TestPatchAttributes(t *testing.T) {
+	should := assert.New(t)
+
+	u := user.NewDefaultUser()
+	u.Profile = user.NewProfile()
+	u.Profile.Attributes = map[string]string{"employee_id": "E1001", "department": "ops"}
+
+	// 按照属性名合并, 空值保留到校验时清除
+	req := user.NewPatchUserRequest("")
+	req.Profile.Attributes = map[string]string{"department": "", "level": "3"}
+	should.NoError(u.Patch(req))
+	should.Equal(map[string]string{"employee_id": "E1001", "department": "", "level": "3"}, u.Profile.Attributes)
+}
+
+func TestMergeActivateProfile(t *testing.T) {
+	should := assert.New(t)
+
+	u := user.NewDefaultUser()
+	u.Profile = user.NewProfile()
+	u.Profile.Attributes = map[string]string{"employee_id": "E1001"}
+
+	// 管理员设置的属性保留, 被邀请人只能补充未设置的属性
+	p := user.NewProfile()
+	p.RealName = "张三"
+	p.Attributes = map[string]string{"employee_id": "E9999", "level": "3"}
+	should.NoError(u.MergeActivateProfile(p))
+	should.Equal("张三", u.Profile.RealName)
+	should.Equal(map[string]string{"employee_id": "E1001", "level": "3"}, u.Profile.Attributes)
+}
+
+func TestCheckActive(t *testing.T) {
+	should := assert.New(t)
+
+	u := user.NewDefaultUser()
+	u.Id = "alice-id"
+	u.Spec = user.NewCreateUserRequest()
+	u.Spec.Username = "alice"
+	should.True(u.IsActive())
+
+	// 外包人员的生效时间
+	now := time.Now()
+	req := user.NewChangeUserStateRequest(u.Id, user.STATE_ACTIVE, "contractor")
+	req.ActiveFrom = now.Add(time.Hour).UnixMilli()
+	should.NoError(req.Validate())
+	u.ChangeState(req)
+	should.False(u.IsActive())
+
+	req.ActiveFrom = now.Add(-time.Hour).UnixMilli()
+	req.ActiveUntil = now.Add(-time.Minute).UnixMilli()
+	u.ChangeState(req)
+	should.False(u.IsActive())
+
+	req.ActiveUntil = now.Add(time.Hour).UnixMilli()
+	u.ChangeState(req)
+	should.True(u.IsActive())
+
+	req.ActiveFrom, req.ActiveUntil = req.ActiveUntil, req.ActiveFrom
+	should.Error(req.Validate())
+
+	// 状态变更和冻结
+	from := u.CloneStatus()
+	u.ChangeState(user.NewChangeUserStateRequest(u.Id, user.STATE_SUSPENDED, "leave"))
+	should.False(u.IsActive())
+	event := user.NewStatusEvent(u, user.STATUS_EVENT_TYPE_STATE_CHANGE, from, "leave", "")
+	should.Equal(user.STATE_ACTIVE, event.From.State)
+	should.Equal(user.STATE_SUSPENDED, event.To.State)
+	should.Equal(user.SYSTEM_OPERATOR, event.Operator)
+
+	u.ChangeState(user.NewChangeUserStateRequest(u.Id, user.STATE_ACTIVE, ""))
+	should.True(u.IsActive())
+	u.Lock(user.LOCK_REASON_DORMANT)
+	should.False(u.IsActive())
+}
+
+func TestAvatar(t *testing.T) {
+	should := assert.New(t)
+
+	// 左半边红色, 右半边蓝色的长方形图片
+	src := image.NewRGBA(image.Rect(0, 0, 300, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 300; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 150 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			src.Set(x, y, c)
+		}
+	}
+	buf := bytes.NewBuffer(nil)
+	should.NoError(png.Encode(buf, src))
+
+	img, err := user.NewUploadAvatarRequest("alice-id", buf).Decode()
+	if should.NoError(err) {
+		dst := user.ResizeAvatar(img, 48)
+		should.Equal(48, dst.Bounds().Dx())
+		should.Equal(48, dst.Bounds().Dy())
+		should.Equal(color.RGBA{R: 255, A: 255}, dst.RGBAAt(0, 24))
+		should.Equal(color.RGBA{B: 255, A: 255}, dst.RGBAAt(47, 24))
+	}
+
+	_, err = user.NewUploadAvatarRequest("alice-id", strings.NewReader("not a image")).Decode()
+	should.Error(err)
+	_, err = user.NewUploadAvatarRequest("alice-id", bytes.NewReader(make([]byte, user.AVATAR_MAX_BYTES+1))).Decode()
+	should.Error(err)
+}
+
+func TestDomainMembership(t *testing.T) {
+	should := assert.New(t)
+
+	u := user.NewDefaultUser()
+	u.Spec = user.NewCreateUserRequest()
+	u.Spec.Domain = "default"
+
+	should.Error(user.NewAddDomainMemberRequest("alice-id", "customer01", user.TYPE_SUPPER).Validate())
+	req := user.NewAddDomainMemberRequest("alice-id", "customer01", user.TYPE_PRIMARY)
+	should.NoError(req.Validate())
+	u.AddMembership(req)
+	should.Equal([]string{"default", "customer01"}, u.AvailableDomains())
+
+	t1, ok := u.DomainType("customer01")
+	should.True(ok)
+	should.Equal(user.TYPE_PRIMARY, t1)
+	t2, ok := u.DomainType("default")
+	should.True(ok)
+	should.Equal(user.TYPE_SUB, t2)
+	_, ok = u.DomainType("customer02")
+	should.False(ok)
+
+	// 重复加入只修改类型
+	u.AddMembership(user.NewAddDomainMemberRequest("alice-id", "customer01", user.TYPE_SUB))
+	should.Len(u.Memberships, 1)
+	should.Equal(user.TYPE_SUB, u.Memberships[0].Type)
+
+	should.True(u.RemoveMembership("customer01"))
+	should.False(u.RemoveMembership("customer01"))
+	should.Equal([]string{"default"}, u.AvailableDomains())
+}
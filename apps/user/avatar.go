@@ -0,0 +1,215 @@
+package user
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"net/http"
+	"strconv"
+
+	// 支持的头像图片格式
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+const (
+	// 头像保存的bucket
+	AVATAR_BUCKET = "avatar"
+	// 头像文件的最大大小
+	AVATAR_MAX_BYTES = 2 << 20
+	// 头像图片的最大像素, 避免解码超大图片占用内存
+	AVATAR_MAX_PIXELS = 4096 * 4096
+	// 下载时未指定尺寸使用的尺寸
+	AVATAR_DEFAULT_SIZE = 128
+)
+
+var (
+	// AVATAR_SIZES 上传后生成的标准缩略图尺寸
+	AVATAR_SIZES = []int{48, 128, 256}
+	// AVATAR_CONTENT_TYPES 允许上传的图片格式
+	AVATAR_CONTENT_TYPES = []string{"image/png", "image/jpeg", "image/gif"}
+)
+
+// IsAvatarSize 是否是标准的缩略图尺寸
+func IsAvatarSize(size int) bool {
+	for _, s := range AVATAR_SIZES {
+		if s == size {
+			return true
+		}
+	}
+	return false
+}
+
+// AvatarFileID 头像不同尺寸在storage中的文件Id
+func AvatarFileID(avatarId string, size int) string {
+	return fmt.Sprintf("%s_%d", avatarId, size)
+}
+
+// NewUploadAvatarRequest 上传头像, reader为图片内容
+func NewUploadAvatarRequest(userId string, reader io.Reader) *UploadAvatarRequest {
+	return &UploadAvatarRequest{
+		UserId: userId,
+		reader: reader,
+	}
+}
+
+// UploadAvatarRequest 上传头像请求
+type UploadAvatarRequest struct {
+	UserId string
+
+	reader io.Reader
+}
+
+func (req *UploadAvatarRequest) Validate() error {
+	if req.UserId == "" {
+		return fmt.Errorf("user id required")
+	}
+	if req.reader == nil {
+		return fmt.Errorf("avatar file reader is nil")
+	}
+	return nil
+}
+
+// Decode 读取并解码头像图片, 检查大小, 格式和像素
+func (req *UploadAvatarRequest) Decode() (image.Image, error) {
+	b, err := io.ReadAll(io.LimitReader(req.reader, AVATAR_MAX_BYTES+1))
+	if err != nil {
+		return nil, fmt.Errorf("read avatar error, %s", err)
+	}
+	if len(b) > AVATAR_MAX_BYTES {
+		return nil, fmt.Errorf("avatar size must less than %d KB", AVATAR_MAX_BYTES>>10)
+	}
+
+	ct := http.DetectContentType(b)
+	if !isAvatarContentType(ct) {
+		return nil, fmt.Errorf("avatar content type %s not support, must be one of %v", ct, AVATAR_CONTENT_TYPES)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("decode avatar error, %s", err)
+	}
+	if cfg.Width*cfg.Height > AVATAR_MAX_PIXELS {
+		return nil, fmt.Errorf("avatar %dx%d too large", cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("decode avatar error, %s", err)
+	}
+	return img, nil
+}
+
+func isAvatarContentType(ct string) bool {
+	for _, t := range AVATAR_CONTENT_TYPES {
+		if t == ct {
+			return true
+		}
+	}
+	return false
+}
+
+// NewDeleteAvatarRequest 删除头像
+func NewDeleteAvatarRequest(userId string) *DeleteAvatarRequest {
+	return &DeleteAvatarRequest{
+		UserId: userId,
+	}
+}
+
+// DeleteAvatarRequest 删除头像请求
+type DeleteAvatarRequest struct {
+	UserId string
+}
+
+// NewDownloadAvatarRequest 下载头像, 图片写入writer
+func NewDownloadAvatarRequest(avatarId string, writer io.Writer) *DownloadAvatarRequest {
+	return &DownloadAvatarRequest{
+		AvatarId: avatarId,
+		Size:     AVATAR_DEFAULT_SIZE,
+		writer:   writer,
+	}
+}
+
+// NewDownloadAvatarRequestFromHTTP 通过size参数指定尺寸
+func NewDownloadAvatarRequestFromHTTP(r *http.Request, avatarId string, writer io.Writer) (*DownloadAvatarRequest, error) {
+	req := NewDownloadAvatarRequest(avatarId, writer)
+	if v := r.URL.Query().Get("size"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("size must be number")
+		}
+		req.Size = size
+	}
+	return req, nil
+}
+
+// DownloadAvatarRequest 下载头像请求
+type DownloadAvatarRequest struct {
+	AvatarId string
+	Size     int
+
+	writer io.Writer
+}
+
+func (req *DownloadAvatarRequest) Validate() error {
+	if req.AvatarId == "" {
+		return fmt.Errorf("avatar id required")
+	}
+	if !IsAvatarSize(req.Size) {
+		return fmt.Errorf("avatar size must be one of %v", AVATAR_SIZES)
+	}
+	return nil
+}
+
+// Writer todo
+func (req *DownloadAvatarRequest) Writer() io.Writer {
+	return req.writer
+}
+
+// ResizeAvatar 居中裁剪为正方形后缩放到指定尺寸, 缩小时取区域内像素的平均值
+func ResizeAvatar(src image.Image, size int) *image.RGBA {
+	b := src.Bounds()
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		sy0, sy1 := span(y, size, side)
+		for x := 0; x < size; x++ {
+			sx0, sx1 := span(x, size, side)
+
+			var r, g, bl, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					cr, cg, cb, ca := src.At(x0+sx, y0+sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(bl / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+	return dst
+}
+
+// span 目标像素i对应的原图区间, 至少包含一个像素
+func span(i, size, side int) (int, int) {
+	start := i * side / size
+	end := (i + 1) * side / size
+	if end <= start {
+		end = start + 1
+	}
+	return start, end
+}
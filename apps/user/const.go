@@ -3,3 +3,8 @@ package user
 const (
 	SYSTEM_INITAL_USERNAME = "system"
 )
+
+const (
+	// HTTP查询参数中自定义属性过滤的前缀
+	ATTRIBUTE_QUERY_PREFIX = "attr."
+)
@@ -0,0 +1,150 @@
+package hasher
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// NewDefaultArgon2Params RFC 9106推荐的低内存配置
+func NewDefaultArgon2Params() *Argon2Params {
+	return &Argon2Params{
+		Time:    3,
+		Memory:  64 * 1024,
+		Threads: 2,
+		KeyLen:  32,
+		SaltLen: 16,
+	}
+}
+
+// Argon2Params argon2id算法参数
+type Argon2Params struct {
+	// 迭代次数
+	Time uint32
+	// 内存大小, 单位KiB
+	Memory uint32
+	// 并行度
+	Threads uint8
+	// hash长度
+	KeyLen uint32
+	// 盐长度
+	SaltLen uint32
+}
+
+func (p *Argon2Params) equal(target *Argon2Params) bool {
+	return p.Time == target.Time &&
+		p.Memory == target.Memory &&
+		p.Threads == target.Threads &&
+		p.KeyLen == target.KeyLen &&
+		p.SaltLen == target.SaltLen
+}
+
+// NewArgon2id argon2id算法, 结果使用PHC格式编码: $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+func NewArgon2id(p *Argon2Params) Hasher {
+	d := NewDefaultArgon2Params()
+	if p == nil {
+		p = d
+	}
+	if p.Time == 0 {
+		p.Time = d.Time
+	}
+	if p.Memory == 0 {
+		p.Memory = d.Memory
+	}
+	if p.Threads == 0 {
+		p.Threads = d.Threads
+	}
+	if p.KeyLen == 0 {
+		p.KeyLen = d.KeyLen
+	}
+	if p.SaltLen == 0 {
+		p.SaltLen = d.SaltLen
+	}
+	return &argon2idHasher{params: p}
+}
+
+type argon2idHasher struct {
+	params *Argon2Params
+}
+
+func (h *argon2idHasher) Algorithm() string {
+	return ALGORITHM_ARGON2ID
+}
+
+func (h *argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	p := h.params
+	key := argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, p.KeyLen)
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		ALGORITHM_ARGON2ID,
+		argon2.Version,
+		p.Memory, p.Time, p.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h *argon2idHasher) Verify(hash, password string) error {
+	return verifyArgon2id(hash, password)
+}
+
+func (h *argon2idHasher) NeedRehash(hash string) bool {
+	p, _, _, err := decodeArgon2id(hash)
+	if err != nil {
+		return true
+	}
+	return !p.equal(h.params)
+}
+
+func verifyArgon2id(hash, password string) error {
+	p, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return err
+	}
+
+	other := argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, p.KeyLen)
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return fmt.Errorf("hashed password is not the hash of the given password")
+	}
+	return nil
+}
+
+func decodeArgon2id(hash string) (*Argon2Params, []byte, []byte, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != ALGORITHM_ARGON2ID {
+		return nil, nil, nil, fmt.Errorf("invalid argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid argon2id version, %s", err)
+	}
+	if version != argon2.Version {
+		return nil, nil, nil, fmt.Errorf("incompatible argon2 version %d", version)
+	}
+
+	p := &Argon2Params{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Time, &p.Threads); err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid argon2id params, %s", err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid argon2id salt, %s", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid argon2id hash, %s", err)
+	}
+	p.SaltLen = uint32(len(salt))
+	p.KeyLen = uint32(len(key))
+	return p, salt, key, nil
+}
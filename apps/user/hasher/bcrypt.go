@@ -0,0 +1,49 @@
+package hasher
+
+import (
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	DEFAULT_BCRYPT_COST = 10
+)
+
+// NewBcrypt bcrypt算法, cost不合法时使用默认值
+func NewBcrypt(cost int) Hasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = DEFAULT_BCRYPT_COST
+	}
+	return &bcryptHasher{cost: cost}
+}
+
+type bcryptHasher struct {
+	cost int
+}
+
+func (h *bcryptHasher) Algorithm() string {
+	return ALGORITHM_BCRYPT
+}
+
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	b, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (h *bcryptHasher) Verify(hash, password string) error {
+	return verifyBcrypt(hash, password)
+}
+
+func (h *bcryptHasher) NeedRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return true
+	}
+	return cost != h.cost
+}
+
+func verifyBcrypt(hash, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+}
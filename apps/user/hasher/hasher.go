@@ -0,0 +1,87 @@
+package hasher
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	// 历史数据没有记录算法, 均为bcrypt
+	ALGORITHM_BCRYPT   = "bcrypt"
+	ALGORITHM_ARGON2ID = "argon2id"
+)
+
+// Hasher 密码hash算法, 算法参数编码在hash结果中, 参数调整后可以识别出旧的hash
+type Hasher interface {
+	// 算法名称
+	Algorithm() string
+	// 使用当前参数计算hash
+	Hash(password string) (string, error)
+	// 校验密码, 使用hash中编码的参数
+	Verify(hash, password string) error
+	// hash使用的参数和当前参数不一致, 需要重新计算
+	NeedRehash(hash string) bool
+}
+
+var (
+	lock     sync.RWMutex
+	_default Hasher = NewBcrypt(DEFAULT_BCRYPT_COST)
+)
+
+// Default 新密码使用的hash算法
+func Default() Hasher {
+	lock.RLock()
+	defer lock.RUnlock()
+	return _default
+}
+
+// SetDefault 设置新密码使用的hash算法
+func SetDefault(h Hasher) {
+	lock.Lock()
+	defer lock.Unlock()
+	_default = h
+}
+
+// New 根据算法名称构造hasher
+func New(algorithm string, bcryptCost int, argon2 *Argon2Params) (Hasher, error) {
+	switch strings.ToLower(algorithm) {
+	case "", ALGORITHM_BCRYPT:
+		return NewBcrypt(bcryptCost), nil
+	case ALGORITHM_ARGON2ID:
+		return NewArgon2id(argon2), nil
+	default:
+		return nil, fmt.Errorf("unknown password hash algorithm %s", algorithm)
+	}
+}
+
+// Detect 通过hash的格式识别算法
+func Detect(hash string) string {
+	if strings.HasPrefix(hash, "$"+ALGORITHM_ARGON2ID+"$") {
+		return ALGORITHM_ARGON2ID
+	}
+	return ALGORITHM_BCRYPT
+}
+
+// Verify 按照hash的算法校验密码
+func Verify(hash, password string) error {
+	switch Detect(hash) {
+	case ALGORITHM_ARGON2ID:
+		return verifyArgon2id(hash, password)
+	default:
+		return verifyBcrypt(hash, password)
+	}
+}
+
+// NeedRehash 判断hash是否需要按照当前默认算法和参数重新计算
+func NeedRehash(algorithm, hash string) bool {
+	if algorithm == "" {
+		algorithm = Detect(hash)
+	}
+
+	h := Default()
+	if algorithm != h.Algorithm() {
+		return true
+	}
+	return h.NeedRehash(hash)
+}
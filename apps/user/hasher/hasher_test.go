@@ -0,0 +1,68 @@
+package hasher_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/infraboard/mcenter/apps/user/hasher"
+	"github.com/stretchr/testify/assert"
+)
+
+// 测试使用较小的参数, 加快速度
+func testArgon2Params() *hasher.Argon2Params {
+	return &hasher.Argon2Params{Time: 1, Memory: 1024, Threads: 1}
+}
+
+func TestBcrypt(t *testing.T) {
+	should := assert.New(t)
+
+	h := hasher.NewBcrypt(4)
+	hash, err := h.Hash("123456")
+	if should.NoError(err) {
+		should.Equal(hasher.ALGORITHM_BCRYPT, hasher.Detect(hash))
+		should.NoError(hasher.Verify(hash, "123456"))
+		should.Error(hasher.Verify(hash, "1234567"))
+		should.False(h.NeedRehash(hash))
+		should.True(hasher.NewBcrypt(5).NeedRehash(hash))
+	}
+}
+
+func TestArgon2id(t *testing.T) {
+	should := assert.New(t)
+
+	h := hasher.NewArgon2id(testArgon2Params())
+	hash, err := h.Hash("123456")
+	if should.NoError(err) {
+		should.True(strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+		should.Equal(hasher.ALGORITHM_ARGON2ID, hasher.Detect(hash))
+		should.NoError(hasher.Verify(hash, "123456"))
+		should.Error(hasher.Verify(hash, "1234567"))
+		should.False(h.NeedRehash(hash))
+
+		p := testArgon2Params()
+		p.Time = 2
+		should.True(hasher.NewArgon2id(p).NeedRehash(hash))
+	}
+
+	should.Error(hasher.Verify("$argon2id$v=19$m=1024$xx$yy", "123456"))
+}
+
+func TestNeedRehash(t *testing.T) {
+	should := assert.New(t)
+	defer hasher.SetDefault(hasher.Default())
+
+	hasher.SetDefault(hasher.NewBcrypt(4))
+	hash, err := hasher.Default().Hash("123456")
+	should.NoError(err)
+	// 历史数据没有记录算法
+	should.False(hasher.NeedRehash("", hash))
+
+	// 升级到argon2id
+	h, err := hasher.New(hasher.ALGORITHM_ARGON2ID, 0, testArgon2Params())
+	should.NoError(err)
+	hasher.SetDefault(h)
+	should.True(hasher.NeedRehash(hasher.ALGORITHM_BCRYPT, hash))
+
+	_, err = hasher.New("md5", 0, nil)
+	should.Error(err)
+}
@@ -0,0 +1,60 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// checkAttributes 按照域的属性定义校验用户的自定义属性, 并清除空值的属性,
+// 外部身份源的用户不强制要求必填属性
+func (s *service) checkAttributes(ctx context.Context, ins *user.User) error {
+	if ins.Profile == nil {
+		ins.Profile = user.NewProfile()
+	}
+
+	d, err := s.domain.DescribeDomain(ctx, domain.NewDescribeDomainRequestByName(ins.Spec.Domain))
+	if err != nil {
+		return err
+	}
+	refs, err := d.Spec.ValidateUserAttributes(ins.Profile.Attributes, !ins.Spec.IsExternal())
+	if err != nil {
+		return exception.NewBadRequest("%s", err)
+	}
+
+	// 引用的用户必须在同一个域中存在
+	for k, v := range refs {
+		if v == ins.Spec.Username {
+			return exception.NewBadRequest("attribute %s can not reference self", k)
+		}
+		_, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithDomainName(ins.Spec.Domain, v))
+		if err != nil {
+			if exception.IsNotFoundError(err) {
+				return exception.NewBadRequest("attribute %s user %s not found", k, v)
+			}
+			return err
+		}
+	}
+
+	for k, v := range ins.Profile.Attributes {
+		if v == "" {
+			delete(ins.Profile.Attributes, k)
+		}
+	}
+	return nil
+}
+
+// attributeFilter 校验属性过滤条件, 只允许使用可查询的属性
+func (s *service) attributeFilter(ctx context.Context, req *user.QueryUserRequest) error {
+	d, err := s.domain.DescribeDomain(ctx, domain.NewDescribeDomainRequestByName(req.Domain))
+	if err != nil {
+		return err
+	}
+	if err := d.Spec.ValidateAttributeFilter(req.Attributes); err != nil {
+		return exception.NewBadRequest("%s", err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image/png"
+	"io"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/rs/xid"
+
+	"github.com/infraboard/mcenter/apps/storage"
+	"github.com/infraboard/mcenter/apps/user"
+	"github.com/infraboard/mcenter/conf"
+)
+
+// 上传头像, 生成标准尺寸的缩略图保存到storage, 并删除之前的头像
+func (s *service) UploadAvatar(ctx context.Context, req *user.UploadAvatarRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := req.Decode()
+	if err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	avatarId := xid.New().String()
+	for _, size := range user.AVATAR_SIZES {
+		buf := bytes.NewBuffer(nil)
+		if err := png.Encode(buf, user.ResizeAvatar(img, size)); err != nil {
+			return nil, exception.NewInternalServerError("encode avatar error, %s", err)
+		}
+		upload := storage.NewUploadFileRequest(user.AVATAR_BUCKET, user.AvatarFileID(avatarId, size), io.NopCloser(buf))
+		upload.Meta()["user_id"] = ins.Id
+		if err := s.storage.UploadFile(upload); err != nil {
+			s.deleteAvatarFiles(avatarId)
+			return nil, exception.NewInternalServerError("save avatar error, %s", err)
+		}
+	}
+
+	old := ins.AvatarId
+	ins.AvatarId = avatarId
+	if ins.Profile == nil {
+		ins.Profile = user.NewProfile()
+	}
+	ins.Profile.Avatar = avatarURL(avatarId)
+	if err := s.update(ctx, ins); err != nil {
+		s.deleteAvatarFiles(avatarId)
+		return nil, err
+	}
+	s.deleteAvatarFiles(old)
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+// 删除头像
+func (s *service) DeleteAvatar(ctx context.Context, req *user.DeleteAvatarRequest) (*user.User, error) {
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	if ins.AvatarId == "" {
+		return nil, exception.NewNotFound("user %s avatar not found", ins.Spec.Username)
+	}
+
+	old := ins.AvatarId
+	ins.AvatarId = ""
+	if ins.Profile == nil {
+		ins.Profile = user.NewProfile()
+	}
+	if ins.Profile.Avatar == avatarURL(old) {
+		ins.Profile.Avatar = ""
+	}
+	if err := s.update(ctx, ins); err != nil {
+		return nil, err
+	}
+	s.deleteAvatarFiles(old)
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+// 下载头像
+func (s *service) DownloadAvatar(ctx context.Context, req *user.DownloadAvatarRequest) error {
+	if err := req.Validate(); err != nil {
+		return exception.NewBadRequest(err.Error())
+	}
+
+	fileId := user.AvatarFileID(req.AvatarId, req.Size)
+	err := s.storage.Download(storage.NewDownloadFileRequest(user.AVATAR_BUCKET, fileId, req.Writer()))
+	if err != nil {
+		return exception.NewNotFound("avatar %s not found, %s", req.AvatarId, err)
+	}
+	return nil
+}
+
+// 删除头像的所有尺寸, 删除失败只记录日志
+func (s *service) deleteAvatarFiles(avatarId string) {
+	if avatarId == "" {
+		return
+	}
+	for _, size := range user.AVATAR_SIZES {
+		err := s.storage.DeleteFile(storage.NewDeleteFileRequest(user.AVATAR_BUCKET, user.AvatarFileID(avatarId, size)))
+		if err != nil && !exception.IsNotFoundError(err) {
+			s.log.Errorf("delete avatar %s error, %s", avatarId, err)
+		}
+	}
+}
+
+// 头像的下载地址, 每次上传都会生成新的地址, 客户端可以长期缓存
+func avatarURL(avatarId string) string {
+	return fmt.Sprintf("/%s/api/v1/account/avatar/%s", conf.C().App.Name, avatarId)
+}
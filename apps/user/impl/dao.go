@@ -13,6 +13,9 @@ import (
 
 func (s *service) save(ctx context.Context, u *user.User) error {
 	if _, err := s.col.InsertOne(ctx, u); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return exception.NewConflict("user %s already exists", u.Spec.Username)
+		}
 		return exception.NewInternalServerError("inserted user(%s) document error, %s",
 			u.Id, err)
 	}
@@ -21,8 +24,8 @@ func (s *service) save(ctx context.Context, u *user.User) error {
 }
 
 func (s *service) update(ctx context.Context, ins *user.User) error {
-	if _, err := s.col.UpdateByID(ctx, ins.Id, ins); err != nil {
-		return exception.NewInternalServerError("inserted user(%s) document error, %s",
+	if _, err := s.col.UpdateByID(ctx, ins.Id, bson.M{"$set": ins}); err != nil {
+		return exception.NewInternalServerError("update user(%s) document error, %s",
 			ins.Id, err)
 	}
 
@@ -66,7 +69,7 @@ type queryRequest struct {
 
 func (r *queryRequest) FindOptions() *options.FindOptions {
 	pageSize := int64(r.Page.PageSize)
-	skip := int64(r.Page.PageSize) * int64(r.Page.PageNumber-1)
+	skip := r.Page.ComputeOffset()
 
 	opt := &options.FindOptions{
 		Sort: bson.D{
@@ -94,6 +97,12 @@ func (r *queryRequest) FindFilter() bson.M {
 	if len(r.UserIds) > 0 {
 		filter["_id"] = bson.M{"$in": r.UserIds}
 	}
+	if r.PasswordBreached != nil {
+		filter["password.breached"] = *r.PasswordBreached
+	}
+	for k, v := range r.Attributes {
+		filter["profile.attributes."+k] = v
+	}
 
 	return filter
 }
@@ -11,8 +11,17 @@ import (
 	"go.mongodb.org/mongo-driver/x/bsonx"
 	"google.golang.org/grpc"
 
+	"github.com/infraboard/mcenter/apps/code"
+	"github.com/infraboard/mcenter/apps/denylist"
 	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/lease"
+	"github.com/infraboard/mcenter/apps/notify"
+	"github.com/infraboard/mcenter/apps/retrylock"
+	"github.com/infraboard/mcenter/apps/setting"
+	"github.com/infraboard/mcenter/apps/storage"
+	"github.com/infraboard/mcenter/apps/token"
 	"github.com/infraboard/mcenter/apps/user"
+	"github.com/infraboard/mcenter/apps/user/hasher"
 	"github.com/infraboard/mcenter/conf"
 )
 
@@ -22,9 +31,22 @@ var (
 )
 
 type service struct {
-	log    logger.Logger
-	col    *mongo.Collection
-	domain domain.Service
+	log     logger.Logger
+	col     *mongo.Collection
+	event   *mongo.Collection
+	domain  domain.Service
+	code    code.Service
+	token   token.Service
+	setting setting.Service
+	notify  notify.Service
+	// 泄露密码黑名单
+	denylist denylist.Service
+	// 头像存储
+	storage storage.Service
+	// 修改密码时与登录共用失败重试锁定
+	retrylock retrylock.Service
+	// 生命周期检查只在一个副本上执行
+	lease lease.Service
 
 	user.UnimplementedRPCServer
 }
@@ -55,9 +77,52 @@ func (s *service) Config() error {
 		return err
 	}
 
+	// 新密码使用的hash算法
+	hc := conf.C().PasswordHash
+	h, err := hasher.New(hc.Algorithm, hc.BcryptCost, &hasher.Argon2Params{
+		Time:    hc.Argon2Time,
+		Memory:  hc.Argon2Memory,
+		Threads: hc.Argon2Threads,
+	})
+	if err != nil {
+		return err
+	}
+	hasher.SetDefault(h)
+
+	// 用户状态变更审计记录
+	ec := db.Collection("user_status_event")
+	_, err = ec.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bsonx.Doc{
+				{Key: "user_id", Value: bsonx.Int32(-1)},
+				{Key: "create_at", Value: bsonx.Int32(-1)},
+			},
+		},
+		{
+			Keys: bsonx.Doc{{Key: "domain", Value: bsonx.Int32(-1)}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
 	s.col = uc
+	s.event = ec
 	s.log = zap.L().Named(user.AppName)
 	s.domain = app.GetInternalApp(domain.AppName).(domain.Service)
+	s.code = app.GetInternalApp(code.AppName).(code.Service)
+	s.token = app.GetInternalApp(token.AppName).(token.Service)
+	s.setting = app.GetInternalApp(setting.AppName).(setting.Service)
+	s.notify = app.GetInternalApp(notify.AppName).(notify.Service)
+	s.denylist = app.GetInternalApp(denylist.AppName).(denylist.Service)
+	s.storage = app.GetInternalApp(storage.AppName).(storage.Service)
+	s.retrylock = app.GetInternalApp(retrylock.AppName).(retrylock.Service)
+	s.lease = app.GetInternalApp(lease.AppName).(lease.Service)
+
+	// 后台清理过期的邀请
+	go s.runInvitationCleaner()
+	// 后台停用过期账号和冻结休眠账号
+	go s.runLifecycleScheduler()
 	return nil
 }
 
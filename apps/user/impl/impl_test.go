@@ -50,6 +50,71 @@ func TestQueryUser(t *testing.T) {
 	t.Log(r)
 }
 
+func TestResetPassword(t *testing.T) {
+	u, err := impl.DescribeUser(ctx, user.NewDescriptUserRequestWithDomainName(domain.DEFAULT_DOMAIN, "test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := user.NewResetPasswordRequest()
+	req.UserId = u.Id
+	r, err := impl.ResetPassword(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r.TemporaryPassword)
+}
+
+func TestUpdatePassword(t *testing.T) {
+	req := user.NewUpdatePasswordRequest()
+	req.Domain = domain.DEFAULT_DOMAIN
+	req.Username = "test"
+	req.OldPass = "123456"
+	req.NewPass = "abcd1234"
+	r, err := impl.UpdatePassword(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
+func TestRecoverPassword(t *testing.T) {
+	req := user.NewRecoverPasswordRequest()
+	req.Domain = domain.DEFAULT_DOMAIN
+	req.Username = "test"
+	req.Code = "612114"
+	req.NewPass = "abcd12345"
+	r, err := impl.RecoverPassword(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
+func TestInviteUser(t *testing.T) {
+	req := user.NewInviteUserRequest()
+	req.Domain = domain.DEFAULT_DOMAIN
+	req.Username = "invitee"
+	req.Email = "invitee@example.com"
+	req.InviteBy = "admin"
+	r, err := impl.InviteUser(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
+func TestActivateUser(t *testing.T) {
+	req := user.NewActivateUserRequest()
+	req.Token = "token in invitation mail"
+	req.Password = "abcd12345"
+	r, err := impl.ActivateUser(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(r)
+}
+
 func init() {
 	tools.DevelopmentSetup()
 	impl = app.GetInternalApp(user.AppName).(user.Service)
@@ -0,0 +1,253 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/domain/password"
+	"github.com/infraboard/mcenter/apps/notify"
+	"github.com/infraboard/mcenter/apps/setting"
+	"github.com/infraboard/mcenter/apps/user"
+	"github.com/infraboard/mcenter/conf"
+)
+
+const (
+	// 清理过期邀请的间隔
+	INVITATION_CLEANUP_INTERVAL = 10 * time.Minute
+)
+
+// 邀请用户, 创建待激活的用户并发送激活链接
+func (s *service) InviteUser(ctx context.Context, req *user.InviteUserRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ic, err := s.invitationSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 激活之前使用随机密码, 用户无法登录
+	ps, err := s.passwordSecurity(ctx, req.Domain)
+	if err != nil {
+		return nil, err
+	}
+	pass, err := password.New(proto.Clone(ps).(*domain.PasswordSecurity)).GenerateValid()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := user.New(req.CreateUserRequest(*pass))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkQuota(ctx, req.Domain); err != nil {
+		return nil, err
+	}
+	if err := s.checkAttributes(ctx, u); err != nil {
+		return nil, err
+	}
+	u.Profile.Email = req.Email
+	u.Invitation = user.NewInvitation(req.InviteBy, req.Email)
+	u.Invitation.Renew(invitationExpire(ic, req.ExpireHours))
+	if err := s.save(ctx, u); err != nil {
+		return nil, err
+	}
+
+	// 激活链接发送失败时回滚, 由管理员重新邀请
+	if err := s.sendInvitation(ctx, u, ic); err != nil {
+		if err := s.delete(ctx, &user.UserSet{Items: []*user.User{u}}); err != nil {
+			s.log.Errorf("rollback invited user %s error, %s", u.Spec.Username, err)
+		}
+		return nil, exception.NewInternalServerError("send invitation error, %s", err)
+	}
+
+	u.Desensitize()
+	return u, nil
+}
+
+// 重新发送激活链接, 之前发送的链接失效
+func (s *service) ResendInvitation(ctx context.Context, req *user.ResendInvitationRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.describePendingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	ic, err := s.invitationSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ins.Invitation.Renew(invitationExpire(ic, req.ExpireHours))
+	ins.UpdateAt = time.Now().UnixMilli()
+	if err := s.update(ctx, ins); err != nil {
+		return nil, err
+	}
+	if err := s.sendInvitation(ctx, ins, ic); err != nil {
+		return nil, exception.NewInternalServerError("send invitation error, %s", err)
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+// 撤销邀请, 删除未激活的用户
+func (s *service) RevokeInvitation(ctx context.Context, req *user.RevokeInvitationRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.describePendingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.delete(ctx, &user.UserSet{Items: []*user.User{ins}}); err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+// 被邀请人通过激活链接设置密码和个人信息, 激活后用户才完成初始化
+func (s *service) ActivateUser(ctx context.Context, req *user.ActivateUserRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	tk, err := user.ParseInvitationToken(req.Token, conf.C().App.EncryptKey)
+	if err != nil {
+		return nil, exception.NewPermissionDeny("invitation token invalidate, %s", err)
+	}
+	if tk.IsExpired() {
+		return nil, exception.NewPermissionDeny("invitation expired")
+	}
+
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(tk.UserId))
+	if err != nil {
+		if exception.IsNotFoundError(err) {
+			return nil, exception.NewPermissionDeny("invitation not found or revoked")
+		}
+		return nil, err
+	}
+	if !ins.IsPendingActivation() {
+		return nil, exception.NewBadRequest("user %s already activated", ins.Spec.Username)
+	}
+	// 重新发送后, 只有最新的链接有效
+	if ins.Invitation.Nonce != tk.Nonce || ins.Invitation.IsExpired() {
+		return nil, exception.NewPermissionDeny("invitation token invalidate, link expired or resent")
+	}
+
+	ps, err := s.passwordSecurity(ctx, ins.Spec.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNewPassword(ps, req.Password); err != nil {
+		return nil, err
+	}
+
+	if err := ins.MergeActivateProfile(req.Profile); err != nil {
+		return nil, err
+	}
+	// 激活链接通过邮箱送达, 未填写邮箱时使用邀请的邮箱
+	if ins.Profile.Email == "" {
+		ins.Profile.Email = ins.Invitation.Email
+	}
+	// 激活链接已经发送到邀请的邮箱, 邮箱视为已验证
+	if ins.Profile.Email == ins.Invitation.Email {
+		ins.SetVerified(user.CONTACT_TYPE_MAIL)
+	}
+	if err := s.checkAttributes(ctx, ins); err != nil {
+		return nil, err
+	}
+	ins.Invitation.ActivatedAt = time.Now().UnixMilli()
+	ins.Invitation.Nonce = ""
+	ins.IsInitialized = true
+
+	if _, err := s.changePassword(ctx, ins, req.Password, ps); err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+// describePendingUser 查询未激活的被邀请用户
+func (s *service) describePendingUser(ctx context.Context, userId string) (*user.User, error) {
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(userId))
+	if err != nil {
+		return nil, err
+	}
+	if !ins.IsPendingActivation() {
+		return nil, exception.NewBadRequest("user %s is not pending activation", ins.Spec.Username)
+	}
+	return ins, nil
+}
+
+// sendInvitation 发送激活链接, 激活链接只通过邮件发送
+func (s *service) sendInvitation(ctx context.Context, u *user.User, ic *setting.Invitation) error {
+	link := ic.ActivateLink(u.Invitation.Sign(u.Id, conf.C().App.EncryptKey))
+	expire := time.Duration(u.Invitation.ExpiredAt-u.Invitation.SendAt) * time.Millisecond
+	content := ic.RenderMailCentent(u.Invitation.InviteBy, u.Spec.Username, link, uint32(expire.Hours()))
+	_, err := s.notify.SendMail(ctx, notify.NewSendMailRequest([]string{u.Invitation.Email}, "账号激活邀请", content))
+	return err
+}
+
+// invitationSetting 系统的邀请配置, 未配置时使用默认值
+func (s *service) invitationSetting(ctx context.Context) (*setting.Invitation, error) {
+	system, err := s.setting.GetSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if system.Notify.Invitation == nil {
+		return setting.NewDefaultInvitation(), nil
+	}
+	return system.Notify.Invitation, nil
+}
+
+// cleanupExpiredInvitation 删除激活链接已经过期的未激活用户
+func (s *service) cleanupExpiredInvitation(ctx context.Context) (int64, error) {
+	filter := bson.M{
+		"invitation.activated_at": 0,
+		"invitation.expired_at":   bson.M{"$lt": time.Now().UnixMilli()},
+	}
+	result, err := s.col.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, exception.NewInternalServerError("delete expired invitation error, %s", err)
+	}
+	return result.DeletedCount, nil
+}
+
+// runInvitationCleaner 定期清理过期的邀请
+func (s *service) runInvitationCleaner() {
+	tk := time.NewTicker(INVITATION_CLEANUP_INTERVAL)
+	defer tk.Stop()
+
+	for range tk.C {
+		count, err := s.cleanupExpiredInvitation(context.Background())
+		if err != nil {
+			s.log.Errorf("cleanup expired invitation error, %s", err)
+			continue
+		}
+		if count > 0 {
+			s.log.Infof("cleanup %d expired invitation", count)
+		}
+	}
+}
+
+func invitationExpire(ic *setting.Invitation, hours uint32) time.Duration {
+	if hours == 0 {
+		hours = ic.ExpireHours
+	}
+	return time.Duration(hours) * time.Hour
+}
@@ -0,0 +1,389 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/lease"
+	"github.com/infraboard/mcenter/apps/notify"
+	"github.com/infraboard/mcenter/apps/setting"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+const (
+	// 检查账号失效时间和休眠账号的间隔
+	LIFECYCLE_CHECK_INTERVAL = 10 * time.Minute
+	// 扫描用户时的分页大小
+	SCAN_PAGE_SIZE = 200
+	// 生命周期检查的租约名称
+	LIFECYCLE_LEASE_NAME = "user.lifecycle"
+)
+
+// 修改用户的生命周期状态和生效时间
+func (s *service) ChangeUserState(ctx context.Context, req *user.ChangeUserStateRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	from := ins.CloneStatus()
+	ins.ChangeState(req)
+	event := user.NewStatusEvent(ins, user.STATUS_EVENT_TYPE_STATE_CHANGE, from, req.Reason, req.Operator)
+	if err := s.transition(ctx, ins, event); err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+// 注销用户, 状态变更记录保留用于审计, 记录中的用户名替换为匿名标识
+func (s *service) EraseUser(ctx context.Context, req *user.EraseUserRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.event.UpdateMany(ctx,
+		bson.M{"user_id": ins.Id},
+		bson.M{"$set": bson.M{"username": req.Pseudonym}},
+	)
+	if err != nil {
+		return nil, exception.NewInternalServerError("anonymize user status event error, %s", err)
+	}
+	_, err = s.event.UpdateMany(ctx,
+		bson.M{"domain": ins.Spec.Domain, "operator": ins.Spec.Username},
+		bson.M{"$set": bson.M{"operator": req.Pseudonym}},
+	)
+	if err != nil {
+		return nil, exception.NewInternalServerError("anonymize user status event error, %s", err)
+	}
+
+	set := user.NewUserSet()
+	set.Add(ins)
+	if err := s.delete(ctx, set); err != nil {
+		return nil, err
+	}
+	s.deleteAvatarFiles(ins.AvatarId)
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+// 删除域下所有的用户, 头像, 状态变更记录和其他用户在该域的成员身份, 清除域时调用
+func (s *service) DeleteDomainResource(ctx context.Context, req *domain.DeleteDomainResourceRequest) (
+	*domain.DeleteDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	// 头像文件保存在storage中, 需要单独删除
+	resp, err := s.col.Find(ctx, bson.M{"spec.domain": req.Domain, "avatar_id": bson.M{"$exists": true, "$ne": ""}})
+	if err != nil {
+		return nil, exception.NewInternalServerError("find domain %s avatar error, %s", req.Domain, err)
+	}
+	for resp.Next(ctx) {
+		ins := user.NewDefaultUser()
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode user error, %s", err)
+		}
+		s.deleteAvatarFiles(ins.AvatarId)
+	}
+
+	rs, err := s.col.DeleteMany(ctx, bson.M{"spec.domain": req.Domain})
+	if err != nil {
+		return nil, exception.NewInternalServerError("delete domain %s user error, %s", req.Domain, err)
+	}
+	if _, err := s.event.DeleteMany(ctx, bson.M{"domain": req.Domain}); err != nil {
+		return nil, exception.NewInternalServerError("delete domain %s user status event error, %s", req.Domain, err)
+	}
+	// 其他域的用户加入该域的成员身份
+	_, err = s.col.UpdateMany(ctx,
+		bson.M{"memberships.domain": req.Domain},
+		bson.M{"$pull": bson.M{"memberships": bson.M{"domain": req.Domain}}},
+	)
+	if err != nil {
+		return nil, exception.NewInternalServerError("remove domain %s membership error, %s", req.Domain, err)
+	}
+
+	return domain.NewDeleteDomainResourceResponse(rs.DeletedCount), nil
+}
+
+// 统计域下的用户数量, 包含从其他域加入的成员, 用于配额检查
+func (s *service) CountDomainResource(ctx context.Context, req *domain.CountDomainResourceRequest) (
+	*domain.CountDomainResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	count, err := s.col.CountDocuments(ctx, bson.M{"$or": bson.A{
+		bson.M{"spec.domain": req.Domain},
+		bson.M{"memberships.domain": req.Domain},
+	}})
+	if err != nil {
+		return nil, exception.NewInternalServerError("count domain %s user error, %s", req.Domain, err)
+	}
+	return domain.NewCountDomainResourceResponse(count), nil
+}
+
+func (s *service) checkQuota(ctx context.Context, domainName string) error {
+	if domainName == "" {
+		return nil
+	}
+
+	resp, err := s.CountDomainResource(ctx, domain.NewCountDomainResourceRequest(domainName))
+	if err != nil {
+		return err
+	}
+	_, err = s.domain.CheckQuota(ctx, domain.NewCheckQuotaRequest(domainName, domain.QUOTA_RESOURCE_USER, resp.Count))
+	return err
+}
+
+// 查询用户状态变更记录
+func (s *service) QueryStatusEvent(ctx context.Context, req *user.QueryStatusEventRequest) (*user.StatusEventSet, error) {
+	filter := bson.M{}
+	if req.Domain != "" {
+		filter["domain"] = req.Domain
+	}
+	if req.UserId != "" {
+		filter["user_id"] = req.UserId
+	}
+
+	pageSize := int64(req.Page.PageSize)
+	skip := req.Page.ComputeOffset()
+	opt := &options.FindOptions{
+		Sort:  bson.D{{Key: "create_at", Value: -1}},
+		Limit: &pageSize,
+		Skip:  &skip,
+	}
+	resp, err := s.event.Find(ctx, filter, opt)
+	if err != nil {
+		return nil, exception.NewInternalServerError("find user status event error, %s", err)
+	}
+
+	set := user.NewStatusEventSet()
+	for resp.Next(ctx) {
+		ins := &user.StatusEvent{}
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode user status event error, %s", err)
+		}
+		set.Add(ins)
+	}
+
+	count, err := s.event.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, exception.NewInternalServerError("get user status event count error, %s", err)
+	}
+	set.Total = count
+	return set, nil
+}
+
+// transition 保存状态变更, 记录审计日志, 用户不可用时撤销所有令牌, 并通知用户
+func (s *service) transition(ctx context.Context, ins *user.User, event *user.StatusEvent) error {
+	ins.UpdateAt = time.Now().UnixMilli()
+	if err := s.update(ctx, ins); err != nil {
+		return err
+	}
+
+	if _, err := s.event.InsertOne(ctx, event); err != nil {
+		return exception.NewInternalServerError("inserted user status event(%s) error, %s", event.Id, err)
+	}
+
+	if err := ins.CheckActive(); err != nil {
+		_, err := s.token.BlockUserToken(ctx, token.NewBlockUserTokenRequest(
+			ins.Id,
+			token.BLOCK_TYPE_USER_INACTIVE,
+			err.Error(),
+		))
+		if err != nil {
+			return err
+		}
+	}
+
+	// 通知失败不影响状态变更
+	if err := s.sendStatusChanged(ctx, ins, event); err != nil {
+		s.log.Errorf("send status changed notify to user %s error, %s", ins.Spec.Username, err)
+	}
+	return nil
+}
+
+// sendStatusChanged 通知用户账号状态已变更
+func (s *service) sendStatusChanged(ctx context.Context, u *user.User, event *user.StatusEvent) error {
+	if u.Profile == nil {
+		return fmt.Errorf("user %s profile not found", u.Spec.Username)
+	}
+	system, err := s.setting.GetSetting(ctx)
+	if err != nil {
+		return err
+	}
+	if err := system.Notify.CheckVerified(u); err != nil {
+		return err
+	}
+	conf := system.Notify.StatusChanged
+	if conf == nil {
+		conf = setting.NewDefaultStatusChanged()
+	}
+
+	now := time.UnixMilli(event.CreateAt)
+	switch system.Notify.Type {
+	case notify.NOTIFY_TYPE_MAIL:
+		if u.Profile.Email == "" {
+			return fmt.Errorf("user %s email not found", u.Spec.Username)
+		}
+		content := conf.RenderMailCentent(u.Spec.Username, now, event.Summary(), event.Reason)
+		_, err := s.notify.SendMail(ctx, notify.NewSendMailRequest([]string{u.Profile.Email}, "账号状态变更通知", content))
+		return err
+	case notify.NOTIFY_TYPE_SMS:
+		if u.Profile.Phone == "" || conf.SmsTemplateID == "" {
+			return fmt.Errorf("user %s phone or sms template not found", u.Spec.Username)
+		}
+		req := notify.NewSendSMSRequest()
+		req.AddPhone(u.Profile.Phone)
+		req.TemplateId = conf.SmsTemplateID
+		req.AddParams(u.Spec.Username, now.Format("2006-01-02 15:04:05"), event.Summary())
+		_, err := s.notify.SendSMS(ctx, req)
+		return err
+	default:
+		return fmt.Errorf("unknown notify type %s", system.Notify.Type)
+	}
+}
+
+// deactivateExpired 停用到达失效时间的账号
+func (s *service) deactivateExpired(ctx context.Context) error {
+	filter := bson.M{
+		"status.state":        user.STATE_ACTIVE,
+		"status.active_until": bson.M{"$gt": 0, "$lte": time.Now().UnixMilli()},
+	}
+	resp, err := s.col.Find(ctx, filter)
+	if err != nil {
+		return exception.NewInternalServerError("find expired user error, %s", err)
+	}
+	defer resp.Close(ctx)
+
+	for resp.Next(ctx) {
+		ins := user.NewDefaultUser()
+		if err := resp.Decode(ins); err != nil {
+			return exception.NewInternalServerError("decode user error, %s", err)
+		}
+
+		from := ins.CloneStatus()
+		req := user.NewChangeUserStateRequest(ins.Id, user.STATE_DISABLED, user.STATE_REASON_EXPIRED)
+		req.ActiveFrom, req.ActiveUntil = from.ActiveFrom, from.ActiveUntil
+		ins.ChangeState(req)
+		event := user.NewStatusEvent(ins, user.STATUS_EVENT_TYPE_STATE_CHANGE, from, req.Reason, user.SYSTEM_OPERATOR)
+		if err := s.transition(ctx, ins, event); err != nil {
+			s.log.Errorf("deactivate expired user %s error, %s", ins.Spec.Username, err)
+			continue
+		}
+		s.log.Infof("user %s expired, deactivated", ins.Spec.Username)
+	}
+	return resp.Err()
+}
+
+// lockDormant 按照域的登录安全配置, 冻结长时间未登录的账号
+func (s *service) lockDormant(ctx context.Context) error {
+	req := domain.NewQueryDomainRequest()
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := s.domain.QueryDoamin(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, d := range set.Items {
+			if d.DormantLockDays() == 0 {
+				continue
+			}
+			if err := s.lockDomainDormant(ctx, d); err != nil {
+				s.log.Errorf("lock domain %s dormant user error, %s", d.Spec.Name, err)
+			}
+		}
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return nil
+		}
+		req.Page.PageNumber++
+	}
+}
+
+func (s *service) lockDomainDormant(ctx context.Context, d *domain.Domain) error {
+	deadline := time.Now().Add(-time.Duration(d.DormantLockDays()) * 24 * time.Hour)
+	since := time.UnixMilli(d.Status.GetDormantLockSince())
+
+	req := user.NewQueryUserRequest()
+	req.Domain = d.Spec.Name
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := s.QueryUser(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, u := range set.Items {
+			if !u.IsActive() || u.IsPendingActivation() || u.Spec.Type.Equal(user.TYPE_SUPPER) {
+				continue
+			}
+			last, err := s.lastLoginAt(ctx, u, since)
+			if err != nil {
+				return err
+			}
+			if last.After(deadline) {
+				continue
+			}
+
+			from := u.CloneStatus()
+			u.Lock(user.LOCK_REASON_DORMANT)
+			event := user.NewStatusEvent(u, user.STATUS_EVENT_TYPE_LOCK, from, user.LOCK_REASON_DORMANT, user.SYSTEM_OPERATOR)
+			if err := s.transition(ctx, u, event); err != nil {
+				s.log.Errorf("lock dormant user %s error, %s", u.Spec.Username, err)
+				continue
+			}
+			s.log.Infof("user %s not login since %s, locked", u.Spec.Username, last.Format(time.RFC3339))
+		}
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return nil
+		}
+		req.Page.PageNumber++
+	}
+}
+
+// lastLoginAt 用户最近一次登录的时间, 使用所有授权方式中最近颁发的令牌,
+// 没有登录记录时从创建时间和休眠锁定启用时间中较晚的时间开始计算
+func (s *service) lastLoginAt(ctx context.Context, u *user.User, since time.Time) (time.Time, error) {
+	set, err := s.token.QueryToken(ctx, token.NewQueryUserLastToken(u.Id))
+	if err != nil {
+		return time.Time{}, err
+	}
+	if set.Length() > 0 {
+		return time.UnixMilli(set.Items[0].IssueAt), nil
+	}
+	created := time.UnixMilli(u.CreateAt)
+	if created.After(since) {
+		return created, nil
+	}
+	return since, nil
+}
+
+// runLifecycleScheduler 定期停用过期账号和冻结休眠账号, 多副本部署时只有持有租约的副本执行
+func (s *service) runLifecycleScheduler() {
+	lease.Schedule(s.lease, LIFECYCLE_LEASE_NAME, LIFECYCLE_CHECK_INTERVAL, func(ctx context.Context) {
+		if err := s.deactivateExpired(ctx); err != nil {
+			s.log.Errorf("deactivate expired user error, %s", err)
+		}
+		if err := s.lockDormant(ctx); err != nil {
+			s.log.Errorf("lock dormant user error, %s", err)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/token"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// 把用户加入其他域, 已经是成员时修改用户在该域中的类型
+func (s *service) AddDomainMember(ctx context.Context, req *user.AddDomainMemberRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	if ins.Spec.Domain == req.Domain {
+		return nil, exception.NewBadRequest("user %s already belongs to domain %s", ins.Spec.Username, req.Domain)
+	}
+
+	d, err := s.domain.DescribeDomain(ctx, domain.NewDescribeDomainRequestByName(req.Domain))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.CheckActive(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	// 新加入的成员占用域的用户配额, 修改类型时需要让之前的令牌失效
+	m := ins.GetMembership(req.Domain)
+	if m == nil {
+		if err := s.checkQuota(ctx, req.Domain); err != nil {
+			return nil, err
+		}
+	}
+	typeChanged := m != nil && !m.Type.Equal(req.Type)
+
+	ins.AddMembership(req)
+	if err := s.update(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	if typeChanged {
+		reason := fmt.Sprintf("user type in domain %s changed to %s", req.Domain, req.Type)
+		if err := s.blockDomainToken(ctx, ins.Id, req.Domain, reason); err != nil {
+			return nil, err
+		}
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+// 把用户移出其他域, 用户在该域中的令牌全部失效
+func (s *service) RemoveDomainMember(ctx context.Context, req *user.RemoveDomainMemberRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	if !ins.RemoveMembership(req.Domain) {
+		return nil, exception.NewNotFound("user %s is not a member of domain %s", ins.Spec.Username, req.Domain)
+	}
+
+	if err := s.update(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	reason := fmt.Sprintf("user removed from domain %s", req.Domain)
+	if err := s.blockDomainToken(ctx, ins.Id, req.Domain, reason); err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+func (s *service) blockDomainToken(ctx context.Context, userId, domainName, reason string) error {
+	req := token.NewBlockUserTokenRequest(userId, token.BLOCK_TYPE_DOMAIN_MEMBERSHIP_REVOKED, reason)
+	req.Domain = domainName
+	_, err := s.token.BlockUserToken(ctx, req)
+	return err
+}
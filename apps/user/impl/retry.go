@@ -0,0 +1,62 @@
+package impl
+
+import (
+	"context"
+	"math"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/retrylock"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// 修改密码时校验原密码, 与登录共用失败次数, 避免通过修改密码接口绕过登录的重试锁定
+func (s *service) checkOldPassword(ctx context.Context, ins *user.User, oldPass string) error {
+	ls, err := s.loginSecurity(ctx, ins.Spec.Domain)
+	if err != nil {
+		return err
+	}
+	if !ls.RetryLock {
+		return ins.Password.CheckPassword(oldPass)
+	}
+
+	key := ins.Spec.Username
+	l, err := s.retrylock.DescribeRetryLock(ctx, key)
+	if err != nil && !exception.IsNotFoundError(err) {
+		return err
+	}
+	if err == nil && l.IsLocked() {
+		return exception.NewPermissionDeny("密码错误次数过多, 请%d分钟后重试", int(math.Ceil(l.TTL().Minutes())))
+	}
+
+	if err := ins.Password.CheckPassword(oldPass); err != nil {
+		attempt := retrylock.NewFailedAttempt(ins.Spec.Domain, ins.Spec.Username, err.Error())
+		attempt.GrantType = "update_password"
+		rc := ls.RetryLockConfig
+		if _, e := s.retrylock.RecordFailure(ctx, retrylock.NewRecordFailureRequest(key, attempt, rc.RetryLimite, rc.LockedMinite)); e != nil {
+			s.log.Errorf("record update password failure error, %s", e)
+		}
+		return err
+	}
+
+	if err := s.retrylock.ResetRetryLock(ctx, key); err != nil {
+		s.log.Errorf("reset retry lock error, %s", err)
+	}
+	return nil
+}
+
+func (s *service) loginSecurity(ctx context.Context, domainName string) (*domain.LoginSecurity, error) {
+	d, err := s.domain.DescribeDomain(ctx, domain.NewDescribeDomainRequestByName(domainName))
+	if err != nil {
+		if exception.IsNotFoundError(err) {
+			return domain.NewDefaultSecuritySetting().LoginSecurity, nil
+		}
+		return nil, err
+	}
+
+	if d.Spec.SecuritySetting == nil || d.Spec.SecuritySetting.LoginSecurity == nil {
+		return domain.NewDefaultSecuritySetting().LoginSecurity, nil
+	}
+	return d.Spec.SecuritySetting.LoginSecurity, nil
+}
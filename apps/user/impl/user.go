@@ -2,12 +2,21 @@ package impl
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/infraboard/mcenter/apps/code"
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/domain/password"
+	"github.com/infraboard/mcenter/apps/notify"
+	"github.com/infraboard/mcenter/apps/setting"
+	"github.com/infraboard/mcenter/apps/token"
 	"github.com/infraboard/mcenter/apps/user"
 	"github.com/infraboard/mcube/exception"
 	"github.com/infraboard/mcube/pb/request"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
 )
 
 func (s *service) CreateUser(ctx context.Context, req *user.CreateUserRequest) (*user.User, error) {
@@ -16,6 +25,14 @@ func (s *service) CreateUser(ctx context.Context, req *user.CreateUserRequest) (
 		return nil, err
 	}
 
+	// 检查域的用户配额
+	if err := s.checkQuota(ctx, req.Domain); err != nil {
+		return nil, err
+	}
+	if err := s.checkAttributes(ctx, u); err != nil {
+		return nil, err
+	}
+
 	// 如果是管理员创建的账号需要用户自己重置密码
 	if req.CreateBy.IsIn(user.CREATE_BY_ADMIN) {
 		u.Password.SetNeedReset("admin created user need reset when first login")
@@ -31,6 +48,12 @@ func (s *service) CreateUser(ctx context.Context, req *user.CreateUserRequest) (
 
 // 查询用户列表
 func (s *service) QueryUser(ctx context.Context, req *user.QueryUserRequest) (*user.UserSet, error) {
+	if len(req.Attributes) > 0 {
+		if err := s.attributeFilter(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
 	r := newQueryRequest(req)
 	resp, err := s.col.Find(ctx, r.FindFilter(), r.FindOptions())
 
@@ -67,7 +90,21 @@ func (s *service) DescribeUser(ctx context.Context, req *user.DescribeUserReques
 	case user.DESCRIBE_BY_USER_ID:
 		filter["_id"] = req.Id
 	case user.DESCRIBE_BY_USER_NAME:
+		if req.WithMember && req.Domain != "" {
+			return s.describeDomainMember(ctx, req)
+		}
 		filter["spec.username"] = req.Username
+		if req.Domain != "" {
+			filter["spec.domain"] = req.Domain
+		}
+	case user.DESCRIBE_BY_EMAIL:
+		if req.Email == "" {
+			return nil, exception.NewBadRequest("email required")
+		}
+		filter["profile.email"] = req.Email
+		if req.Domain != "" {
+			filter["spec.domain"] = req.Domain
+		}
 	default:
 		return nil, exception.NewBadRequest("unknow desribe by %s", req.DescribeBy)
 	}
@@ -84,6 +121,44 @@ func (s *service) DescribeUser(ctx context.Context, req *user.DescribeUserReques
 	return ins, nil
 }
 
+// 查询域内的用户, 包含从其他域加入该域的成员
+// 用户名只在所属域内唯一, 优先使用所属域的用户, 其他域有多个同名成员时无法确定是哪一个
+func (s *service) describeDomainMember(ctx context.Context, req *user.DescribeUserRequest) (*user.User, error) {
+	filter := bson.M{
+		"spec.username": req.Username,
+		"$or": bson.A{
+			bson.M{"spec.domain": req.Domain},
+			bson.M{"memberships.domain": req.Domain},
+		},
+	}
+	resp, err := s.col.Find(ctx, filter)
+	if err != nil {
+		return nil, exception.NewInternalServerError("find domain %s member %s error, %s", req.Domain, req.Username, err)
+	}
+	defer resp.Close(ctx)
+
+	members := []*user.User{}
+	for resp.Next(ctx) {
+		ins := user.NewDefaultUser()
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode user error, %s", err)
+		}
+		if ins.Spec.Domain == req.Domain {
+			return ins, nil
+		}
+		members = append(members, ins)
+	}
+
+	switch len(members) {
+	case 0:
+		return nil, exception.NewNotFound("user %s not found in domain %s", req.Username, req.Domain)
+	case 1:
+		return members[0], nil
+	default:
+		return nil, exception.NewConflict("domain %s has %d members named %s", req.Domain, len(members), req.Username)
+	}
+}
+
 // 修改用户信息
 func (s *service) UpdateUser(ctx context.Context, req *user.UpdateUserRequest) (*user.User, error) {
 	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
@@ -101,11 +176,97 @@ func (s *service) UpdateUser(ctx context.Context, req *user.UpdateUserRequest) (
 		}
 	}
 
+	// 按照域的属性定义校验合并后的自定义属性, 全量更新时也会检查必填属性
+	if err := s.checkAttributes(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	if err := s.update(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+// 冻结/解冻用户
+func (s *service) UpdateUserStatus(ctx context.Context, req *user.UpdateUserStatusRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	from, et := ins.CloneStatus(), user.STATUS_EVENT_TYPE_UNLOCK
+	if req.Locked {
+		ins.Lock(req.Reason)
+		et = user.STATUS_EVENT_TYPE_LOCK
+	} else {
+		ins.Unlock()
+	}
+
+	if err := s.transition(ctx, ins, user.NewStatusEvent(ins, et, from, req.Reason, req.Operator)); err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+// 密码hash算法或者参数过时时重新计算hash, 密码本身不变
+func (s *service) UpgradePasswordHash(ctx context.Context, req *user.UpgradePasswordHashRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	if !ins.Password.NeedRehash() {
+		ins.Desensitize()
+		return ins, nil
+	}
+	if err := ins.Password.CheckPassword(req.Password); err != nil {
+		return nil, err
+	}
+
+	algorithm := ins.Password.HashAlgorithm
+	if err := ins.Password.Rehash(req.Password); err != nil {
+		return nil, exception.NewInternalServerError("rehash password error, %s", err)
+	}
 	if err := s.update(ctx, ins); err != nil {
 		return nil, err
 	}
+	s.log.Infof("user %s password hash upgraded, %s -> %s", ins.Spec.Username, algorithm, ins.Password.HashAlgorithm)
 
-	return nil, nil
+	ins.Desensitize()
+	return ins, nil
+}
+
+// 标记用户当前密码是否命中泄露密码黑名单
+func (s *service) MarkPasswordBreached(ctx context.Context, req *user.MarkPasswordBreachedRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	ins.Password.Breached = req.Breached
+	ins.Password.BreachedReason = req.Reason
+	ins.Password.BreachedCheckAt = time.Now().UnixMilli()
+	if err := s.update(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
 }
 
 // 删除用户
@@ -131,15 +292,225 @@ func (s *service) DeleteUser(ctx context.Context, req *user.DeleteUserRequest) (
 	if err := s.delete(ctx, set); err != nil {
 		return nil, err
 	}
+	for _, u := range set.Items {
+		s.deleteAvatarFiles(u.AvatarId)
+	}
 	return set, nil
 }
 
 // 修改用户密码, 用户需要知道原先密码
 func (s *service) UpdatePassword(ctx context.Context, req *user.UpdatePasswordRequest) (*user.Password, error) {
-	return nil, nil
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, req.DescribeUserRequest())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkOldPassword(ctx, ins, req.OldPass); err != nil {
+		return nil, err
+	}
+
+	ps, err := s.passwordSecurity(ctx, ins.Spec.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNewPassword(ps, req.NewPass); err != nil {
+		return nil, err
+	}
+
+	return s.changePassword(ctx, ins, req.NewPass, ps)
 }
 
 // 重置密码, 无需知道原先密码, 主账号执行
 func (s *service) ResetPassword(ctx context.Context, req *user.ResetPasswordRequest) (*user.Password, error) {
-	return nil, nil
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := s.passwordSecurity(ctx, ins.Spec.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// 没有指定新密码时, 按照密码策略生成临时密码
+	newPass := req.NewPass
+	if newPass == "" {
+		p, err := password.New(proto.Clone(ps).(*domain.PasswordSecurity)).GenerateValid()
+		if err != nil {
+			return nil, err
+		}
+		newPass = *p
+	} else if err := s.checkNewPassword(ps, newPass); err != nil {
+		return nil, err
+	}
+
+	pass, err := s.changePassword(ctx, ins, newPass, ps, func(p *user.Password) {
+		// 重置后的密码用户下次登录时必须修改
+		reason := req.ResetReason
+		if reason == "" {
+			reason = "password reset by admin, need change when next login"
+		}
+		p.SetNeedReset(reason)
+		p.ForceReset = true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pass.TemporaryPassword = newPass
+	return pass, nil
+}
+
+// 通过验证码找回密码, 修改后用户之前的令牌全部失效
+func (s *service) RecoverPassword(ctx context.Context, req *user.RecoverPasswordRequest) (*user.Password, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, req.DescribeUserRequest())
+	if err != nil {
+		if exception.IsNotFoundError(err) {
+			return nil, exception.NewPermissionDeny("verify code invalidate")
+		}
+		return nil, err
+	}
+
+	// 先校验密码策略, 避免验证码被无效的请求消耗掉
+	ps, err := s.passwordSecurity(ctx, ins.Spec.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNewPassword(ps, req.NewPass); err != nil {
+		return nil, err
+	}
+
+	_, err = s.code.VerifyCode(ctx, code.NewResetPasswordVerifyCodeRequest(ins.Id, req.Code))
+	if err != nil {
+		return nil, exception.NewPermissionDeny("verify code invalidate, %s", err)
+	}
+
+	pass, err := s.changePassword(ctx, ins, req.NewPass, ps)
+	if err != nil {
+		return nil, err
+	}
+
+	// 撤销用户之前颁发的令牌
+	_, err = s.token.BlockUserToken(ctx, token.NewBlockUserTokenRequest(
+		ins.Id,
+		token.BLOCK_TYPE_PASSWORD_CHANGED,
+		"password changed by forgot password",
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	// 通知失败不影响密码修改
+	if err := s.sendPasswordChanged(ctx, ins); err != nil {
+		s.log.Errorf("send password changed notify to user %s error, %s", ins.Spec.Username, err)
+	}
+
+	return pass, nil
+}
+
+// changePassword 检查密码是否重复使用, 保存新密码
+func (s *service) changePassword(ctx context.Context, ins *user.User, newPass string, ps *domain.PasswordSecurity, opts ...func(*user.Password)) (*user.Password, error) {
+	if ins.Password.IsReused(newPass, uint(ps.RepeateLimite)) {
+		return nil, exception.NewBadRequest("password can not be the same as the last %d passwords", ps.RepeateLimite)
+	}
+
+	pass, err := ins.Password.Change(newPass, uint(ps.RepeateLimite))
+	if err != nil {
+		return nil, exception.NewInternalServerError("hash password error, %s", err)
+	}
+	for _, opt := range opts {
+		opt(pass)
+	}
+
+	ins.Password = pass
+	ins.UpdateAt = time.Now().UnixMilli()
+	if err := s.update(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	pass.Desensitize()
+	return pass, nil
+}
+
+// checkNewPassword 校验新密码是否满足密码策略, 以及是否命中泄露密码黑名单
+func (s *service) checkNewPassword(ps *domain.PasswordSecurity, pass string) error {
+	if err := password.Validate(ps, pass); err != nil {
+		return err
+	}
+
+	// 黑名单未加载时不做检测
+	result, err := s.denylist.CheckPassword(pass)
+	if err != nil {
+		s.log.Warnf("check password denylist error, %s", err)
+		return nil
+	}
+	if result.Matched {
+		return exception.NewBadRequest(result.Reason())
+	}
+	return nil
+}
+
+// passwordSecurity 用户所在域的密码策略, 域不存在时使用默认策略
+func (s *service) passwordSecurity(ctx context.Context, domainName string) (*domain.PasswordSecurity, error) {
+	d, err := s.domain.DescribeDomain(ctx, domain.NewDescribeDomainRequestByName(domainName))
+	if err != nil {
+		if exception.IsNotFoundError(err) {
+			return domain.NewDefaulPasswordSecurity(), nil
+		}
+		return nil, err
+	}
+
+	if d.Spec.SecuritySetting == nil || d.Spec.SecuritySetting.PasswordSecurity == nil {
+		return domain.NewDefaulPasswordSecurity(), nil
+	}
+	return d.Spec.SecuritySetting.PasswordSecurity, nil
+}
+
+// sendPasswordChanged 通知用户密码已修改
+func (s *service) sendPasswordChanged(ctx context.Context, u *user.User) error {
+	system, err := s.setting.GetSetting(ctx)
+	if err != nil {
+		return err
+	}
+	if err := system.Notify.CheckVerified(u); err != nil {
+		return err
+	}
+	conf := system.Notify.PasswordChanged
+	if conf == nil {
+		conf = setting.NewDefaultPasswordChanged()
+	}
+
+	now := time.Now()
+	switch system.Notify.Type {
+	case notify.NOTIFY_TYPE_MAIL:
+		if u.Profile.Email == "" {
+			return fmt.Errorf("user %s email not found", u.Spec.Username)
+		}
+		content := conf.RenderMailCentent(u.Spec.Username, now)
+		_, err := s.notify.SendMail(ctx, notify.NewSendMailRequest([]string{u.Profile.Email}, "密码修改通知", content))
+		return err
+	case notify.NOTIFY_TYPE_SMS:
+		if u.Profile.Phone == "" || conf.SmsTemplateID == "" {
+			return fmt.Errorf("user %s phone or sms template not found", u.Spec.Username)
+		}
+		req := notify.NewSendSMSRequest()
+		req.AddPhone(u.Profile.Phone)
+		req.TemplateId = conf.SmsTemplateID
+		req.AddParams(u.Spec.Username, now.Format("2006-01-02 15:04:05"))
+		_, err := s.notify.SendSMS(ctx, req)
+		return err
+	default:
+		return fmt.Errorf("unknown notify type %s", system.Notify.Type)
+	}
 }
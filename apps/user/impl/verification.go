@@ -0,0 +1,46 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/code"
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+// 校验发送到邮箱或者手机的验证码, 验证码发送后联系方式被修改时需要重新发送
+func (s *service) VerifyContact(ctx context.Context, req *user.VerifyContactRequest) (*user.User, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	ins, err := s.DescribeUser(ctx, user.NewDescriptUserRequestWithId(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	target := ins.Contact(req.Type)
+	if target == "" {
+		return nil, exception.NewBadRequest("user %s %s not set", ins.Spec.Username, req.Type)
+	}
+
+	purpose := code.PURPOSE_VERIFY_EMAIL
+	if req.Type.Equal(user.CONTACT_TYPE_PHONE) {
+		purpose = code.PURPOSE_VERIFY_PHONE
+	}
+	c, err := s.code.VerifyCode(ctx, code.NewVerifyContactCodeRequest(ins.Id, req.Code, purpose))
+	if err != nil {
+		return nil, exception.NewPermissionDeny("verify code invalidate, %s", err)
+	}
+	if c.Target != target {
+		return nil, exception.NewPermissionDeny("%s changed after verify code issued, please verify again", req.Type)
+	}
+
+	ins.SetVerified(req.Type)
+	if err := s.update(ctx, ins); err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
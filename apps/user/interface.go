@@ -1,6 +1,10 @@
 package user
 
-import context "context"
+import (
+	context "context"
+
+	"github.com/infraboard/mcenter/apps/domain"
+)
 
 type Service interface {
 	// 创建用户
@@ -13,6 +17,44 @@ type Service interface {
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*Password, error)
 	// 重置密码, 无需知道原先密码, 主账号执行
 	ResetPassword(context.Context, *ResetPasswordRequest) (*Password, error)
+	// 通过验证码找回密码, 用户忘记密码时使用
+	RecoverPassword(context.Context, *RecoverPasswordRequest) (*Password, error)
+	// 登录成功后, 密码hash算法或者参数过时时重新计算hash
+	UpgradePasswordHash(context.Context, *UpgradePasswordHashRequest) (*User, error)
+	// 标记用户当前密码是否命中泄露密码黑名单
+	MarkPasswordBreached(context.Context, *MarkPasswordBreachedRequest) (*User, error)
+	// 邀请用户, 创建待激活的用户并通过邮件发送激活链接
+	InviteUser(context.Context, *InviteUserRequest) (*User, error)
+	// 重新发送激活链接, 之前发送的链接失效
+	ResendInvitation(context.Context, *ResendInvitationRequest) (*User, error)
+	// 撤销邀请, 删除未激活的用户
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*User, error)
+	// 被邀请人通过激活链接设置密码和个人信息
+	ActivateUser(context.Context, *ActivateUserRequest) (*User, error)
+	// 校验发送到邮箱或者手机的验证码, 标记联系方式已验证
+	VerifyContact(context.Context, *VerifyContactRequest) (*User, error)
+	// 把用户加入其他域, 已经是成员时修改用户在该域中的类型
+	AddDomainMember(context.Context, *AddDomainMemberRequest) (*User, error)
+	// 把用户移出其他域, 用户在该域中的令牌全部失效
+	RemoveDomainMember(context.Context, *RemoveDomainMemberRequest) (*User, error)
+	// 冻结/解冻用户
+	UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*User, error)
+	// 修改用户的生命周期状态和生效时间, 用户不可用时撤销所有令牌
+	ChangeUserState(context.Context, *ChangeUserStateRequest) (*User, error)
+	// 查询用户状态变更记录
+	QueryStatusEvent(context.Context, *QueryStatusEventRequest) (*StatusEventSet, error)
+	// 注销用户, 删除用户和头像, 状态变更记录保留但用户名替换为匿名标识
+	EraseUser(context.Context, *EraseUserRequest) (*User, error)
+	// 删除域下所有的用户, 清除域时调用
+	DeleteDomainResource(context.Context, *domain.DeleteDomainResourceRequest) (*domain.DeleteDomainResourceResponse, error)
+	// 统计域下的用户数量, 包含从其他域加入的成员, 用于配额检查
+	CountDomainResource(context.Context, *domain.CountDomainResourceRequest) (*domain.CountDomainResourceResponse, error)
+	// 上传头像, 生成标准尺寸的缩略图, 替换之前的头像
+	UploadAvatar(context.Context, *UploadAvatarRequest) (*User, error)
+	// 删除上传的头像
+	DeleteAvatar(context.Context, *DeleteAvatarRequest) (*User, error)
+	// 下载头像
+	DownloadAvatar(context.Context, *DownloadAvatarRequest) error
 	// RPC服务
 	RPCServer
 }
@@ -0,0 +1,106 @@
+package user
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// 激活令牌各字段之间的分隔符
+	INVITATION_TOKEN_SEP = "."
+)
+
+// NewInvitation 邀请信息
+func NewInvitation(inviteBy, email string) *Invitation {
+	return &Invitation{
+		InviteBy: inviteBy,
+		Email:    email,
+		InviteAt: time.Now().UnixMilli(),
+	}
+}
+
+// Renew 生成新的随机数和过期时间, 之前发送的激活链接失效
+func (i *Invitation) Renew(expire time.Duration) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	now := time.Now()
+	i.Nonce = base64.RawURLEncoding.EncodeToString(b)
+	i.SendAt = now.UnixMilli()
+	i.SendCount++
+	i.ExpiredAt = now.Add(expire).UnixMilli()
+}
+
+// IsExpired 激活链接是否已经过期
+func (i *Invitation) IsExpired() bool {
+	return time.Now().UnixMilli() > i.ExpiredAt
+}
+
+// Sign 生成激活链接中携带的令牌, 格式: base64(用户Id.随机数.过期时间).base64(签名)
+func (i *Invitation) Sign(userId, key string) string {
+	payload := strings.Join([]string{userId, i.Nonce, strconv.FormatInt(i.ExpiredAt, 10)}, INVITATION_TOKEN_SEP)
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) +
+		INVITATION_TOKEN_SEP +
+		base64.RawURLEncoding.EncodeToString(invitationSignature(payload, key))
+}
+
+// InvitationToken 解析后的激活令牌
+type InvitationToken struct {
+	UserId    string
+	Nonce     string
+	ExpiredAt int64
+}
+
+// ParseInvitationToken 校验签名并解析激活令牌
+func ParseInvitationToken(token, key string) (*InvitationToken, error) {
+	parts := strings.Split(token, INVITATION_TOKEN_SEP)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid invitation token format")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("decode invitation token error, %s", err)
+	}
+	sign, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("decode invitation token signature error, %s", err)
+	}
+	if !hmac.Equal(sign, invitationSignature(string(payload), key)) {
+		return nil, fmt.Errorf("invitation token signature not match")
+	}
+
+	fields := strings.Split(string(payload), INVITATION_TOKEN_SEP)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("invalid invitation token payload")
+	}
+	expiredAt, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invitation token expired time, %s", err)
+	}
+
+	return &InvitationToken{
+		UserId:    fields[0],
+		Nonce:     fields[1],
+		ExpiredAt: expiredAt,
+	}, nil
+}
+
+// IsExpired 令牌是否已经过期
+func (t *InvitationToken) IsExpired() bool {
+	return time.Now().UnixMilli() > t.ExpiredAt
+}
+
+func invitationSignature(payload, key string) []byte {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,154 @@
+package user
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/infraboard/mcube/http/request"
+	"github.com/rs/xid"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// 系统自动变更状态时的操作人
+	SYSTEM_OPERATOR = "system"
+	// 休眠锁定的原因
+	LOCK_REASON_DORMANT = "dormant account locked automatically"
+	// 到达失效时间自动停用的原因
+	STATE_REASON_EXPIRED = "scheduled deactivation"
+)
+
+// IsActive 用户当前是否可用, 冻结, 非ACTIVE状态, 不在生效时间内的用户都不可用
+func (u *User) IsActive() bool {
+	return u.CheckActive() == nil
+}
+
+// CheckActive 检查用户当前是否可用, 不可用时返回原因
+func (u *User) CheckActive() error {
+	if u.IsLocked() {
+		return fmt.Errorf("user %s is locked, %s", u.Spec.Username, u.Status.LockedReson)
+	}
+	if u.Status == nil {
+		return nil
+	}
+	if !u.Status.State.Equal(STATE_ACTIVE) {
+		return fmt.Errorf("user %s is %s, %s", u.Spec.Username, u.Status.State, u.Status.StateReason)
+	}
+
+	now := time.Now().UnixMilli()
+	if u.Status.ActiveFrom > 0 && now < u.Status.ActiveFrom {
+		return fmt.Errorf("user %s not active until %s", u.Spec.Username,
+			time.UnixMilli(u.Status.ActiveFrom).Format(time.RFC3339))
+	}
+	if u.Status.ActiveUntil > 0 && now >= u.Status.ActiveUntil {
+		return fmt.Errorf("user %s expired at %s", u.Spec.Username,
+			time.UnixMilli(u.Status.ActiveUntil).Format(time.RFC3339))
+	}
+	return nil
+}
+
+// ChangeState 修改生命周期状态和生效时间
+func (u *User) ChangeState(req *ChangeUserStateRequest) {
+	if u.Status == nil {
+		u.Status = &Status{}
+	}
+	if !u.Status.State.Equal(req.State) {
+		u.Status.State = req.State
+		u.Status.StateAt = time.Now().UnixMilli()
+	}
+	u.Status.StateReason = req.Reason
+	u.Status.ActiveFrom = req.ActiveFrom
+	u.Status.ActiveUntil = req.ActiveUntil
+}
+
+// CloneStatus 状态快照, 用于记录变更前后的状态
+func (u *User) CloneStatus() *Status {
+	if u.Status == nil {
+		return &Status{}
+	}
+	return proto.Clone(u.Status).(*Status)
+}
+
+func NewChangeUserStateRequest(userId string, state STATE, reason string) *ChangeUserStateRequest {
+	return &ChangeUserStateRequest{
+		UserId: userId,
+		State:  state,
+		Reason: reason,
+	}
+}
+
+func (req *ChangeUserStateRequest) Validate() error {
+	if err := validate.Struct(req); err != nil {
+		return err
+	}
+	if req.ActiveFrom > 0 && req.ActiveUntil > 0 && req.ActiveFrom >= req.ActiveUntil {
+		return fmt.Errorf("active_from must before active_until")
+	}
+	return nil
+}
+
+// NewStatusEvent 用户状态变更记录
+func NewStatusEvent(u *User, t STATUS_EVENT_TYPE, from *Status, reason, operator string) *StatusEvent {
+	if operator == "" {
+		operator = SYSTEM_OPERATOR
+	}
+	return &StatusEvent{
+		Id:       xid.New().String(),
+		CreateAt: time.Now().UnixMilli(),
+		Domain:   u.Spec.Domain,
+		UserId:   u.Id,
+		Username: u.Spec.Username,
+		Type:     t,
+		From:     from,
+		To:       u.CloneStatus(),
+		Reason:   reason,
+		Operator: operator,
+	}
+}
+
+// Summary 变更内容, 用于通知
+func (e *StatusEvent) Summary() string {
+	switch e.Type {
+	case STATUS_EVENT_TYPE_LOCK:
+		return "账号已冻结"
+	case STATUS_EVENT_TYPE_UNLOCK:
+		return "账号已解冻"
+	default:
+		return fmt.Sprintf("账号状态变更为%s", e.To.State)
+	}
+}
+
+func NewStatusEventSet() *StatusEventSet {
+	return &StatusEventSet{
+		Items: []*StatusEvent{},
+	}
+}
+
+func (s *StatusEventSet) Add(item *StatusEvent) {
+	s.Items = append(s.Items, item)
+}
+
+func NewQueryStatusEventRequest() *QueryStatusEventRequest {
+	return &QueryStatusEventRequest{
+		Page: request.NewPageRequest(20, 1),
+	}
+}
+
+func NewQueryStatusEventRequestFromHTTP(r *http.Request) *QueryStatusEventRequest {
+	req := NewQueryStatusEventRequest()
+	req.Page = request.NewPageRequestFromHTTP(r)
+	req.Domain = r.URL.Query().Get("domain")
+	return req
+}
+
+func NewEraseUserRequest(userId, pseudonym string) *EraseUserRequest {
+	return &EraseUserRequest{
+		UserId:    userId,
+		Pseudonym: pseudonym,
+	}
+}
+
+func (req *EraseUserRequest) Validate() error {
+	return validate.Struct(req)
+}
@@ -0,0 +1,93 @@
+package user
+
+import (
+	"fmt"
+	"time"
+)
+
+// AvailableDomains 用户可以切换的域, 用户所属的域排在第一个
+func (u *User) AvailableDomains() []string {
+	domains := []string{u.Spec.Domain}
+	for _, m := range u.Memberships {
+		domains = append(domains, m.Domain)
+	}
+	return domains
+}
+
+// DomainType 用户在域中的类型, 不是该域的成员时返回false
+func (u *User) DomainType(domain string) (TYPE, bool) {
+	if u.Spec.Domain == domain {
+		return u.Spec.Type, true
+	}
+	m := u.GetMembership(domain)
+	if m == nil {
+		return TYPE_SUB, false
+	}
+	return m.Type, true
+}
+
+// GetMembership 用户在其他域中的成员身份
+func (u *User) GetMembership(domain string) *DomainMembership {
+	for _, m := range u.Memberships {
+		if m.Domain == domain {
+			return m
+		}
+	}
+	return nil
+}
+
+// AddMembership 加入其他域, 已经是成员时修改类型
+func (u *User) AddMembership(req *AddDomainMemberRequest) {
+	if m := u.GetMembership(req.Domain); m != nil {
+		m.Type = req.Type
+		m.Operator = req.Operator
+		return
+	}
+	u.Memberships = append(u.Memberships, &DomainMembership{
+		Domain:   req.Domain,
+		Type:     req.Type,
+		JoinAt:   time.Now().UnixMilli(),
+		Operator: req.Operator,
+	})
+}
+
+// RemoveMembership 移出其他域, 不是该域的成员时返回false
+func (u *User) RemoveMembership(domain string) bool {
+	for i, m := range u.Memberships {
+		if m.Domain == domain {
+			u.Memberships = append(u.Memberships[:i], u.Memberships[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func NewAddDomainMemberRequest(userId, domain string, t TYPE) *AddDomainMemberRequest {
+	return &AddDomainMemberRequest{
+		UserId: userId,
+		Domain: domain,
+		Type:   t,
+	}
+}
+
+func (req *AddDomainMemberRequest) Validate() error {
+	if err := validate.Struct(req); err != nil {
+		return err
+	}
+	// 超级管理员是全局的, 不能通过加入域获得
+	if !req.Type.IsIn(TYPE_SUB, TYPE_PRIMARY) {
+		return fmt.Errorf("domain member type must be %s or %s", TYPE_SUB, TYPE_PRIMARY)
+	}
+	return nil
+}
+
+func NewRemoveDomainMemberRequest(userId, domain string) *RemoveDomainMemberRequest {
+	return &RemoveDomainMemberRequest{
+		UserId: userId,
+		Domain: domain,
+	}
+}
+
+func (req *RemoveDomainMemberRequest) Validate() error {
+	return validate.Struct(req)
+}
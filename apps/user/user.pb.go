@@ -20,6 +20,116 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 账号生命周期状态
+type STATE int32
+
+const (
+	// 正常
+	STATE_ACTIVE STATE = 0
+	// 暂停使用, 比如休假, 可以恢复
+	STATE_SUSPENDED STATE = 1
+	// 停用, 比如离职
+	STATE_DISABLED STATE = 2
+	// 等待删除
+	STATE_PENDING_DELETION STATE = 3
+)
+
+// Enum value maps for STATE.
+var (
+	STATE_name = map[int32]string{
+		0: "ACTIVE",
+		1: "SUSPENDED",
+		2: "DISABLED",
+		3: "PENDING_DELETION",
+	}
+	STATE_value = map[string]int32{
+		"ACTIVE":           0,
+		"SUSPENDED":        1,
+		"DISABLED":         2,
+		"PENDING_DELETION": 3,
+	}
+)
+
+func (x STATE) Enum() *STATE {
+	p := new(STATE)
+	*p = x
+	return p
+}
+
+func (x STATE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (STATE) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_user_pb_user_proto_enumTypes[0].Descriptor()
+}
+
+func (STATE) Type() protoreflect.EnumType {
+	return &file_apps_user_pb_user_proto_enumTypes[0]
+}
+
+func (x STATE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use STATE.Descriptor instead.
+func (STATE) EnumDescriptor() ([]byte, []int) {
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{0}
+}
+
+// 用户状态变更类型
+type STATUS_EVENT_TYPE int32
+
+const (
+	// 冻结
+	STATUS_EVENT_TYPE_LOCK STATUS_EVENT_TYPE = 0
+	// 解冻
+	STATUS_EVENT_TYPE_UNLOCK STATUS_EVENT_TYPE = 1
+	// 生命周期状态或者生效时间变更
+	STATUS_EVENT_TYPE_STATE_CHANGE STATUS_EVENT_TYPE = 2
+)
+
+// Enum value maps for STATUS_EVENT_TYPE.
+var (
+	STATUS_EVENT_TYPE_name = map[int32]string{
+		0: "LOCK",
+		1: "UNLOCK",
+		2: "STATE_CHANGE",
+	}
+	STATUS_EVENT_TYPE_value = map[string]int32{
+		"LOCK":         0,
+		"UNLOCK":       1,
+		"STATE_CHANGE": 2,
+	}
+)
+
+func (x STATUS_EVENT_TYPE) Enum() *STATUS_EVENT_TYPE {
+	p := new(STATUS_EVENT_TYPE)
+	*p = x
+	return p
+}
+
+func (x STATUS_EVENT_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (STATUS_EVENT_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_user_pb_user_proto_enumTypes[1].Descriptor()
+}
+
+func (STATUS_EVENT_TYPE) Type() protoreflect.EnumType {
+	return &file_apps_user_pb_user_proto_enumTypes[1]
+}
+
+func (x STATUS_EVENT_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use STATUS_EVENT_TYPE.Descriptor instead.
+func (STATUS_EVENT_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{1}
+}
+
 type PROVIDER int32
 
 const (
@@ -27,6 +137,8 @@ const (
 	PROVIDER_LOCAL PROVIDER = 0
 	// 来源LDAP
 	PROVIDER_LDAP PROVIDER = 1
+	// 来源上游OIDC
+	PROVIDER_OIDC PROVIDER = 2
 )
 
 // Enum value maps for PROVIDER.
@@ -34,10 +146,12 @@ var (
 	PROVIDER_name = map[int32]string{
 		0: "LOCAL",
 		1: "LDAP",
+		2: "OIDC",
 	}
 	PROVIDER_value = map[string]int32{
 		"LOCAL": 0,
 		"LDAP":  1,
+		"OIDC":  2,
 	}
 )
 
@@ -52,11 +166,11 @@ func (x PROVIDER) String() string {
 }
 
 func (PROVIDER) Descriptor() protoreflect.EnumDescriptor {
-	return file_apps_user_pb_user_proto_enumTypes[0].Descriptor()
+	return file_apps_user_pb_user_proto_enumTypes[2].Descriptor()
 }
 
 func (PROVIDER) Type() protoreflect.EnumType {
-	return &file_apps_user_pb_user_proto_enumTypes[0]
+	return &file_apps_user_pb_user_proto_enumTypes[2]
 }
 
 func (x PROVIDER) Number() protoreflect.EnumNumber {
@@ -65,7 +179,7 @@ func (x PROVIDER) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PROVIDER.Descriptor instead.
 func (PROVIDER) EnumDescriptor() ([]byte, []int) {
-	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{0}
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{2}
 }
 
 // 为了防止越权, 用户可以调整的权限范围只有10已下的权限
@@ -105,11 +219,11 @@ func (x TYPE) String() string {
 }
 
 func (TYPE) Descriptor() protoreflect.EnumDescriptor {
-	return file_apps_user_pb_user_proto_enumTypes[1].Descriptor()
+	return file_apps_user_pb_user_proto_enumTypes[3].Descriptor()
 }
 
 func (TYPE) Type() protoreflect.EnumType {
-	return &file_apps_user_pb_user_proto_enumTypes[1]
+	return &file_apps_user_pb_user_proto_enumTypes[3]
 }
 
 func (x TYPE) Number() protoreflect.EnumNumber {
@@ -118,7 +232,56 @@ func (x TYPE) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TYPE.Descriptor instead.
 func (TYPE) EnumDescriptor() ([]byte, []int) {
-	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{1}
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{3}
+}
+
+// 联系方式
+type CONTACT_TYPE int32
+
+const (
+	// 邮箱
+	CONTACT_TYPE_MAIL CONTACT_TYPE = 0
+	// 手机
+	CONTACT_TYPE_PHONE CONTACT_TYPE = 1
+)
+
+// Enum value maps for CONTACT_TYPE.
+var (
+	CONTACT_TYPE_name = map[int32]string{
+		0: "MAIL",
+		1: "PHONE",
+	}
+	CONTACT_TYPE_value = map[string]int32{
+		"MAIL":  0,
+		"PHONE": 1,
+	}
+)
+
+func (x CONTACT_TYPE) Enum() *CONTACT_TYPE {
+	p := new(CONTACT_TYPE)
+	*p = x
+	return p
+}
+
+func (x CONTACT_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CONTACT_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_apps_user_pb_user_proto_enumTypes[4].Descriptor()
+}
+
+func (CONTACT_TYPE) Type() protoreflect.EnumType {
+	return &file_apps_user_pb_user_proto_enumTypes[4]
+}
+
+func (x CONTACT_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CONTACT_TYPE.Descriptor instead.
+func (CONTACT_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{4}
 }
 
 type Gender int32
@@ -157,11 +320,11 @@ func (x Gender) String() string {
 }
 
 func (Gender) Descriptor() protoreflect.EnumDescriptor {
-	return file_apps_user_pb_user_proto_enumTypes[2].Descriptor()
+	return file_apps_user_pb_user_proto_enumTypes[5].Descriptor()
 }
 
 func (Gender) Type() protoreflect.EnumType {
-	return &file_apps_user_pb_user_proto_enumTypes[2]
+	return &file_apps_user_pb_user_proto_enumTypes[5]
 }
 
 func (x Gender) Number() protoreflect.EnumNumber {
@@ -170,7 +333,7 @@ func (x Gender) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Gender.Descriptor instead.
 func (Gender) EnumDescriptor() ([]byte, []int) {
-	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{2}
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{5}
 }
 
 type CREATE_BY int32
@@ -180,6 +343,10 @@ const (
 	CREATE_BY_ADMIN CREATE_BY = 0
 	// 自己注册
 	CREATE_BY_SELF CREATE_BY = 1
+	// 系统初始化创建
+	CREATE_BY_SYSTEM CREATE_BY = 2
+	// 外部系统同步, 比如SCIM, LDAP, 批量导入
+	CREATE_BY_SYNC CREATE_BY = 3
 )
 
 // Enum value maps for CREATE_BY.
@@ -187,10 +354,14 @@ var (
 	CREATE_BY_name = map[int32]string{
 		0: "ADMIN",
 		1: "SELF",
+		2: "SYSTEM",
+		3: "SYNC",
 	}
 	CREATE_BY_value = map[string]int32{
-		"ADMIN": 0,
-		"SELF":  1,
+		"ADMIN":  0,
+		"SELF":   1,
+		"SYSTEM": 2,
+		"SYNC":   3,
 	}
 )
 
@@ -205,11 +376,11 @@ func (x CREATE_BY) String() string {
 }
 
 func (CREATE_BY) Descriptor() protoreflect.EnumDescriptor {
-	return file_apps_user_pb_user_proto_enumTypes[3].Descriptor()
+	return file_apps_user_pb_user_proto_enumTypes[6].Descriptor()
 }
 
 func (CREATE_BY) Type() protoreflect.EnumType {
-	return &file_apps_user_pb_user_proto_enumTypes[3]
+	return &file_apps_user_pb_user_proto_enumTypes[6]
 }
 
 func (x CREATE_BY) Number() protoreflect.EnumNumber {
@@ -218,7 +389,7 @@ func (x CREATE_BY) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CREATE_BY.Descriptor instead.
 func (CREATE_BY) EnumDescriptor() ([]byte, []int) {
-	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{3}
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{6}
 }
 
 type DESCRIBE_BY int32
@@ -228,6 +399,8 @@ const (
 	DESCRIBE_BY_USER_ID DESCRIBE_BY = 0
 	// 通过Username查询用户
 	DESCRIBE_BY_USER_NAME DESCRIBE_BY = 1
+	// 通过邮箱查询用户
+	DESCRIBE_BY_EMAIL DESCRIBE_BY = 2
 )
 
 // Enum value maps for DESCRIBE_BY.
@@ -235,10 +408,12 @@ var (
 	DESCRIBE_BY_name = map[int32]string{
 		0: "USER_ID",
 		1: "USER_NAME",
+		2: "EMAIL",
 	}
 	DESCRIBE_BY_value = map[string]int32{
 		"USER_ID":   0,
 		"USER_NAME": 1,
+		"EMAIL":     2,
 	}
 )
 
@@ -253,11 +428,11 @@ func (x DESCRIBE_BY) String() string {
 }
 
 func (DESCRIBE_BY) Descriptor() protoreflect.EnumDescriptor {
-	return file_apps_user_pb_user_proto_enumTypes[4].Descriptor()
+	return file_apps_user_pb_user_proto_enumTypes[7].Descriptor()
 }
 
 func (DESCRIBE_BY) Type() protoreflect.EnumType {
-	return &file_apps_user_pb_user_proto_enumTypes[4]
+	return &file_apps_user_pb_user_proto_enumTypes[7]
 }
 
 func (x DESCRIBE_BY) Number() protoreflect.EnumNumber {
@@ -266,7 +441,7 @@ func (x DESCRIBE_BY) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DESCRIBE_BY.Descriptor instead.
 func (DESCRIBE_BY) EnumDescriptor() ([]byte, []int) {
-	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{4}
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{7}
 }
 
 type Password struct {
@@ -298,6 +473,24 @@ type Password struct {
 	// 历史密码
 	// @gotags: bson:"history" json:"history,omitempty"
 	History []string `protobuf:"bytes,8,rep,name=history,proto3" json:"history,omitempty" bson:"history"`
+	// 临时密码, 管理员重置密码时返回, 不做存储
+	// @gotags: bson:"-" json:"temporary_password,omitempty"
+	TemporaryPassword string `protobuf:"bytes,9,opt,name=temporary_password,json=temporaryPassword,proto3" json:"temporary_password,omitempty" bson:"-"`
+	// 当前密码命中泄露密码黑名单, 登录时检测
+	// @gotags: bson:"breached" json:"breached"
+	Breached bool `protobuf:"varint,10,opt,name=breached,proto3" json:"breached" bson:"breached"`
+	// 命中黑名单的原因
+	// @gotags: bson:"breached_reason" json:"breached_reason,omitempty"
+	BreachedReason string `protobuf:"bytes,11,opt,name=breached_reason,json=breachedReason,proto3" json:"breached_reason,omitempty" bson:"breached_reason"`
+	// 检测结果变化的时间
+	// @gotags: bson:"breached_check_at" json:"breached_check_at"
+	BreachedCheckAt int64 `protobuf:"varint,12,opt,name=breached_check_at,json=breachedCheckAt,proto3" json:"breached_check_at" bson:"breached_check_at"`
+	// 密码hash算法, 为空时为bcrypt, 算法参数编码在hash中
+	// @gotags: bson:"hash_algorithm" json:"hash_algorithm"
+	HashAlgorithm string `protobuf:"bytes,13,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm" bson:"hash_algorithm"`
+	// 管理员重置的密码, 用户修改密码前不允许登录
+	// @gotags: bson:"force_reset" json:"force_reset"
+	ForceReset bool `protobuf:"varint,14,opt,name=force_reset,json=forceReset,proto3" json:"force_reset" bson:"force_reset"`
 }
 
 func (x *Password) Reset() {
@@ -388,6 +581,48 @@ func (x *Password) GetHistory() []string {
 	return nil
 }
 
+func (x *Password) GetTemporaryPassword() string {
+	if x != nil {
+		return x.TemporaryPassword
+	}
+	return ""
+}
+
+func (x *Password) GetBreached() bool {
+	if x != nil {
+		return x.Breached
+	}
+	return false
+}
+
+func (x *Password) GetBreachedReason() string {
+	if x != nil {
+		return x.BreachedReason
+	}
+	return ""
+}
+
+func (x *Password) GetBreachedCheckAt() int64 {
+	if x != nil {
+		return x.BreachedCheckAt
+	}
+	return 0
+}
+
+func (x *Password) GetHashAlgorithm() string {
+	if x != nil {
+		return x.HashAlgorithm
+	}
+	return ""
+}
+
+func (x *Password) GetForceReset() bool {
+	if x != nil {
+		return x.ForceReset
+	}
+	return false
+}
+
 // Status 用户状态
 type Status struct {
 	state         protoimpl.MessageState
@@ -406,25 +641,366 @@ type Status struct {
 	// 解冻时间
 	// @gotags: bson:"unlock_time" json:"unlock_time"
 	UnlockTime int64 `protobuf:"varint,4,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time" bson:"unlock_time"`
+	// 生命周期状态
+	// @gotags: bson:"state" json:"state"
+	State STATE `protobuf:"varint,5,opt,name=state,proto3,enum=infraboard.mcenter.user.STATE" json:"state" bson:"state"`
+	// 状态变更时间
+	// @gotags: bson:"state_at" json:"state_at"
+	StateAt int64 `protobuf:"varint,6,opt,name=state_at,json=stateAt,proto3" json:"state_at" bson:"state_at"`
+	// 状态变更原因
+	// @gotags: bson:"state_reason" json:"state_reason"
+	StateReason string `protobuf:"bytes,7,opt,name=state_reason,json=stateReason,proto3" json:"state_reason" bson:"state_reason"`
+	// 账号生效时间, 比如外包人员的入场时间, 为0表示不限制
+	// @gotags: bson:"active_from" json:"active_from"
+	ActiveFrom int64 `protobuf:"varint,8,opt,name=active_from,json=activeFrom,proto3" json:"active_from" bson:"active_from"`
+	// 账号失效时间, 到期后自动停用, 为0表示不限制
+	// @gotags: bson:"active_until" json:"active_until"
+	ActiveUntil int64 `protobuf:"varint,9,opt,name=active_until,json=activeUntil,proto3" json:"active_until" bson:"active_until"`
 }
 
 func (x *Status) Reset() {
 	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_user_pb_user_proto_msgTypes[1]
+		mi := &file_apps_user_pb_user_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_user_pb_user_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Status) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *Status) GetLockedTime() int64 {
+	if x != nil {
+		return x.LockedTime
+	}
+	return 0
+}
+
+func (x *Status) GetLockedReson() string {
+	if x != nil {
+		return x.LockedReson
+	}
+	return ""
+}
+
+func (x *Status) GetUnlockTime() int64 {
+	if x != nil {
+		return x.UnlockTime
+	}
+	return 0
+}
+
+func (x *Status) GetState() STATE {
+	if x != nil {
+		return x.State
+	}
+	return STATE_ACTIVE
+}
+
+func (x *Status) GetStateAt() int64 {
+	if x != nil {
+		return x.StateAt
+	}
+	return 0
+}
+
+func (x *Status) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+func (x *Status) GetActiveFrom() int64 {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return 0
+}
+
+func (x *Status) GetActiveUntil() int64 {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return 0
+}
+
+// StatusEvent 用户状态变更审计记录
+type StatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 记录Id
+	// @gotags: bson:"_id" json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id"`
+	// 变更时间
+	// @gotags: bson:"create_at" json:"create_at"
+	CreateAt int64 `protobuf:"varint,2,opt,name=create_at,json=createAt,proto3" json:"create_at" bson:"create_at"`
+	// 用户所属域
+	// @gotags: bson:"domain" json:"domain"
+	Domain string `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain" bson:"domain"`
+	// 用户Id
+	// @gotags: bson:"user_id" json:"user_id"
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id" bson:"user_id"`
+	// 用户名
+	// @gotags: bson:"username" json:"username"
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username" bson:"username"`
+	// 变更类型
+	// @gotags: bson:"type" json:"type"
+	Type STATUS_EVENT_TYPE `protobuf:"varint,6,opt,name=type,proto3,enum=infraboard.mcenter.user.STATUS_EVENT_TYPE" json:"type" bson:"type"`
+	// 变更前的状态
+	// @gotags: bson:"from" json:"from"
+	From *Status `protobuf:"bytes,7,opt,name=from,proto3" json:"from" bson:"from"`
+	// 变更后的状态
+	// @gotags: bson:"to" json:"to"
+	To *Status `protobuf:"bytes,8,opt,name=to,proto3" json:"to" bson:"to"`
+	// 变更原因
+	// @gotags: bson:"reason" json:"reason"
+	Reason string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason" bson:"reason"`
+	// 操作人, 系统自动变更时为system
+	// @gotags: bson:"operator" json:"operator"
+	Operator string `protobuf:"bytes,10,opt,name=operator,proto3" json:"operator" bson:"operator"`
+}
+
+func (x *StatusEvent) Reset() {
+	*x = StatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_user_pb_user_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusEvent) ProtoMessage() {}
+
+func (x *StatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_user_pb_user_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusEvent.ProtoReflect.Descriptor instead.
+func (*StatusEvent) Descriptor() ([]byte, []int) {
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StatusEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetCreateAt() int64 {
+	if x != nil {
+		return x.CreateAt
+	}
+	return 0
+}
+
+func (x *StatusEvent) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetType() STATUS_EVENT_TYPE {
+	if x != nil {
+		return x.Type
+	}
+	return STATUS_EVENT_TYPE_LOCK
+}
+
+func (x *StatusEvent) GetFrom() *Status {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *StatusEvent) GetTo() *Status {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *StatusEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type StatusEventSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 总数量
+	// @gotags: bson:"total" json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total" bson:"total"`
+	// 数据项
+	// @gotags: bson:"items" json:"items"
+	Items []*StatusEvent `protobuf:"bytes,2,rep,name=items,proto3" json:"items" bson:"items"`
+}
+
+func (x *StatusEventSet) Reset() {
+	*x = StatusEventSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_user_pb_user_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusEventSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusEventSet) ProtoMessage() {}
+
+func (x *StatusEventSet) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_user_pb_user_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusEventSet.ProtoReflect.Descriptor instead.
+func (*StatusEventSet) Descriptor() ([]byte, []int) {
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StatusEventSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *StatusEventSet) GetItems() []*StatusEvent {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Invitation 用户邀请信息
+type Invitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 邀请人
+	// @gotags: bson:"invite_by" json:"invite_by"
+	InviteBy string `protobuf:"bytes,1,opt,name=invite_by,json=inviteBy,proto3" json:"invite_by" bson:"invite_by"`
+	// 激活链接发送的邮箱
+	// @gotags: bson:"email" json:"email"
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email" bson:"email"`
+	// 邀请时间
+	// @gotags: bson:"invite_at" json:"invite_at"
+	InviteAt int64 `protobuf:"varint,3,opt,name=invite_at,json=inviteAt,proto3" json:"invite_at" bson:"invite_at"`
+	// 最近一次发送激活链接的时间
+	// @gotags: bson:"send_at" json:"send_at"
+	SendAt int64 `protobuf:"varint,4,opt,name=send_at,json=sendAt,proto3" json:"send_at" bson:"send_at"`
+	// 激活链接发送次数
+	// @gotags: bson:"send_count" json:"send_count"
+	SendCount int32 `protobuf:"varint,5,opt,name=send_count,json=sendCount,proto3" json:"send_count" bson:"send_count"`
+	// 激活链接过期时间
+	// @gotags: bson:"expired_at" json:"expired_at"
+	ExpiredAt int64 `protobuf:"varint,6,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at" bson:"expired_at"`
+	// 激活链接签名使用的随机数, 重新发送后之前的链接失效
+	// @gotags: bson:"nonce" json:"-"
+	Nonce string `protobuf:"bytes,7,opt,name=nonce,proto3" json:"-" bson:"nonce"`
+	// 激活时间, 为0表示还未激活
+	// @gotags: bson:"activated_at" json:"activated_at"
+	ActivatedAt int64 `protobuf:"varint,8,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at" bson:"activated_at"`
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_user_pb_user_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Status) String() string {
+func (x *Invitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*Invitation) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_user_pb_user_proto_msgTypes[1]
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_user_pb_user_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,35 +1011,63 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
-	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Status) GetLocked() bool {
+func (x *Invitation) GetInviteBy() string {
 	if x != nil {
-		return x.Locked
+		return x.InviteBy
 	}
-	return false
+	return ""
 }
 
-func (x *Status) GetLockedTime() int64 {
+func (x *Invitation) GetEmail() string {
 	if x != nil {
-		return x.LockedTime
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetInviteAt() int64 {
+	if x != nil {
+		return x.InviteAt
 	}
 	return 0
 }
 
-func (x *Status) GetLockedReson() string {
+func (x *Invitation) GetSendAt() int64 {
 	if x != nil {
-		return x.LockedReson
+		return x.SendAt
+	}
+	return 0
+}
+
+func (x *Invitation) GetSendCount() int32 {
+	if x != nil {
+		return x.SendCount
+	}
+	return 0
+}
+
+func (x *Invitation) GetExpiredAt() int64 {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return 0
+}
+
+func (x *Invitation) GetNonce() string {
+	if x != nil {
+		return x.Nonce
 	}
 	return ""
 }
 
-func (x *Status) GetUnlockTime() int64 {
+func (x *Invitation) GetActivatedAt() int64 {
 	if x != nil {
-		return x.UnlockTime
+		return x.ActivatedAt
 	}
 	return 0
 }
@@ -498,12 +1102,24 @@ type User struct {
 	// 用户状态
 	// @gotags: bson:"status" json:"status"
 	Status *Status `protobuf:"bytes,8,opt,name=status,proto3" json:"status" bson:"status"`
+	// 邀请信息, 通过邀请创建的用户才有
+	// @gotags: bson:"invitation" json:"invitation,omitempty"
+	Invitation *Invitation `protobuf:"bytes,9,opt,name=invitation,proto3" json:"invitation,omitempty" bson:"invitation"`
+	// 邮箱和手机的验证状态, 修改邮箱或者手机后需要重新验证
+	// @gotags: bson:"verification" json:"verification"
+	Verification *Verification `protobuf:"bytes,10,opt,name=verification,proto3" json:"verification" bson:"verification"`
+	// 上传的头像文件Id, 头像的不同尺寸保存在storage中
+	// @gotags: bson:"avatar_id" json:"avatar_id,omitempty"
+	AvatarId string `protobuf:"bytes,11,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty" bson:"avatar_id"`
+	// 用户加入的其他域, 用户所属的域(spec.domain)不在其中
+	// @gotags: bson:"memberships" json:"memberships,omitempty"
+	Memberships []*DomainMembership `protobuf:"bytes,12,rep,name=memberships,proto3" json:"memberships,omitempty" bson:"memberships"`
 }
 
 func (x *User) Reset() {
 	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_user_pb_user_proto_msgTypes[2]
+		mi := &file_apps_user_pb_user_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -516,7 +1132,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_user_pb_user_proto_msgTypes[2]
+	mi := &file_apps_user_pb_user_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +1145,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{2}
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *User) GetId() string {
@@ -588,6 +1204,194 @@ func (x *User) GetStatus() *Status {
 	return nil
 }
 
+func (x *User) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+func (x *User) GetVerification() *Verification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *User) GetAvatarId() string {
+	if x != nil {
+		return x.AvatarId
+	}
+	return ""
+}
+
+func (x *User) GetMemberships() []*DomainMembership {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+// DomainMembership 用户在其他域中的成员身份
+type DomainMembership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 域名称
+	// @gotags: bson:"domain" json:"domain"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain" bson:"domain"`
+	// 用户在该域中的类型
+	// @gotags: bson:"type" json:"type"
+	Type TYPE `protobuf:"varint,2,opt,name=type,proto3,enum=infraboard.mcenter.user.TYPE" json:"type" bson:"type"`
+	// 加入时间
+	// @gotags: bson:"join_at" json:"join_at"
+	JoinAt int64 `protobuf:"varint,3,opt,name=join_at,json=joinAt,proto3" json:"join_at" bson:"join_at"`
+	// 操作人
+	// @gotags: bson:"operator" json:"operator"
+	Operator string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator" bson:"operator"`
+}
+
+func (x *DomainMembership) Reset() {
+	*x = DomainMembership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_user_pb_user_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DomainMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainMembership) ProtoMessage() {}
+
+func (x *DomainMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_user_pb_user_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainMembership.ProtoReflect.Descriptor instead.
+func (*DomainMembership) Descriptor() ([]byte, []int) {
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DomainMembership) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *DomainMembership) GetType() TYPE {
+	if x != nil {
+		return x.Type
+	}
+	return TYPE_SUB
+}
+
+func (x *DomainMembership) GetJoinAt() int64 {
+	if x != nil {
+		return x.JoinAt
+	}
+	return 0
+}
+
+func (x *DomainMembership) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// Verification 邮箱和手机的验证状态
+type Verification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 邮箱是否已验证
+	// @gotags: bson:"email_verified" json:"email_verified"
+	EmailVerified bool `protobuf:"varint,1,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified" bson:"email_verified"`
+	// 邮箱验证时间
+	// @gotags: bson:"email_verified_at" json:"email_verified_at"
+	EmailVerifiedAt int64 `protobuf:"varint,2,opt,name=email_verified_at,json=emailVerifiedAt,proto3" json:"email_verified_at" bson:"email_verified_at"`
+	// 手机是否已验证
+	// @gotags: bson:"phone_verified" json:"phone_verified"
+	PhoneVerified bool `protobuf:"varint,3,opt,name=phone_verified,json=phoneVerified,proto3" json:"phone_verified" bson:"phone_verified"`
+	// 手机验证时间
+	// @gotags: bson:"phone_verified_at" json:"phone_verified_at"
+	PhoneVerifiedAt int64 `protobuf:"varint,4,opt,name=phone_verified_at,json=phoneVerifiedAt,proto3" json:"phone_verified_at" bson:"phone_verified_at"`
+}
+
+func (x *Verification) Reset() {
+	*x = Verification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apps_user_pb_user_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Verification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Verification) ProtoMessage() {}
+
+func (x *Verification) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_user_pb_user_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Verification.ProtoReflect.Descriptor instead.
+func (*Verification) Descriptor() ([]byte, []int) {
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Verification) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *Verification) GetEmailVerifiedAt() int64 {
+	if x != nil {
+		return x.EmailVerifiedAt
+	}
+	return 0
+}
+
+func (x *Verification) GetPhoneVerified() bool {
+	if x != nil {
+		return x.PhoneVerified
+	}
+	return false
+}
+
+func (x *Verification) GetPhoneVerifiedAt() int64 {
+	if x != nil {
+		return x.PhoneVerifiedAt
+	}
+	return 0
+}
+
 // Profile todo
 type Profile struct {
 	state         protoimpl.MessageState
@@ -624,12 +1428,15 @@ type Profile struct {
 	// 用户所在的省
 	// @gotags: bson:"province" json:"province" validate:"lte=40"
 	Province string `protobuf:"bytes,10,opt,name=province,proto3" json:"province" bson:"province" validate:"lte=40"`
+	// 域自定义的用户属性, 属性定义见域的user_attributes
+	// @gotags: bson:"attributes" json:"attributes,omitempty"
+	Attributes map[string]string `protobuf:"bytes,11,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" bson:"attributes"`
 }
 
 func (x *Profile) Reset() {
 	*x = Profile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_user_pb_user_proto_msgTypes[3]
+		mi := &file_apps_user_pb_user_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -642,7 +1449,7 @@ func (x *Profile) String() string {
 func (*Profile) ProtoMessage() {}
 
 func (x *Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_user_pb_user_proto_msgTypes[3]
+	mi := &file_apps_user_pb_user_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,7 +1462,7 @@ func (x *Profile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Profile.ProtoReflect.Descriptor instead.
 func (*Profile) Descriptor() ([]byte, []int) {
-	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{3}
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Profile) GetRealName() string {
@@ -728,6 +1535,13 @@ func (x *Profile) GetProvince() string {
 	return ""
 }
 
+func (x *Profile) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // CreateUserRequest 创建用户请求
 type CreateUserRequest struct {
 	state         protoimpl.MessageState
@@ -755,12 +1569,21 @@ type CreateUserRequest struct {
 	// 用户描述
 	// @gotags: json:"description"
 	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description"`
+	// 外部系统中的用户Id, 比如通过SCIM同步的用户
+	// @gotags: json:"external_id" bson:"external_id"
+	ExternalId string `protobuf:"bytes,8,opt,name=external_id,json=externalId,proto3" json:"external_id" bson:"external_id"`
+	// 用户所属的认证源名称, 通过域的认证源登录时自动创建的用户
+	// @gotags: json:"auth_source" bson:"auth_source"
+	AuthSource string `protobuf:"bytes,9,opt,name=auth_source,json=authSource,proto3" json:"auth_source" bson:"auth_source"`
+	// 域自定义的用户属性, 创建后保存在用户的profile中
+	// @gotags: json:"attributes" bson:"-"
+	Attributes map[string]string `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" bson:"-"`
 }
 
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_user_pb_user_proto_msgTypes[4]
+		mi := &file_apps_user_pb_user_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -773,7 +1596,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_user_pb_user_proto_msgTypes[4]
+	mi := &file_apps_user_pb_user_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1609,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{4}
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateUserRequest) GetProvider() PROVIDER {
@@ -838,6 +1661,27 @@ func (x *CreateUserRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetAuthSource() string {
+	if x != nil {
+		return x.AuthSource
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 type UserSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -854,7 +1698,7 @@ type UserSet struct {
 func (x *UserSet) Reset() {
 	*x = UserSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apps_user_pb_user_proto_msgTypes[5]
+		mi := &file_apps_user_pb_user_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -867,7 +1711,7 @@ func (x *UserSet) String() string {
 func (*UserSet) ProtoMessage() {}
 
 func (x *UserSet) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_user_pb_user_proto_msgTypes[5]
+	mi := &file_apps_user_pb_user_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,7 +1724,7 @@ func (x *UserSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSet.ProtoReflect.Descriptor instead.
 func (*UserSet) Descriptor() ([]byte, []int) {
-	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{5}
+	return file_apps_user_pb_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UserSet) GetTotal() int64 {
@@ -903,7 +1747,7 @@ var file_apps_user_pb_user_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x2f, 0x75,
 	0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x69, 0x6e, 0x66, 0x72, 0x61,
 	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x22, 0x86, 0x02, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x65, 0x72, 0x22, 0xee, 0x03, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
 	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
@@ -919,94 +1763,236 @@ var file_apps_user_pb_user_proto_rawDesc = []byte{
 	0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01,
 	0x28, 0x0d, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e,
 	0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x85, 0x01, 0x0a, 0x06,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0xeb, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e,
+	0x28, 0x09, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x72, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72,
+	0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x72,
+	0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x2a, 0x0a, 0x11, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x72, 0x65, 0x61,
+	0x63, 0x68, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x68,
+	0x61, 0x73, 0x68, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x22, 0xbd, 0x02, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x66,
+	0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x54, 0x41, 0x54, 0x45, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x22, 0xe1, 0x02, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x69, 0x6e,
 	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0xa6, 0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x69,
-	0x63, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
-	0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a,
-	0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
-	0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74,
-	0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x06,
-	0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69,
-	0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x22, 0xb8, 0x02, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x3d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
-	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x52, 0x4f,
-	0x56, 0x49, 0x44, 0x45, 0x52, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x2f, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x62, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x3a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x0a,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x42, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe5, 0x04, 0x0a, 0x04, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x3e, 0x0a,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3a, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x69, 0x73, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x12, 0x3d, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69,
+	0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a,
+	0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x66,
+	0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12,
 	0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
 	0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74,
 	0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x59, 0x50, 0x45, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x59, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2a, 0x1f, 0x0a, 0x08, 0x50,
+	0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xb4, 0x01, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb7,
+	0x03, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x69, 0x63, 0x6b, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x67, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66,
+	0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x06, 0x67, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x95, 0x04, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x52, 0x4f, 0x56, 0x49,
+	0x44, 0x45, 0x52, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x59, 0x50, 0x45, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x3f, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x59, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x69, 0x6e, 0x66, 0x72,
+	0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x54, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x6d, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2a, 0x46, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x54, 0x45, 0x12,
+	0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53,
+	0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49,
+	0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x3b,
+	0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x2a, 0x29, 0x0a, 0x08, 0x50,
 	0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x44, 0x41, 0x50, 0x10, 0x01, 0x2a, 0x28, 0x0a, 0x04,
-	0x54, 0x59, 0x50, 0x45, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x55, 0x42, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x55,
-	0x50, 0x50, 0x45, 0x52, 0x10, 0x0f, 0x2a, 0x2b, 0x0a, 0x06, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x45, 0x4d, 0x41, 0x4c,
-	0x45, 0x10, 0x02, 0x2a, 0x20, 0x0a, 0x09, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x59,
-	0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53,
-	0x45, 0x4c, 0x46, 0x10, 0x01, 0x2a, 0x29, 0x0a, 0x0b, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x42,
-	0x45, 0x5f, 0x42, 0x59, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x10,
-	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x44, 0x41, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x4f, 0x49, 0x44, 0x43, 0x10, 0x02, 0x2a, 0x28, 0x0a, 0x04, 0x54, 0x59, 0x50, 0x45, 0x12, 0x07,
+	0x0a, 0x03, 0x53, 0x55, 0x42, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x49, 0x4d, 0x41,
+	0x52, 0x59, 0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x55, 0x50, 0x50, 0x45, 0x52, 0x10, 0x0f,
+	0x2a, 0x23, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x12, 0x08, 0x0a, 0x04, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x48,
+	0x4f, 0x4e, 0x45, 0x10, 0x01, 0x2a, 0x2b, 0x0a, 0x06, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x4d, 0x41, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x45, 0x4d, 0x41, 0x4c, 0x45,
+	0x10, 0x02, 0x2a, 0x36, 0x0a, 0x09, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x59, 0x12,
+	0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45,
+	0x4c, 0x46, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x02,
+	0x12, 0x08, 0x0a, 0x04, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x03, 0x2a, 0x34, 0x0a, 0x0b, 0x44, 0x45,
+	0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x5f, 0x42, 0x59, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02,
 	0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69,
 	0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x65, 0x6e, 0x74, 0x65,
 	0x72, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
@@ -1025,36 +2011,57 @@ func file_apps_user_pb_user_proto_rawDescGZIP() []byte {
 	return file_apps_user_pb_user_proto_rawDescData
 }
 
-var file_apps_user_pb_user_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_apps_user_pb_user_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_apps_user_pb_user_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_apps_user_pb_user_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_apps_user_pb_user_proto_goTypes = []interface{}{
-	(PROVIDER)(0),             // 0: infraboard.mcenter.user.PROVIDER
-	(TYPE)(0),                 // 1: infraboard.mcenter.user.TYPE
-	(Gender)(0),               // 2: infraboard.mcenter.user.Gender
-	(CREATE_BY)(0),            // 3: infraboard.mcenter.user.CREATE_BY
-	(DESCRIBE_BY)(0),          // 4: infraboard.mcenter.user.DESCRIBE_BY
-	(*Password)(nil),          // 5: infraboard.mcenter.user.Password
-	(*Status)(nil),            // 6: infraboard.mcenter.user.Status
-	(*User)(nil),              // 7: infraboard.mcenter.user.User
-	(*Profile)(nil),           // 8: infraboard.mcenter.user.Profile
-	(*CreateUserRequest)(nil), // 9: infraboard.mcenter.user.CreateUserRequest
-	(*UserSet)(nil),           // 10: infraboard.mcenter.user.UserSet
+	(STATE)(0),                // 0: infraboard.mcenter.user.STATE
+	(STATUS_EVENT_TYPE)(0),    // 1: infraboard.mcenter.user.STATUS_EVENT_TYPE
+	(PROVIDER)(0),             // 2: infraboard.mcenter.user.PROVIDER
+	(TYPE)(0),                 // 3: infraboard.mcenter.user.TYPE
+	(CONTACT_TYPE)(0),         // 4: infraboard.mcenter.user.CONTACT_TYPE
+	(Gender)(0),               // 5: infraboard.mcenter.user.Gender
+	(CREATE_BY)(0),            // 6: infraboard.mcenter.user.CREATE_BY
+	(DESCRIBE_BY)(0),          // 7: infraboard.mcenter.user.DESCRIBE_BY
+	(*Password)(nil),          // 8: infraboard.mcenter.user.Password
+	(*Status)(nil),            // 9: infraboard.mcenter.user.Status
+	(*StatusEvent)(nil),       // 10: infraboard.mcenter.user.StatusEvent
+	(*StatusEventSet)(nil),    // 11: infraboard.mcenter.user.StatusEventSet
+	(*Invitation)(nil),        // 12: infraboard.mcenter.user.Invitation
+	(*User)(nil),              // 13: infraboard.mcenter.user.User
+	(*DomainMembership)(nil),  // 14: infraboard.mcenter.user.DomainMembership
+	(*Verification)(nil),      // 15: infraboard.mcenter.user.Verification
+	(*Profile)(nil),           // 16: infraboard.mcenter.user.Profile
+	(*CreateUserRequest)(nil), // 17: infraboard.mcenter.user.CreateUserRequest
+	(*UserSet)(nil),           // 18: infraboard.mcenter.user.UserSet
+	nil,                       // 19: infraboard.mcenter.user.Profile.AttributesEntry
+	nil,                       // 20: infraboard.mcenter.user.CreateUserRequest.AttributesEntry
 }
 var file_apps_user_pb_user_proto_depIdxs = []int32{
-	9, // 0: infraboard.mcenter.user.User.spec:type_name -> infraboard.mcenter.user.CreateUserRequest
-	8, // 1: infraboard.mcenter.user.User.profile:type_name -> infraboard.mcenter.user.Profile
-	5, // 2: infraboard.mcenter.user.User.password:type_name -> infraboard.mcenter.user.Password
-	6, // 3: infraboard.mcenter.user.User.status:type_name -> infraboard.mcenter.user.Status
-	2, // 4: infraboard.mcenter.user.Profile.gender:type_name -> infraboard.mcenter.user.Gender
-	0, // 5: infraboard.mcenter.user.CreateUserRequest.provider:type_name -> infraboard.mcenter.user.PROVIDER
-	1, // 6: infraboard.mcenter.user.CreateUserRequest.type:type_name -> infraboard.mcenter.user.TYPE
-	3, // 7: infraboard.mcenter.user.CreateUserRequest.create_by:type_name -> infraboard.mcenter.user.CREATE_BY
-	7, // 8: infraboard.mcenter.user.UserSet.items:type_name -> infraboard.mcenter.user.User
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	0,  // 0: infraboard.mcenter.user.Status.state:type_name -> infraboard.mcenter.user.STATE
+	1,  // 1: infraboard.mcenter.user.StatusEvent.type:type_name -> infraboard.mcenter.user.STATUS_EVENT_TYPE
+	9,  // 2: infraboard.mcenter.user.StatusEvent.from:type_name -> infraboard.mcenter.user.Status
+	9,  // 3: infraboard.mcenter.user.StatusEvent.to:type_name -> infraboard.mcenter.user.Status
+	10, // 4: infraboard.mcenter.user.StatusEventSet.items:type_name -> infraboard.mcenter.user.StatusEvent
+	17, // 5: infraboard.mcenter.user.User.spec:type_name -> infraboard.mcenter.user.CreateUserRequest
+	16, // 6: infraboard.mcenter.user.User.profile:type_name -> infraboard.mcenter.user.Profile
+	8,  // 7: infraboard.mcenter.user.User.password:type_name -> infraboard.mcenter.user.Password
+	9,  // 8: infraboard.mcenter.user.User.status:type_name -> infraboard.mcenter.user.Status
+	12, // 9: infraboard.mcenter.user.User.invitation:type_name -> infraboard.mcenter.user.Invitation
+	15, // 10: infraboard.mcenter.user.User.verification:type_name -> infraboard.mcenter.user.Verification
+	14, // 11: infraboard.mcenter.user.User.memberships:type_name -> infraboard.mcenter.user.DomainMembership
+	3,  // 12: infraboard.mcenter.user.DomainMembership.type:type_name -> infraboard.mcenter.user.TYPE
+	5,  // 13: infraboard.mcenter.user.Profile.gender:type_name -> infraboard.mcenter.user.Gender
+	19, // 14: infraboard.mcenter.user.Profile.attributes:type_name -> infraboard.mcenter.user.Profile.AttributesEntry
+	2,  // 15: infraboard.mcenter.user.CreateUserRequest.provider:type_name -> infraboard.mcenter.user.PROVIDER
+	3,  // 16: infraboard.mcenter.user.CreateUserRequest.type:type_name -> infraboard.mcenter.user.TYPE
+	6,  // 17: infraboard.mcenter.user.CreateUserRequest.create_by:type_name -> infraboard.mcenter.user.CREATE_BY
+	20, // 18: infraboard.mcenter.user.CreateUserRequest.attributes:type_name -> infraboard.mcenter.user.CreateUserRequest.AttributesEntry
+	13, // 19: infraboard.mcenter.user.UserSet.items:type_name -> infraboard.mcenter.user.User
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_apps_user_pb_user_proto_init() }
@@ -1088,7 +2095,7 @@ func file_apps_user_pb_user_proto_init() {
 			}
 		}
 		file_apps_user_pb_user_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+			switch v := v.(*StatusEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1100,7 +2107,7 @@ func file_apps_user_pb_user_proto_init() {
 			}
 		}
 		file_apps_user_pb_user_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Profile); i {
+			switch v := v.(*StatusEventSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1112,7 +2119,7 @@ func file_apps_user_pb_user_proto_init() {
 			}
 		}
 		file_apps_user_pb_user_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequest); i {
+			switch v := v.(*Invitation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1124,6 +2131,66 @@ func file_apps_user_pb_user_proto_init() {
 			}
 		}
 		file_apps_user_pb_user_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_user_pb_user_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DomainMembership); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_user_pb_user_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Verification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_user_pb_user_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_user_pb_user_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apps_user_pb_user_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserSet); i {
 			case 0:
 				return &v.state
@@ -1141,8 +2208,8 @@ func file_apps_user_pb_user_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apps_user_pb_user_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   6,
+			NumEnums:      8,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
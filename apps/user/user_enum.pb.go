@@ -9,6 +9,96 @@ import (
 	"strings"
 )
 
+// ParseSTATEFromString Parse STATE from string
+func ParseSTATEFromString(str string) (STATE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := STATE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown STATE: %s", str)
+	}
+
+	return STATE(v), nil
+}
+
+// Equal type compare
+func (t STATE) Equal(target STATE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t STATE) IsIn(targets ...STATE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t STATE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *STATE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseSTATEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseSTATUS_EVENT_TYPEFromString Parse STATUS_EVENT_TYPE from string
+func ParseSTATUS_EVENT_TYPEFromString(str string) (STATUS_EVENT_TYPE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := STATUS_EVENT_TYPE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown STATUS_EVENT_TYPE: %s", str)
+	}
+
+	return STATUS_EVENT_TYPE(v), nil
+}
+
+// Equal type compare
+func (t STATUS_EVENT_TYPE) Equal(target STATUS_EVENT_TYPE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t STATUS_EVENT_TYPE) IsIn(targets ...STATUS_EVENT_TYPE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t STATUS_EVENT_TYPE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *STATUS_EVENT_TYPE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseSTATUS_EVENT_TYPEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
 // ParsePROVIDERFromString Parse PROVIDER from string
 func ParsePROVIDERFromString(str string) (PROVIDER, error) {
 	key := strings.Trim(string(str), `"`)
@@ -99,6 +189,51 @@ func (t *TYPE) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// ParseCONTACT_TYPEFromString Parse CONTACT_TYPE from string
+func ParseCONTACT_TYPEFromString(str string) (CONTACT_TYPE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := CONTACT_TYPE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown CONTACT_TYPE: %s", str)
+	}
+
+	return CONTACT_TYPE(v), nil
+}
+
+// Equal type compare
+func (t CONTACT_TYPE) Equal(target CONTACT_TYPE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t CONTACT_TYPE) IsIn(targets ...CONTACT_TYPE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t CONTACT_TYPE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *CONTACT_TYPE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseCONTACT_TYPEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
 // ParseGenderFromString Parse Gender from string
 func ParseGenderFromString(str string) (Gender, error) {
 	key := strings.Trim(string(str), `"`)
@@ -0,0 +1,98 @@
+package user
+
+import (
+	"time"
+)
+
+// NewVerification 邮箱和手机的验证状态
+func NewVerification() *Verification {
+	return &Verification{}
+}
+
+// SetVerified 标记联系方式已验证
+func (v *Verification) SetVerified(t CONTACT_TYPE) {
+	now := time.Now().UnixMilli()
+	switch t {
+	case CONTACT_TYPE_MAIL:
+		v.EmailVerified = true
+		v.EmailVerifiedAt = now
+	case CONTACT_TYPE_PHONE:
+		v.PhoneVerified = true
+		v.PhoneVerifiedAt = now
+	}
+}
+
+// SetUnverified 联系方式修改后需要重新验证
+func (v *Verification) SetUnverified(t CONTACT_TYPE) {
+	switch t {
+	case CONTACT_TYPE_MAIL:
+		v.EmailVerified = false
+		v.EmailVerifiedAt = 0
+	case CONTACT_TYPE_PHONE:
+		v.PhoneVerified = false
+		v.PhoneVerifiedAt = 0
+	}
+}
+
+// Contact 用户当前的邮箱或者手机
+func (u *User) Contact(t CONTACT_TYPE) string {
+	if u.Profile == nil {
+		return ""
+	}
+	switch t {
+	case CONTACT_TYPE_MAIL:
+		return u.Profile.Email
+	case CONTACT_TYPE_PHONE:
+		return u.Profile.Phone
+	default:
+		return ""
+	}
+}
+
+// IsVerified 用户当前的邮箱或者手机是否已验证
+func (u *User) IsVerified(t CONTACT_TYPE) bool {
+	if u.Verification == nil || u.Contact(t) == "" {
+		return false
+	}
+	switch t {
+	case CONTACT_TYPE_MAIL:
+		return u.Verification.EmailVerified
+	case CONTACT_TYPE_PHONE:
+		return u.Verification.PhoneVerified
+	default:
+		return false
+	}
+}
+
+// SetVerified 标记用户当前的邮箱或者手机已验证
+func (u *User) SetVerified(t CONTACT_TYPE) {
+	if u.Verification == nil {
+		u.Verification = NewVerification()
+	}
+	u.Verification.SetVerified(t)
+}
+
+// resetChangedContact 邮箱或者手机修改后, 清除之前的验证状态
+func (u *User) resetChangedContact(email, phone string) {
+	if u.Verification == nil {
+		return
+	}
+	if u.Contact(CONTACT_TYPE_MAIL) != email {
+		u.Verification.SetUnverified(CONTACT_TYPE_MAIL)
+	}
+	if u.Contact(CONTACT_TYPE_PHONE) != phone {
+		u.Verification.SetUnverified(CONTACT_TYPE_PHONE)
+	}
+}
+
+func NewVerifyContactRequest(userId string, t CONTACT_TYPE, code string) *VerifyContactRequest {
+	return &VerifyContactRequest{
+		UserId: userId,
+		Type:   t,
+		Code:   code,
+	}
+}
+
+func (req *VerifyContactRequest) Validate() error {
+	return validate.Struct(req)
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/mcenter/apps/userbulk"
+)
+
+var (
+	h = &handler{}
+)
+
+type handler struct {
+	service userbulk.Service
+	log     logger.Logger
+}
+
+func (h *handler) Config() error {
+	h.log = zap.L().Named(userbulk.AppName)
+	h.service = app.GetInternalApp(userbulk.AppName).(userbulk.Service)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return userbulk.AppName
+}
+
+func (h *handler) Version() string {
+	return "v1"
+}
+
+func (h *handler) Registry(ws *restful.WebService) {
+	tags := []string{"用户批量导入导出"}
+
+	ws.Route(ws.POST("/import").To(h.ImportUser).
+		Doc("批量导入用户, 请求体为CSV/JSON/YAML文件, 返回每一行的导入结果").
+		Consumes("text/csv", "application/json", "application/yaml", "text/plain", "application/octet-stream").
+		Param(ws.QueryParameter("domain", "domain of the users").DataType("string").Required(true)).
+		Param(ws.QueryParameter("format", "csv, json or yaml, default csv").DataType("string")).
+		Param(ws.QueryParameter("dry_run", "only validate the records").DataType("boolean")).
+		Param(ws.QueryParameter("invite", "invite users without password by email").DataType("boolean")).
+		Param(ws.QueryParameter("expire_hours", "invitation expire hours").DataType("integer")).
+		Param(ws.QueryParameter("create_by", "operator, the inviter and policy creator").DataType("string")).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Returns(200, "OK", userbulk.ImportReport{}))
+
+	ws.Route(ws.GET("/export").To(h.ExportUser).
+		Doc("导出域内的用户, 包含用户信息和授权, 不包含密码").
+		Produces("text/csv", "application/json", "application/yaml").
+		Param(ws.QueryParameter("domain", "domain of the users").DataType("string").Required(true)).
+		Param(ws.QueryParameter("format", "csv, json or yaml, default csv").DataType("string")).
+		Param(ws.QueryParameter("with_policies", "export user policies").DataType("boolean")).
+		Metadata(restfulspec.KeyOpenAPITags, tags))
+}
+
+func init() {
+	app.RegistryRESTfulApp(h)
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/http/restful/response"
+
+	"github.com/infraboard/mcenter/apps/userbulk"
+)
+
+func (h *handler) ImportUser(r *restful.Request, w *restful.Response) {
+	format, err := userbulk.ParseFormat(r.QueryParameter("format"))
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest(err.Error()))
+		return
+	}
+
+	req, err := userbulk.NewImportUserRequestFromHTTP(r.Request)
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest(err.Error()))
+		return
+	}
+	defer r.Request.Body.Close()
+	req.Records, err = userbulk.ReadRecords(format, r.Request.Body)
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest(err.Error()))
+		return
+	}
+
+	report, err := h.service.ImportUser(r.Request.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, report)
+}
+
+// ExportUser 边查询边写入响应, 写入第一条记录前出错时返回错误信息
+func (h *handler) ExportUser(r *restful.Request, w *restful.Response) {
+	format, err := userbulk.ParseFormat(r.QueryParameter("format"))
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest(err.Error()))
+		return
+	}
+	req := userbulk.NewExportUserRequestFromHTTP(r.Request)
+
+	started := false
+	writer := userbulk.NewRecordWriter(format, w)
+	start := func() {
+		if started {
+			return
+		}
+		started = true
+		w.Header().Set("Content-Type", format.ContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="users-%s.%s"`, req.Domain, format.FileExt()))
+		w.WriteHeader(http.StatusOK)
+	}
+
+	err = h.service.ExportUser(r.Request.Context(), req, func(rec *userbulk.UserRecord) error {
+		start()
+		if err := writer.Write(rec); err != nil {
+			return err
+		}
+		if f, ok := w.ResponseWriter.(http.Flusher); ok {
+			f.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		if !started {
+			response.Failed(w, err)
+			return
+		}
+		h.log.Errorf("export domain %s users error, %s", req.Domain, err)
+		return
+	}
+
+	start()
+	if err := writer.Close(); err != nil {
+		h.log.Errorf("export domain %s users error, %s", req.Domain, err)
+	}
+}
@@ -0,0 +1,157 @@
+package userbulk
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+const (
+	AppName = "userbulk"
+)
+
+// use a single instance of Validate, it caches struct info
+var (
+	validate = validator.New()
+)
+
+// NewUserRecord 用户记录
+func NewUserRecord() *UserRecord {
+	return &UserRecord{
+		Profile:  user.NewProfile(),
+		Policies: []*PolicyRecord{},
+	}
+}
+
+// NewUserRecordFromUser 导出的用户记录, 不包含密码
+func NewUserRecordFromUser(u *user.User) *UserRecord {
+	r := NewUserRecord()
+	r.Username = u.Spec.Username
+	r.Provider = u.Spec.Provider
+	r.AuthSource = u.Spec.AuthSource
+	r.ExternalId = u.Spec.ExternalId
+	r.Description = u.Spec.Description
+	if u.Profile != nil {
+		r.Profile = u.Profile
+	}
+	return r
+}
+
+func (r *UserRecord) Validate() error {
+	return validate.Struct(r)
+}
+
+// CreateUserRequest 使用记录中的密码创建用户
+func (r *UserRecord) CreateUserRequest(domain, password string) *user.CreateUserRequest {
+	req := user.NewCreateUserRequest()
+	req.Provider = r.Provider
+	req.Type = user.TYPE_SUB
+	req.CreateBy = user.CREATE_BY_SYNC
+	req.Domain = domain
+	req.Username = r.Username
+	req.Password = password
+	req.Description = r.Description
+	req.ExternalId = r.ExternalId
+	req.AuthSource = r.AuthSource
+	if r.Profile != nil {
+		req.Attributes = r.Profile.Attributes
+	}
+	return req
+}
+
+// NewImportUserRequest 导入请求
+func NewImportUserRequest() *ImportUserRequest {
+	return &ImportUserRequest{
+		Records: []*UserRecord{},
+	}
+}
+
+// NewImportUserRequestFromHTTP 从URL参数中读取导入选项, 用户记录从请求体中读取
+func NewImportUserRequestFromHTTP(r *http.Request) (*ImportUserRequest, error) {
+	req := NewImportUserRequest()
+
+	qs := r.URL.Query()
+	req.Domain = qs.Get("domain")
+	req.CreateBy = qs.Get("create_by")
+	req.DryRun = qs.Get("dry_run") == "true"
+	req.Invite = qs.Get("invite") == "true"
+	if v := qs.Get("expire_hours"); v != "" {
+		hours, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("expire_hours invalidate, %s", err)
+		}
+		req.ExpireHours = uint32(hours)
+	}
+	return req, nil
+}
+
+func (req *ImportUserRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// NewImportReport 导入报告
+func NewImportReport(req *ImportUserRequest) *ImportReport {
+	return &ImportReport{
+		Domain: req.Domain,
+		DryRun: req.DryRun,
+		Total:  int64(len(req.Records)),
+		Rows:   []*RowResult{},
+	}
+}
+
+// Add 添加行结果并统计
+func (r *ImportReport) Add(row *RowResult) {
+	if row.Success {
+		r.Succeeded++
+	} else {
+		r.Failed++
+	}
+	r.Rows = append(r.Rows, row)
+}
+
+// NewRowResult 行结果
+func NewRowResult(row int, username string) *RowResult {
+	return &RowResult{
+		Row:      int32(row),
+		Username: username,
+		Success:  true,
+		Policies: []string{},
+	}
+}
+
+// Failed 行导入失败
+func (r *RowResult) Failed(format string, a ...interface{}) {
+	r.Success = false
+	r.Error = fmt.Sprintf(format, a...)
+}
+
+// NewExportUserRequest 导出请求
+func NewExportUserRequest(domain string) *ExportUserRequest {
+	return &ExportUserRequest{
+		Domain: domain,
+	}
+}
+
+// NewExportUserRequestFromHTTP todo
+func NewExportUserRequestFromHTTP(r *http.Request) *ExportUserRequest {
+	qs := r.URL.Query()
+	req := NewExportUserRequest(qs.Get("domain"))
+	req.WithPolicies = qs.Get("with_policies") == "true"
+	return req
+}
+
+func (req *ExportUserRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// ParseFormat 文件格式, 为空时使用CSV
+func ParseFormat(v string) (FORMAT, error) {
+	if v == "" {
+		return FORMAT_CSV, nil
+	}
+	return ParseFORMATFromString(v)
+}
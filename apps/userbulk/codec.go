@@ -0,0 +1,314 @@
+package userbulk
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/infraboard/mcenter/apps/user"
+)
+
+const (
+	// CSV中多个授权之间的分隔符
+	CSV_POLICY_SEP = ";"
+	// CSV中授权的格式为: 空间:角色[:范围]
+	CSV_POLICY_FIELD_SEP = ":"
+	// CSV中自定义属性列的前缀, 比如: attr.employee_id
+	CSV_ATTRIBUTE_PREFIX = "attr."
+)
+
+var (
+	// CSV_HEADER CSV表头, 导入时列的顺序可以不同, username为必填列
+	CSV_HEADER = []string{
+		"username", "password", "provider", "auth_source", "external_id", "description",
+		"real_name", "nick_name", "phone", "email", "address", "gender",
+		"language", "city", "province", "policies",
+	}
+)
+
+// ContentType 文件格式对应的Content-Type
+func (f FORMAT) ContentType() string {
+	switch f {
+	case FORMAT_JSON:
+		return "application/json"
+	case FORMAT_YAML:
+		return "application/yaml"
+	default:
+		return "text/csv"
+	}
+}
+
+// FileExt 文件格式对应的文件后缀
+func (f FORMAT) FileExt() string {
+	return strings.ToLower(f.String())
+}
+
+// ReadRecords 读取导入文件中的用户记录
+func ReadRecords(format FORMAT, r io.Reader) ([]*UserRecord, error) {
+	records := []*UserRecord{}
+	switch format {
+	case FORMAT_CSV:
+		return readCSV(r)
+	case FORMAT_JSON:
+		if err := json.NewDecoder(r).Decode(&records); err != nil {
+			return nil, fmt.Errorf("decode json error, %s", err)
+		}
+	case FORMAT_YAML:
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(b, &records); err != nil {
+			return nil, fmt.Errorf("decode yaml error, %s", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown format %s", format)
+	}
+
+	for i := range records {
+		if records[i] == nil {
+			return nil, fmt.Errorf("row %d is empty", i+1)
+		}
+		if records[i].Profile == nil {
+			records[i].Profile = user.NewProfile()
+		}
+	}
+	return records, nil
+}
+
+func readCSV(r io.Reader) ([]*UserRecord, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header error, %s", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !isCSVColumn(name) {
+			return nil, fmt.Errorf("unknown csv column %s", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["username"]; !ok {
+		return nil, fmt.Errorf("csv column username required")
+	}
+
+	records := []*UserRecord{}
+	for row := 1; ; row++ {
+		line, err := cr.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv row %d error, %s", row, err)
+		}
+
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(line) {
+				return strings.TrimSpace(line[i])
+			}
+			return ""
+		}
+		rec, err := newRecordFromCSV(get)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %s", row, err)
+		}
+		for name := range columns {
+			if attr := strings.TrimPrefix(name, CSV_ATTRIBUTE_PREFIX); attr != name {
+				if v := get(name); v != "" {
+					if rec.Profile.Attributes == nil {
+						rec.Profile.Attributes = map[string]string{}
+					}
+					rec.Profile.Attributes[attr] = v
+				}
+			}
+		}
+		records = append(records, rec)
+	}
+}
+
+func newRecordFromCSV(get func(string) string) (*UserRecord, error) {
+	r := NewUserRecord()
+	r.Username = get("username")
+	r.Password = get("password")
+	r.AuthSource = get("auth_source")
+	r.ExternalId = get("external_id")
+	r.Description = get("description")
+	r.Profile.RealName = get("real_name")
+	r.Profile.NickName = get("nick_name")
+	r.Profile.Phone = get("phone")
+	r.Profile.Email = get("email")
+	r.Profile.Address = get("address")
+	r.Profile.Language = get("language")
+	r.Profile.City = get("city")
+	r.Profile.Province = get("province")
+
+	if v := get("provider"); v != "" {
+		p, err := user.ParsePROVIDERFromString(v)
+		if err != nil {
+			return nil, err
+		}
+		r.Provider = p
+	}
+	if v := get("gender"); v != "" {
+		g, err := user.ParseGenderFromString(v)
+		if err != nil {
+			return nil, err
+		}
+		r.Profile.Gender = g
+	}
+	for _, item := range strings.Split(get("policies"), CSV_POLICY_SEP) {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		fields := strings.SplitN(item, CSV_POLICY_FIELD_SEP, 3)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("policy %s format error, namespace:role[:scope]", item)
+		}
+		p := &PolicyRecord{Namespace: fields[0], Role: fields[1]}
+		if len(fields) == 3 {
+			p.Scope = fields[2]
+		}
+		r.Policies = append(r.Policies, p)
+	}
+	return r, nil
+}
+
+func isCSVColumn(name string) bool {
+	if strings.HasPrefix(name, CSV_ATTRIBUTE_PREFIX) {
+		return len(name) > len(CSV_ATTRIBUTE_PREFIX)
+	}
+	for _, c := range CSV_HEADER {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordWriter 导出时逐条写入用户记录
+type RecordWriter interface {
+	Write(*UserRecord) error
+	// 写入文件结尾
+	Close() error
+}
+
+// NewRecordWriter 导出文件的写入器
+func NewRecordWriter(format FORMAT, w io.Writer) RecordWriter {
+	switch format {
+	case FORMAT_JSON:
+		return &jsonWriter{w: w}
+	case FORMAT_YAML:
+		return &yamlWriter{w: w}
+	default:
+		return &csvWriter{w: csv.NewWriter(w)}
+	}
+}
+
+type csvWriter struct {
+	w           *csv.Writer
+	writeHeader bool
+}
+
+func (c *csvWriter) Write(r *UserRecord) error {
+	if !c.writeHeader {
+		if err := c.w.Write(CSV_HEADER); err != nil {
+			return err
+		}
+		c.writeHeader = true
+	}
+
+	p := r.Profile
+	if p == nil {
+		p = user.NewProfile()
+	}
+	policies := []string{}
+	for _, item := range r.Policies {
+		fields := []string{item.Namespace, item.Role}
+		if item.Scope != "" {
+			fields = append(fields, item.Scope)
+		}
+		policies = append(policies, strings.Join(fields, CSV_POLICY_FIELD_SEP))
+	}
+
+	err := c.w.Write([]string{
+		r.Username, r.Password, r.Provider.String(), r.AuthSource, r.ExternalId, r.Description,
+		p.RealName, p.NickName, p.Phone, p.Email, p.Address, p.Gender.String(),
+		p.Language, p.City, p.Province, strings.Join(policies, CSV_POLICY_SEP),
+	})
+	if err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Close() error {
+	if !c.writeHeader {
+		if err := c.w.Write(CSV_HEADER); err != nil {
+			return err
+		}
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonWriter 逐条写入JSON数组的元素
+type jsonWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (j *jsonWriter) Write(r *UserRecord) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	sep := ",\n"
+	if j.count == 0 {
+		sep = "[\n"
+	}
+	j.count++
+	_, err = j.w.Write(append([]byte(sep), b...))
+	return err
+}
+
+func (j *jsonWriter) Close() error {
+	end := "\n]\n"
+	if j.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(j.w, end)
+	return err
+}
+
+// yamlWriter 逐条写入YAML列表的元素
+type yamlWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (y *yamlWriter) Write(r *UserRecord) error {
+	b, err := yaml.Marshal([]*UserRecord{r})
+	if err != nil {
+		return err
+	}
+	y.count++
+	_, err = y.w.Write(b)
+	return err
+}
+
+func (y *yamlWriter) Close() error {
+	if y.count == 0 {
+		_, err := io.WriteString(y.w, "[]\n")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package userbulk_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/mcenter/apps/user"
+	"github.com/infraboard/mcenter/apps/userbulk"
+)
+
+func TestReadCSV(t *testing.T) {
+	should := assert.New(t)
+
+	data := `username,email,password,gender,policies,attr.employee_id
+alice,alice@example.org,Abc@123456,female,"dev:developer;ops:admin:env=prod",E1001
+bob,bob@example.org,,,,
+`
+	records, err := userbulk.ReadRecords(userbulk.FORMAT_CSV, strings.NewReader(data))
+	if !should.NoError(err) {
+		return
+	}
+	should.Len(records, 2)
+	should.Equal("alice", records[0].Username)
+	should.Equal("Abc@123456", records[0].Password)
+	should.Equal(user.Gender_FEMALE, records[0].Profile.Gender)
+	should.Equal([]*userbulk.PolicyRecord{
+		{Namespace: "dev", Role: "developer"},
+		{Namespace: "ops", Role: "admin", Scope: "env=prod"},
+	}, records[0].Policies)
+	should.Equal(map[string]string{"employee_id": "E1001"}, records[0].Profile.Attributes)
+	should.Equal("bob@example.org", records[1].Profile.Email)
+	should.Empty(records[1].Policies)
+	should.Empty(records[1].Profile.Attributes)
+
+	_, err = userbulk.ReadRecords(userbulk.FORMAT_CSV, strings.NewReader("name\nalice\n"))
+	should.Error(err)
+	_, err = userbulk.ReadRecords(userbulk.FORMAT_CSV, strings.NewReader("username,policies\nalice,dev\n"))
+	should.Error(err)
+}
+
+func TestRoundTrip(t *testing.T) {
+	should := assert.New(t)
+
+	alice := userbulk.NewUserRecord()
+	alice.Username = "alice"
+	alice.Provider = user.PROVIDER_LDAP
+	alice.ExternalId = "uid=alice,dc=example,dc=org"
+	alice.Profile.Email = "alice@example.org"
+	alice.Profile.NickName = "Alice"
+	alice.Policies = append(alice.Policies, &userbulk.PolicyRecord{Namespace: "dev", Role: "developer", Scope: "env=test"})
+	bob := userbulk.NewUserRecord()
+	bob.Username = "bob"
+	bob.Profile.Gender = user.Gender_MALE
+
+	for _, format := range []userbulk.FORMAT{userbulk.FORMAT_CSV, userbulk.FORMAT_JSON, userbulk.FORMAT_YAML} {
+		buf := bytes.NewBuffer(nil)
+		w := userbulk.NewRecordWriter(format, buf)
+		should.NoError(w.Write(alice))
+		should.NoError(w.Write(bob))
+		should.NoError(w.Close())
+
+		records, err := userbulk.ReadRecords(format, buf)
+		if !should.NoError(err, format) {
+			continue
+		}
+		if should.Len(records, 2, format) {
+			should.Equal(alice.Username, records[0].Username, format)
+			should.Equal(alice.Provider, records[0].Provider, format)
+			should.Equal(alice.ExternalId, records[0].ExternalId, format)
+			should.Equal(alice.Profile.Email, records[0].Profile.Email, format)
+			should.Equal(alice.Profile.NickName, records[0].Profile.NickName, format)
+			should.Equal(alice.Policies, records[0].Policies, format)
+			should.Equal(user.Gender_MALE, records[1].Profile.Gender, format)
+		}
+	}
+}
+
+func TestWriteEmpty(t *testing.T) {
+	should := assert.New(t)
+
+	for _, format := range []userbulk.FORMAT{userbulk.FORMAT_CSV, userbulk.FORMAT_JSON, userbulk.FORMAT_YAML} {
+		buf := bytes.NewBuffer(nil)
+		should.NoError(userbulk.NewRecordWriter(format, buf).Close())
+
+		records, err := userbulk.ReadRecords(format, buf)
+		should.NoError(err, format)
+		should.Empty(records, format)
+	}
+}
@@ -0,0 +1,85 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/mcenter/apps/policy"
+	"github.com/infraboard/mcenter/apps/role"
+	"github.com/infraboard/mcenter/apps/user"
+	"github.com/infraboard/mcenter/apps/userbulk"
+)
+
+const (
+	// 导出时每次从数据库加载的数据量
+	SCAN_PAGE_SIZE = 200
+)
+
+// 流式导出域内的用户, 分页查询, 每个用户调用一次fn
+func (i *impl) ExportUser(ctx context.Context, req *userbulk.ExportUserRequest, fn func(*userbulk.UserRecord) error) error {
+	if err := req.Validate(); err != nil {
+		return exception.NewBadRequest(err.Error())
+	}
+
+	roles := map[string]string{}
+	query := user.NewQueryUserRequest()
+	query.Domain = req.Domain
+	query.Page.PageSize = SCAN_PAGE_SIZE
+	for {
+		set, err := i.user.QueryUser(ctx, query)
+		if err != nil {
+			return err
+		}
+		for _, u := range set.Items {
+			r := userbulk.NewUserRecordFromUser(u)
+			if req.WithPolicies {
+				if r.Policies, err = i.userPolicies(ctx, u, roles); err != nil {
+					return err
+				}
+			}
+			if err := fn(r); err != nil {
+				return err
+			}
+		}
+		if len(set.Items) < SCAN_PAGE_SIZE {
+			return nil
+		}
+		query.Page.PageNumber++
+	}
+}
+
+// userPolicies 直接授权给用户的策略, 不包含系统内置策略和用户组的策略
+func (i *impl) userPolicies(ctx context.Context, u *user.User, roles map[string]string) ([]*userbulk.PolicyRecord, error) {
+	req := policy.NewQueryPolicyRequest()
+	req.Domain = u.Spec.Domain
+	req.Username = u.Spec.Username
+	req.Page.PageSize = SCAN_PAGE_SIZE
+	set, err := i.policy.QueryPolicy(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []*userbulk.PolicyRecord{}
+	for _, p := range set.Items {
+		if p.Spec.Type.Equal(policy.PolicyType_BUILD_IN) || p.IsGroupPolicy() {
+			continue
+		}
+		name, ok := roles[p.Spec.RoleId]
+		if !ok {
+			r, err := i.role.DescribeRole(ctx, role.NewDescribeRoleRequestWithID(p.Spec.RoleId))
+			if err != nil {
+				return nil, err
+			}
+			name = r.Spec.Name
+			roles[p.Spec.RoleId] = name
+		}
+		records = append(records, &userbulk.PolicyRecord{
+			Namespace:   p.Spec.Namespace,
+			Role:        name,
+			Scope:       p.Spec.Scope,
+			ExpiredTime: p.Spec.ExpiredTime,
+		})
+	}
+	return records, nil
+}
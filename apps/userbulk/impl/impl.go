@@ -0,0 +1,50 @@
+package impl
+
+import (
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/mcenter/apps/denylist"
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/namespace"
+	"github.com/infraboard/mcenter/apps/policy"
+	"github.com/infraboard/mcenter/apps/role"
+	"github.com/infraboard/mcenter/apps/user"
+	"github.com/infraboard/mcenter/apps/userbulk"
+)
+
+var (
+	// Service 服务实例
+	svr = &impl{}
+)
+
+type impl struct {
+	log logger.Logger
+
+	domain    domain.Service
+	user      user.Service
+	policy    policy.Service
+	role      role.Service
+	namespace namespace.Service
+	denylist  denylist.Service
+}
+
+func (i *impl) Config() error {
+	i.log = zap.L().Named(i.Name())
+	i.domain = app.GetInternalApp(domain.AppName).(domain.Service)
+	i.user = app.GetInternalApp(user.AppName).(user.Service)
+	i.policy = app.GetInternalApp(policy.AppName).(policy.Service)
+	i.role = app.GetInternalApp(role.AppName).(role.Service)
+	i.namespace = app.GetInternalApp(namespace.AppName).(namespace.Service)
+	i.denylist = app.GetInternalApp(denylist.AppName).(denylist.Service)
+	return nil
+}
+
+func (i *impl) Name() string {
+	return userbulk.AppName
+}
+
+func init() {
+	app.RegistryInternalApp(svr)
+}
@@ -0,0 +1,226 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/infraboard/mcube/exception"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/infraboard/mcenter/apps/domain"
+	"github.com/infraboard/mcenter/apps/domain/password"
+	"github.com/infraboard/mcenter/apps/namespace"
+	"github.com/infraboard/mcenter/apps/policy"
+	"github.com/infraboard/mcenter/apps/role"
+	"github.com/infraboard/mcenter/apps/user"
+	"github.com/infraboard/mcenter/apps/userbulk"
+)
+
+// 批量导入用户, 每一行单独校验和创建, 失败的行不影响其他行
+func (i *impl) ImportUser(ctx context.Context, req *userbulk.ImportUserRequest) (*userbulk.ImportReport, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	dom, err := i.domain.DescribeDomain(ctx, domain.NewDescribeDomainRequestByName(req.Domain))
+	if err != nil {
+		return nil, err
+	}
+	ps := domain.NewDefaulPasswordSecurity()
+	if dom.Spec.SecuritySetting != nil && dom.Spec.SecuritySetting.PasswordSecurity != nil {
+		ps = dom.Spec.SecuritySetting.PasswordSecurity
+	}
+
+	report := userbulk.NewImportReport(req)
+	res := newResolver(i, req.Domain)
+	seen := map[string]bool{}
+	for idx, r := range req.Records {
+		row := userbulk.NewRowResult(idx+1, r.Username)
+		if err := i.checkRecord(ctx, req, dom, r, ps, res, seen); err != nil {
+			row.Failed(err.Error())
+		} else if !req.DryRun {
+			i.importRecord(ctx, req, r, ps, res, row)
+		}
+		report.Add(row)
+	}
+	return report, nil
+}
+
+// checkRecord 校验用户记录, 预演和正式导入都需要校验
+func (i *impl) checkRecord(ctx context.Context, req *userbulk.ImportUserRequest, dom *domain.Domain,
+	r *userbulk.UserRecord, ps *domain.PasswordSecurity, res *resolver, seen map[string]bool) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if seen[r.Username] {
+		return fmt.Errorf("username %s duplicate in file", r.Username)
+	}
+	seen[r.Username] = true
+
+	_, err := i.user.DescribeUser(ctx, user.NewDescriptUserRequestWithDomainName(req.Domain, r.Username))
+	if err == nil {
+		return fmt.Errorf("user %s already exists", r.Username)
+	}
+	if !exception.IsNotFoundError(err) {
+		return err
+	}
+
+	// 外部认证源的用户使用随机密码
+	if r.Provider.Equal(user.PROVIDER_LOCAL) {
+		if err := i.checkPassword(req, r, ps); err != nil {
+			return err
+		}
+	}
+
+	// 引用的用户是否存在在创建用户时检查
+	cr := r.CreateUserRequest(req.Domain, "")
+	if _, err := dom.Spec.ValidateUserAttributes(r.Profile.Attributes, !cr.IsExternal()); err != nil {
+		return err
+	}
+
+	for _, p := range r.Policies {
+		if _, err := res.roleId(ctx, p.Role); err != nil {
+			return err
+		}
+		if err := res.checkNamespace(ctx, p.Namespace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPassword 没有密码的用户需要通过邮件邀请
+func (i *impl) checkPassword(req *userbulk.ImportUserRequest, r *userbulk.UserRecord, ps *domain.PasswordSecurity) error {
+	if r.Password == "" {
+		if !req.Invite {
+			return fmt.Errorf("password required, or import with invite")
+		}
+		if r.Profile.Email == "" {
+			return fmt.Errorf("email required for invitation")
+		}
+		return nil
+	}
+
+	if err := password.Validate(ps, r.Password); err != nil {
+		return err
+	}
+	// 黑名单未加载时不做检测
+	result, err := i.denylist.CheckPassword(r.Password)
+	if err != nil {
+		i.log.Debugf("check password denylist error, %s", err)
+		return nil
+	}
+	if result.Matched {
+		return fmt.Errorf("%s", result.Reason())
+	}
+	return nil
+}
+
+// importRecord 创建用户(或者发送邀请), 更新用户信息, 创建授权策略
+func (i *impl) importRecord(ctx context.Context, req *userbulk.ImportUserRequest, r *userbulk.UserRecord,
+	ps *domain.PasswordSecurity, res *resolver, row *userbulk.RowResult) {
+	u, err := i.createUser(ctx, req, r, ps)
+	if err != nil {
+		row.Failed("create user error, %s", err)
+		return
+	}
+	row.UserId = u.Id
+	row.Invited = u.IsPendingActivation()
+
+	patch := user.NewPatchUserRequest(u.Id)
+	patch.Profile = r.Profile
+	if _, err := i.user.UpdateUser(ctx, patch); err != nil {
+		row.Failed("update user profile error, %s", err)
+		return
+	}
+
+	for _, p := range r.Policies {
+		roleId, err := res.roleId(ctx, p.Role)
+		if err != nil {
+			row.Failed("create policy error, %s", err)
+			return
+		}
+		pr := policy.NewCreatePolicyRequest()
+		pr.CreateBy = req.CreateBy
+		pr.Domain = req.Domain
+		pr.Namespace = p.Namespace
+		pr.Username = u.Spec.Username
+		pr.RoleId = roleId
+		pr.Scope = p.Scope
+		pr.ExpiredTime = p.ExpiredTime
+		ins, err := i.policy.CreatePolicy(ctx, pr)
+		if err != nil {
+			row.Failed("create policy %s:%s error, %s", p.Namespace, p.Role, err)
+			return
+		}
+		row.Policies = append(row.Policies, ins.Id)
+	}
+}
+
+func (i *impl) createUser(ctx context.Context, req *userbulk.ImportUserRequest, r *userbulk.UserRecord,
+	ps *domain.PasswordSecurity) (*user.User, error) {
+	if r.Provider.Equal(user.PROVIDER_LOCAL) && r.Password == "" {
+		ir := user.NewInviteUserRequest()
+		ir.Domain = req.Domain
+		ir.Username = r.Username
+		ir.Email = r.Profile.Email
+		ir.InviteBy = req.CreateBy
+		ir.Description = r.Description
+		ir.ExpireHours = req.ExpireHours
+		ir.Attributes = r.Profile.Attributes
+		return i.user.InviteUser(ctx, ir)
+	}
+
+	pass := r.Password
+	if pass == "" {
+		random, err := password.New(proto.Clone(ps).(*domain.PasswordSecurity)).GenerateValid()
+		if err != nil {
+			return nil, err
+		}
+		pass = *random
+	}
+	return i.user.CreateUser(ctx, r.CreateUserRequest(req.Domain, pass))
+}
+
+// resolver 缓存导入过程中查询的角色和空间
+type resolver struct {
+	i          *impl
+	domain     string
+	roles      map[string]string
+	namespaces map[string]bool
+}
+
+func newResolver(i *impl, domain string) *resolver {
+	return &resolver{
+		i:          i,
+		domain:     domain,
+		roles:      map[string]string{},
+		namespaces: map[string]bool{},
+	}
+}
+
+// roleId 通过角色名称查询角色Id
+func (r *resolver) roleId(ctx context.Context, name string) (string, error) {
+	if id, ok := r.roles[name]; ok {
+		return id, nil
+	}
+	ins, err := r.i.role.DescribeRole(ctx, role.NewDescribeRoleRequestWithName(name))
+	if err != nil {
+		return "", fmt.Errorf("role %s, %s", name, err)
+	}
+	r.roles[name] = ins.Id
+	return ins.Id, nil
+}
+
+// checkNamespace 空间是否存在, *表示所有空间
+func (r *resolver) checkNamespace(ctx context.Context, name string) error {
+	if name == "*" || r.namespaces[name] {
+		return nil
+	}
+	_, err := r.i.namespace.DescribeNamespace(ctx, namespace.NewDescriptNamespaceRequest(r.domain, name))
+	if err != nil {
+		return fmt.Errorf("namespace %s, %s", name, err)
+	}
+	r.namespaces[name] = true
+	return nil
+}
@@ -0,0 +1,10 @@
+package userbulk
+
+import "context"
+
+type Service interface {
+	// 批量导入用户, 预演时只校验不创建
+	ImportUser(context.Context, *ImportUserRequest) (*ImportReport, error)
+	// 流式导出域内的用户, 每个用户调用一次fn
+	ExportUser(ctx context.Context, req *ExportUserRequest, fn func(*UserRecord) error) error
+}
@@ -0,0 +1,55 @@
+// Code generated by github.com/infraboard/mcube
+// DO NOT EDIT
+
+package userbulk
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseFORMATFromString Parse FORMAT from string
+func ParseFORMATFromString(str string) (FORMAT, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := FORMAT_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown FORMAT: %s", str)
+	}
+
+	return FORMAT(v), nil
+}
+
+// Equal type compare
+func (t FORMAT) Equal(target FORMAT) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t FORMAT) IsIn(targets ...FORMAT) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t FORMAT) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *FORMAT) UnmarshalJSON(b []byte) error {
+	ins, err := ParseFORMATFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
@@ -1,7 +1,7 @@
 package rest
 
 import (
-	"github.com/infraboard/mcube/client/rest"
+	"net/http"
 )
 
 func NewClient(conf *Config) (*ClientSet, error) {
@@ -9,16 +9,21 @@ func NewClient(conf *Config) (*ClientSet, error) {
 		return nil, err
 	}
 
-	c := rest.NewRESTClient()
-	c.SetBearerTokenAuth(conf.Token)
-	c.SetBaseURL(conf.Address + conf.PathPrefix)
+	baseURL := conf.Address + conf.PathPrefix
+	var c *httpClient
+	if conf.MacKey != "" {
+		// MAC签名会写入Authorization头, 不再设置Bearer认证
+		c = newHTTPClient(baseURL, "", NewMacHTTPClient(conf.Token, conf.MacKey))
+	} else {
+		c = newHTTPClient(baseURL, conf.Token, &http.Client{})
+	}
 	return &ClientSet{
 		c: c,
 	}, nil
 }
 
 type ClientSet struct {
-	c *rest.RESTClient
+	c *httpClient
 }
 
 func (c *ClientSet) Service() MetaService {
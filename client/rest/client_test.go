@@ -2,6 +2,9 @@ package rest_test
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/infraboard/mcenter/apps/service"
@@ -35,6 +38,29 @@ func TestValidateToken(t *testing.T) {
 	t.Log(tk)
 }
 
+func TestMacClient(t *testing.T) {
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get(token.ACCESS_TOKEN_HEADER_KEY)
+		w.Write([]byte(`{"code":0,"data":{}}`))
+	}))
+	defer srv.Close()
+
+	conf := rest.NewDefaultConfig()
+	conf.Address = srv.URL
+	conf.Token = "test_access_token"
+	conf.MacKey = "test_mac_key"
+	mc, err := rest.NewClient(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mc.Service().QueryService(ctx, service.NewQueryServiceRequest())
+
+	if !strings.HasPrefix(auth, token.MAC_AUTH_SCHEME) {
+		t.Fatalf("want mac authorization, but got: %s", auth)
+	}
+}
+
 func init() {
 	err := rest.LoadClientFromEnv()
 	if err != nil {
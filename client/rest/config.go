@@ -14,7 +14,9 @@ func NewDefaultConfig() *Config {
 }
 
 type Config struct {
-	Token      string `json:"token" toml:"token" yaml:"token" env:"MCENTER_TOKEN"`
+	Token string `json:"token" toml:"token" yaml:"token" env:"MCENTER_TOKEN"`
+	// MAC令牌的签名密钥, 配置后所有请求使用MAC签名认证
+	MacKey     string `json:"mac_key" toml:"mac_key" yaml:"mac_key" env:"MCENTER_MAC_KEY"`
 	Address    string `json:"address" toml:"address" yaml:"address" env:"MCENTER_HTTP_ADDRESS" validate:"required"`
 	PathPrefix string `json:"path_prefix" toml:"path_prefix" yaml:"path_prefix" env:"MCENTER_HTTP_PATH_PREFIX" validate:"required"`
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/infraboard/mcenter/apps/instance"
-	"github.com/infraboard/mcube/client/rest"
 )
 
 type InstanceService interface {
@@ -19,7 +18,7 @@ type InstanceService interface {
 }
 
 type insImpl struct {
-	client *rest.RESTClient
+	client *httpClient
 }
 
 func (i *insImpl) RegistryInstance(ctx context.Context, req *instance.RegistryRequest) (
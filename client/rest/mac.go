@@ -0,0 +1,42 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/infraboard/mcenter/apps/token"
+)
+
+// NewMacHTTPClient 使用MAC令牌的HTTP客户端, 所有请求自动签名
+func NewMacHTTPClient(accessToken, macKey string) *http.Client {
+	return &http.Client{
+		Transport: NewMacTransport(accessToken, macKey, nil),
+	}
+}
+
+// NewMacTransport 对经过的请求进行MAC签名, base为空时使用http.DefaultTransport
+func NewMacTransport(accessToken, macKey string, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &macTransport{
+		accessToken: accessToken,
+		macKey:      macKey,
+		base:        base,
+	}
+}
+
+type macTransport struct {
+	accessToken string
+	macKey      string
+	base        http.RoundTripper
+}
+
+func (t *macTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	// RoundTripper不能修改原始请求, 签名在副本上进行
+	req := r.Clone(r.Context())
+	if err := token.SignHTTPRequest(req, t.accessToken, t.macKey); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/infraboard/mcenter/apps/permission"
 	"github.com/infraboard/mcenter/apps/role"
-	"github.com/infraboard/mcube/client/rest"
 )
 
 type PermissionService interface {
@@ -14,26 +13,20 @@ type PermissionService interface {
 }
 
 type permissionImpl struct {
-	client *rest.RESTClient
+	client *httpClient
 }
 
 func (i *permissionImpl) CheckPermission(ctx context.Context, req *permission.CheckPermissionRequest) (*role.Permission, error) {
-	// ins := token.NewDefaultToken()
-	// resp := (ins)
-
-	// fmt.Println("bearer " + req.AccessToken)
-	// err := i.client.
-	// 	Get("token").
-	// 	Header(token.VALIDATE_TOKEN_HEADER_KEY, req.AccessToken).
-	// 	Do(ctx).
-	// 	Into(resp)
-	// if err != nil {
-	// 	return nil, err
-	// }
-
-	// if resp.Error() != nil {
-	// 	return nil, err
-	// }
-
-	return nil, nil
+	ins := role.NewDeaultPermission()
+
+	err := i.client.
+		Post("permission").
+		Body(req).
+		Do(ctx).
+		Into(ins)
+	if err != nil {
+		return nil, err
+	}
+
+	return ins, nil
 }
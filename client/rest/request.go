@@ -0,0 +1,160 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/infraboard/mcube/client/negotiator"
+	"github.com/infraboard/mcube/client/rest"
+)
+
+// newHTTPClient SDK使用的REST客户端, 请求通过hc发送
+// 使用MAC令牌时hc负责签名, token为空; 使用Bearer令牌时hc为普通客户端
+func newHTTPClient(baseURL, token string, hc *http.Client) *httpClient {
+	return &httpClient{
+		baseURL: baseURL,
+		token:   token,
+		client:  hc,
+	}
+}
+
+// httpClient mcube的RESTClient不支持设置http.Client,
+// 为了让MAC签名的Transport生效, SDK自己构造请求, 接口保持和RESTClient一致
+type httpClient struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func (c *httpClient) method(verb, p string) *restRequest {
+	r := &restRequest{
+		c:       c,
+		method:  verb,
+		headers: http.Header{},
+	}
+
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		r.err = err
+		return r
+	}
+	u.Path = path.Join(u.Path, p)
+	r.url = u.String()
+	return r
+}
+
+func (c *httpClient) Post(p string) *restRequest {
+	return c.method(http.MethodPost, p)
+}
+
+func (c *httpClient) Put(p string) *restRequest {
+	return c.method(http.MethodPut, p)
+}
+
+func (c *httpClient) Patch(p string) *restRequest {
+	return c.method(http.MethodPatch, p)
+}
+
+func (c *httpClient) Get(p string) *restRequest {
+	return c.method(http.MethodGet, p)
+}
+
+func (c *httpClient) Delete(p string) *restRequest {
+	return c.method(http.MethodDelete, p)
+}
+
+type restRequest struct {
+	c       *httpClient
+	method  string
+	url     string
+	headers http.Header
+	body    []byte
+	err     error
+}
+
+func (r *restRequest) Header(key string, values ...string) *restRequest {
+	r.headers.Del(key)
+	for _, value := range values {
+		r.headers.Add(key, value)
+	}
+	return r
+}
+
+func (r *restRequest) Body(v any) *restRequest {
+	if r.err != nil {
+		return r
+	}
+
+	ct := rest.HeaderFilterFlags(r.headers.Get(rest.CONTENT_TYPE_HEADER))
+	b, err := negotiator.GetNegotiator(ct).Encode(v)
+	if err != nil {
+		r.err = err
+		return r
+	}
+	r.body = b
+	return r
+}
+
+func (r *restRequest) Do(ctx context.Context) *restResponse {
+	if r.err != nil {
+		return &restResponse{err: r.err}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, r.method, r.url, bytes.NewReader(r.body))
+	if err != nil {
+		return &restResponse{err: err}
+	}
+	for k, vs := range r.headers {
+		for i := range vs {
+			req.Header.Set(k, vs[i])
+		}
+	}
+	if r.c.token != "" {
+		req.Header.Set(rest.AUTHORIZATION_HEADER, "Bearer "+r.c.token)
+	}
+
+	raw, err := r.c.client.Do(req)
+	if err != nil {
+		return &restResponse{err: err}
+	}
+	defer raw.Body.Close()
+
+	// 不主动设置Accept-Encoding, 由Transport负责gzip解压
+	body, err := io.ReadAll(raw.Body)
+	if err != nil {
+		return &restResponse{err: err}
+	}
+	return &restResponse{
+		statusCode: raw.StatusCode,
+		headers:    raw.Header,
+		body:       body,
+	}
+}
+
+type restResponse struct {
+	statusCode int
+	headers    http.Header
+	body       []byte
+	err        error
+}
+
+func (r *restResponse) Into(v any) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if r.statusCode/100 != 2 {
+		return fmt.Errorf("status code is %d, not 2xx, response: %s", r.statusCode, string(r.body))
+	}
+
+	ct := rest.HeaderFilterFlags(r.headers.Get(rest.CONTENT_TYPE_HEADER))
+	if err := negotiator.GetNegotiator(ct).Decode(r.body, v); err != nil {
+		return fmt.Errorf("decode err: %s, data: %s", err, string(r.body))
+	}
+	return nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/infraboard/mcenter/apps/service"
-	"github.com/infraboard/mcube/client/rest"
 	"github.com/infraboard/mcube/pb/request"
 )
 
@@ -19,7 +18,7 @@ type MetaService interface {
 }
 
 type svcImpl struct {
-	client *rest.RESTClient
+	client *httpClient
 }
 
 func (i *svcImpl) ValidateCredential(ctx context.Context, req *service.ValidateCredentialRequest) (
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/infraboard/mcenter/apps/token"
-	"github.com/infraboard/mcube/client/rest"
 )
 
 type TokenService interface {
@@ -13,12 +12,25 @@ type TokenService interface {
 }
 
 type tokenImpl struct {
-	client *rest.RESTClient
+	client *httpClient
 }
 
 func (i *tokenImpl) ValidateToken(ctx context.Context, req *token.ValidateTokenRequest) (*token.Token, error) {
 	ins := token.NewDefaultToken()
 
+	// MAC令牌需要携带请求签名
+	if req.MacSignature != nil {
+		err := i.client.
+			Post("token/validate").
+			Body(req).
+			Do(ctx).
+			Into(ins)
+		if err != nil {
+			return nil, err
+		}
+		return ins, nil
+	}
+
 	err := i.client.
 		Get("token").
 		Header(token.VALIDATE_TOKEN_HEADER_KEY, req.AccessToken).
@@ -53,11 +53,15 @@ func (a *httpAuther) GoRestfulAuthFunc(req *restful.Request, resp *restful.Respo
 	entry := endpoint.NewEntryFromRestRequest(req)
 
 	if entry.AuthEnable {
-		// 获取用户Token, Token放在Heander Authorization
-		ak := token.GetTokenFromHTTPHeader(req.Request)
+		// 获取用户Token, Token放在Heander Authorization, 支持Bearer和MAC两种方式
+		vreq, err := token.NewValidateTokenRequestFromHTTP(req.Request)
+		if err != nil {
+			response.Failed(resp, exception.NewUnauthorized(err.Error()))
+			return
+		}
 
 		// 调用GRPC 校验用户Token合法性
-		tk, err := a.client.Token().ValidateToken(req.Request.Context(), token.NewValidateTokenRequest(ak))
+		tk, err := a.client.Token().ValidateToken(req.Request.Context(), vreq)
 		if err != nil {
 			response.Failed(resp, err)
 			return
@@ -66,6 +70,12 @@ func (a *httpAuther) GoRestfulAuthFunc(req *restful.Request, resp *restful.Respo
 		// 是不是需要返回用户的认证信息: 那个人, 那个空间下面， token本身的信息
 		req.SetAttribute("token", tk)
 
+		// 令牌范围限制, 先于鉴权开关判断, 超出范围的资源即使不需要鉴权也不允许访问
+		if err := a.CheckScope(tk, entry); err != nil {
+			response.Failed(resp, err)
+			return
+		}
+
 		if entry.PermissionEnable {
 			// 权限检查
 			err := a.CheckPermission(req.Request.Context(), tk, entry)
@@ -80,6 +90,18 @@ func (a *httpAuther) GoRestfulAuthFunc(req *restful.Request, resp *restful.Respo
 	next.ProcessFilter(req, resp)
 }
 
+// CheckScope 令牌范围限制对所有用户生效, 包括超级管理员
+func (a *httpAuther) CheckScope(tk *token.Token, e *endpoint.Entry) error {
+	scope, err := tk.ParseScope()
+	if err != nil {
+		return exception.NewPermissionDeny("parse token scope error, %s", err)
+	}
+	if !scope.MatchLabel(e.Labels) {
+		return exception.NewPermissionDeny("token scope [%s] not allow access endpoint: %s", scope, e.Path)
+	}
+	return nil
+}
+
 func (a *httpAuther) CheckPermission(ctx context.Context, tk *token.Token, e *endpoint.Entry) error {
 	if tk == nil {
 		return exception.NewUnauthorized("validate permission need token")
@@ -123,6 +145,7 @@ func (a *httpAuther) ValidatePermissionByPRBAC(ctx context.Context, tk *token.To
 	req := permission.NewCheckPermissionRequest()
 	req.Username = tk.Username
 	req.Namespace = tk.Namespace
+	req.Scope = tk.Scope
 	req.ServiceId = ci.Id
 	req.Path = e.UniquePath()
 	_, err = a.client.Permission().CheckPermission(ctx, req)
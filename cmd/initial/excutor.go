@@ -179,6 +179,7 @@ func (e *excutor) InitSystemSetting(ctx context.Context) error {
 func (e *excutor) InitAdminUser(ctx context.Context) error {
 	req := user.NewCreateUserRequest()
 	req.Type = user.TYPE_SUPPER
+	req.CreateBy = user.CREATE_BY_SYSTEM
 	req.Username = strings.TrimSpace(e.username)
 	req.Password = strings.TrimSpace(e.password)
 	req.Domain = domain.DEFAULT_DOMAIN
@@ -22,6 +22,10 @@ func newConfig() *Config {
 		Log:   newDefaultLog(),
 		Cache: newDefaultCache(),
 		Mongo: newDefaultMongoDB(),
+
+		TokenCache:   newDefaultTokenCache(),
+		PasswordHash: newDefaultPasswordHash(),
+		RetryLock:    newDefaultRetryLock(),
 	}
 }
 
@@ -31,6 +35,10 @@ type Config struct {
 	Log   *log     `toml:"log"`
 	Mongo *mongodb `toml:"mongodb"`
 	Cache *_cache  `toml:"cache"`
+
+	TokenCache   *tokenCache   `toml:"token_cache"`
+	PasswordHash *passwordHash `toml:"password_hash"`
+	RetryLock    *retryLock    `toml:"retry_lock"`
 }
 
 type app struct {
@@ -187,3 +195,65 @@ type _cache struct {
 	Memory *memory.Config `toml:"memory" json:"memory" yaml:"memory"`
 	Redis  *redis.Config  `toml:"redis" json:"redis" yaml:"redis"`
 }
+
+func newDefaultTokenCache() *tokenCache {
+	return &tokenCache{
+		Enabled: true,
+		Size:    10000,
+		TTL:     30,
+		Bus:     "memory",
+		Channel: "token_event",
+	}
+}
+
+// tokenCache Token校验缓存, 多副本部署时需要使用redis或者mongo作为失效事件总线
+type tokenCache struct {
+	Enabled bool `toml:"enabled" env:"TOKEN_CACHE_ENABLED"`
+	// 最大缓存的Token数量
+	Size int `toml:"size" env:"TOKEN_CACHE_SIZE"`
+	// 缓存有效期, 单位秒
+	TTL int `toml:"ttl" env:"TOKEN_CACHE_TTL"`
+	// 失效事件总线类型: memory/redis/mongo, memory只适用于单节点
+	Bus string `toml:"bus" env:"TOKEN_CACHE_BUS"`
+	// redis的channel名称, 或者mongo的collection名称
+	Channel string `toml:"channel" env:"TOKEN_CACHE_CHANNEL"`
+}
+
+func newDefaultPasswordHash() *passwordHash {
+	return &passwordHash{
+		Algorithm:     "bcrypt",
+		BcryptCost:    10,
+		Argon2Time:    3,
+		Argon2Memory:  64 * 1024,
+		Argon2Threads: 2,
+	}
+}
+
+// passwordHash 新密码使用的hash算法, 调整后用户登录时会自动按照新的算法和参数重新计算
+type passwordHash struct {
+	// hash算法: bcrypt/argon2id
+	Algorithm string `toml:"algorithm" env:"PASSWORD_HASH_ALGORITHM"`
+	// bcrypt的cost
+	BcryptCost int `toml:"bcrypt_cost" env:"PASSWORD_HASH_BCRYPT_COST"`
+	// argon2id迭代次数
+	Argon2Time uint32 `toml:"argon2_time" env:"PASSWORD_HASH_ARGON2_TIME"`
+	// argon2id内存大小, 单位KiB
+	Argon2Memory uint32 `toml:"argon2_memory" env:"PASSWORD_HASH_ARGON2_MEMORY"`
+	// argon2id并行度
+	Argon2Threads uint8 `toml:"argon2_threads" env:"PASSWORD_HASH_ARGON2_THREADS"`
+}
+
+func newDefaultRetryLock() *retryLock {
+	return &retryLock{
+		Store:             "cache",
+		AttemptRetainDays: 30,
+	}
+}
+
+// retryLock 登录失败重试锁定, 使用cache存储时重启缓存后锁定状态会丢失
+type retryLock struct {
+	// 锁定状态的存储: cache/mongo
+	Store string `toml:"store" env:"RETRY_LOCK_STORE"`
+	// 登录失败记录的保存天数
+	AttemptRetainDays int `toml:"attempt_retain_days" env:"RETRY_LOCK_ATTEMPT_RETAIN_DAYS"`
+}
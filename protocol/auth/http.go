@@ -7,6 +7,7 @@ import (
 	"github.com/infraboard/mcenter/apps/token"
 	"github.com/infraboard/mcenter/apps/user"
 	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/exception"
 	"github.com/infraboard/mcube/http/label"
 	"github.com/infraboard/mcube/http/restful/response"
 	"github.com/infraboard/mcube/logger"
@@ -33,10 +34,14 @@ func (a *httpAuther) GoRestfulAuthFunc(req *restful.Request, resp *restful.Respo
 	isAuth, ok := meta[label.Auth]
 	// 有认证标签,并且开启了认证
 	if ok && isAuth.(bool) {
-		// 获取token
-		ak := token.GetTokenFromHTTPHeader(req.Request)
+		// 获取token, MAC令牌会同时解析请求签名
+		vreq, err := token.NewValidateTokenRequestFromHTTP(req.Request)
+		if err != nil {
+			response.Failed(resp, exception.NewUnauthorized(err.Error()))
+			return
+		}
 
-		tk, err := a.tk.ValidateToken(req.Request.Context(), token.NewValidateTokenRequest(ak))
+		tk, err := a.tk.ValidateToken(req.Request.Context(), vreq)
 		if err != nil {
 			response.Failed(resp, err)
 			return